@@ -14,6 +14,7 @@ import (
 
 	"github.com/nice-pea/npchat/internal/app"
 	"github.com/nice-pea/npchat/internal/common"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
 )
 
 var (
@@ -80,6 +81,11 @@ func initCliCommand() *cli.Command {
 				Usage:       "Адрес для запуска HTTP сервера",
 				Value:       ":8080",
 			},
+			&cli.StringFlag{
+				Name:        "admin-token",
+				Destination: &cfg.Http2.AdminToken,
+				Usage:       "Токен для административных методов HTTP API. Если не задан, методы недоступны",
+			},
 			&cli.StringFlag{
 				Name:        "log-level",
 				Destination: &cfg.LogLevel,
@@ -134,6 +140,20 @@ func initCliCommand() *cli.Command {
 				Destination: &cfg.Jwt.RedisDSN,
 				Usage:       "Строка подключения Redis в формате 'redis://<user>:<password>@host:port/db'",
 			},
+
+			&cli.StringFlag{
+				Name:        "blob-storage-dir",
+				Destination: &cfg.BlobStorage.Dir,
+				Usage:       "Директория для хранения содержимого вложений",
+				Value:       "./data/attachments",
+			},
+
+			&cli.IntFlag{
+				Name:        "max-pins-per-chat",
+				Destination: &cfg.MaxPinsPerChat,
+				Usage:       "Максимальное количество закрепленных сообщений в чате",
+				Value:       chatt.DefaultMaxPins,
+			},
 		},
 	}
 }
@@ -0,0 +1,11 @@
+CREATE TABLE messages
+(
+    id         TEXT PRIMARY KEY,
+    chat_id    TEXT        NOT NULL,
+    author_id  TEXT        NOT NULL,
+    text       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT
+);
+
+CREATE INDEX messages_chat_id_created_at_idx ON messages (chat_id, created_at DESC);
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS message_revisions;
+
+ALTER TABLE messages
+    DROP COLUMN edited_at,
+    DROP COLUMN deleted_at;
\ No newline at end of file
@@ -0,0 +1,13 @@
+ALTER TABLE messages
+    ADD COLUMN edited_at  TIMESTAMPTZ NULL,
+    ADD COLUMN deleted_at TIMESTAMPTZ NULL;
+
+CREATE TABLE message_revisions
+(
+    message_id TEXT        NOT NULL,
+    text       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (message_id) REFERENCES messages ON DELETE CASCADE
+);
+
+CREATE INDEX message_revisions_message_id_idx ON message_revisions (message_id, created_at);
\ No newline at end of file
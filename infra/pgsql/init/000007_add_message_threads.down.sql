@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS messages_parent_id_created_at_idx;
+
+ALTER TABLE messages
+    DROP COLUMN parent_id,
+    DROP COLUMN reply_count,
+    DROP COLUMN last_reply_at;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE messages
+    ADD COLUMN parent_id     TEXT        NULL REFERENCES messages,
+    ADD COLUMN reply_count   INTEGER     NOT NULL DEFAULT 0,
+    ADD COLUMN last_reply_at TIMESTAMPTZ NULL;
+
+CREATE INDEX messages_parent_id_created_at_idx ON messages (parent_id, created_at DESC);
\ No newline at end of file
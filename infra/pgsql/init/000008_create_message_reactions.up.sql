@@ -0,0 +1,9 @@
+CREATE TABLE message_reactions
+(
+    message_id TEXT        NOT NULL,
+    user_id    TEXT        NOT NULL,
+    emoji      TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (message_id, user_id, emoji),
+    FOREIGN KEY (message_id) REFERENCES messages ON DELETE CASCADE
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE participants
+    DROP COLUMN last_read_message_id,
+    DROP COLUMN last_read_at;
\ No newline at end of file
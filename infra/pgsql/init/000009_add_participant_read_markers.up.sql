@@ -0,0 +1,3 @@
+ALTER TABLE participants
+    ADD COLUMN last_read_message_id TEXT        NULL,
+    ADD COLUMN last_read_at         TIMESTAMPTZ NULL;
\ No newline at end of file
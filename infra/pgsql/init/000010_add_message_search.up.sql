@@ -0,0 +1,6 @@
+ALTER TABLE messages
+    ADD COLUMN search_vector TSVECTOR NOT NULL DEFAULT ''::tsvector;
+
+UPDATE messages SET search_vector = to_tsvector('simple', text);
+
+CREATE INDEX messages_search_vector_idx ON messages USING GIN (search_vector);
\ No newline at end of file
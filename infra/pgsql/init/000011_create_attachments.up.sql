@@ -0,0 +1,25 @@
+CREATE TABLE attachments
+(
+    id          TEXT PRIMARY KEY,
+    chat_id     TEXT        NOT NULL,
+    uploader_id TEXT        NOT NULL,
+    name        TEXT        NOT NULL,
+    mime_type   TEXT        NOT NULL,
+    size        BIGINT      NOT NULL,
+    checksum    TEXT        NOT NULL,
+    storage_key TEXT        NOT NULL UNIQUE,
+    created_at  TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT
+);
+
+CREATE INDEX attachments_chat_id_idx ON attachments (chat_id);
+
+CREATE TABLE message_attachments
+(
+    message_id    TEXT    NOT NULL,
+    attachment_id TEXT    NOT NULL,
+    position      INTEGER NOT NULL,
+    PRIMARY KEY (message_id, attachment_id),
+    FOREIGN KEY (message_id) REFERENCES messages ON DELETE CASCADE,
+    FOREIGN KEY (attachment_id) REFERENCES attachments ON DELETE RESTRICT
+);
\ No newline at end of file
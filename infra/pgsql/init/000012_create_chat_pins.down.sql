@@ -0,0 +1 @@
+DROP TABLE IF EXISTS chat_pins;
\ No newline at end of file
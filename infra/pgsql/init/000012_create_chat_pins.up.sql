@@ -0,0 +1,9 @@
+CREATE TABLE chat_pins
+(
+    chat_id    TEXT        NOT NULL,
+    message_id TEXT        NOT NULL,
+    pinned_by  TEXT        NOT NULL,
+    pinned_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (chat_id, message_id),
+    FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT
+);
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE messages
+    DROP COLUMN forwarded_message_id,
+    DROP COLUMN forwarded_chat_id,
+    DROP COLUMN forwarded_author_id,
+    DROP COLUMN forwarded_created_at;
\ No newline at end of file
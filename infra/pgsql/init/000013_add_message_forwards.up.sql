@@ -0,0 +1,5 @@
+ALTER TABLE messages
+    ADD COLUMN forwarded_message_id TEXT        NULL,
+    ADD COLUMN forwarded_chat_id    TEXT        NULL,
+    ADD COLUMN forwarded_author_id  TEXT        NULL,
+    ADD COLUMN forwarded_created_at TIMESTAMPTZ NULL;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE scheduled_messages
+(
+    id          TEXT PRIMARY KEY,
+    chat_id     TEXT        NOT NULL,
+    author_id   TEXT        NOT NULL,
+    text        TEXT        NOT NULL,
+    parent_id   TEXT        NULL,
+    send_at     TIMESTAMPTZ NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL,
+    sent_at     TIMESTAMPTZ NULL,
+    message_id  TEXT        NULL,
+    canceled_at TIMESTAMPTZ NULL,
+    failed_at   TIMESTAMPTZ NULL,
+    fail_reason TEXT        NOT NULL DEFAULT '',
+    FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT
+);
+
+CREATE INDEX scheduled_messages_pending_send_at_idx ON scheduled_messages (send_at)
+    WHERE sent_at IS NULL AND canceled_at IS NULL AND failed_at IS NULL;
\ No newline at end of file
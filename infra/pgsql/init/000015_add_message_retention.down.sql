@@ -0,0 +1,12 @@
+ALTER TABLE messages
+    DROP CONSTRAINT messages_parent_id_fkey,
+    ADD CONSTRAINT messages_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES messages;
+
+DROP INDEX messages_expires_at_idx;
+
+ALTER TABLE messages
+    DROP COLUMN expires_at;
+
+ALTER TABLE chats
+    DROP COLUMN retention_max_age_seconds,
+    DROP COLUMN retention_self_destruct_seconds;
\ No newline at end of file
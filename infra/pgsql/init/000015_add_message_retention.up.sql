@@ -0,0 +1,13 @@
+ALTER TABLE chats
+    ADD COLUMN retention_max_age_seconds       BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN retention_self_destruct_seconds BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE messages
+    ADD COLUMN expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX messages_expires_at_idx ON messages (expires_at) WHERE expires_at IS NOT NULL;
+
+-- Ответы удаляются вместе с корневым сообщением треда
+ALTER TABLE messages
+    DROP CONSTRAINT messages_parent_id_fkey,
+    ADD CONSTRAINT messages_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES messages ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE message_mentions
+(
+    message_id TEXT    NOT NULL,
+    user_id    TEXT    NOT NULL,
+    position   INTEGER NOT NULL,
+    PRIMARY KEY (message_id, user_id),
+    FOREIGN KEY (message_id) REFERENCES messages ON DELETE CASCADE
+);
+
+CREATE INDEX message_mentions_user_id_idx ON message_mentions (user_id);
\ No newline at end of file
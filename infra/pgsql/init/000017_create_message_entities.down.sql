@@ -0,0 +1 @@
+DROP TABLE IF EXISTS message_entities;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE message_entities
+(
+    message_id TEXT    NOT NULL,
+    position   INTEGER NOT NULL,
+    type       TEXT    NOT NULL,
+    start      INTEGER NOT NULL,
+    length     INTEGER NOT NULL,
+    url        TEXT    NOT NULL DEFAULT '',
+    PRIMARY KEY (message_id, position),
+    FOREIGN KEY (message_id) REFERENCES messages ON DELETE CASCADE
+);
\ No newline at end of file
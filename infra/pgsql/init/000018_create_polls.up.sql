@@ -0,0 +1,20 @@
+CREATE TABLE polls
+(
+    message_id      TEXT        NOT NULL PRIMARY KEY,
+    question        TEXT        NOT NULL,
+    options         TEXT[]      NOT NULL,
+    multiple_choice BOOLEAN     NOT NULL,
+    anonymous       BOOLEAN     NOT NULL,
+    closes_at       TIMESTAMPTZ NULL,
+    FOREIGN KEY (message_id) REFERENCES messages ON DELETE CASCADE
+);
+
+CREATE TABLE poll_votes
+(
+    message_id   TEXT        NOT NULL,
+    user_id      TEXT        NOT NULL,
+    option_index INTEGER     NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (message_id, user_id, option_index),
+    FOREIGN KEY (message_id) REFERENCES polls ON DELETE CASCADE
+);
\ No newline at end of file
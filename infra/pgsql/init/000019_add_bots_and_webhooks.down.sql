@@ -0,0 +1,11 @@
+DROP TABLE webhook_deliveries;
+
+DROP INDEX users_bot_owner_id_idx;
+DROP INDEX users_bot_token_hash_idx;
+
+ALTER TABLE users
+    DROP COLUMN kind,
+    DROP COLUMN bot_owner_id,
+    DROP COLUMN bot_token_hash,
+    DROP COLUMN webhook_url,
+    DROP COLUMN webhook_secret;
\ No newline at end of file
@@ -0,0 +1,29 @@
+ALTER TABLE users
+    ADD COLUMN kind           TEXT NOT NULL DEFAULT 'human',
+    ADD COLUMN bot_owner_id   TEXT NULL,
+    ADD COLUMN bot_token_hash TEXT NOT NULL DEFAULT '',
+    ADD COLUMN webhook_url    TEXT NOT NULL DEFAULT '',
+    ADD COLUMN webhook_secret TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX users_bot_token_hash_idx ON users (bot_token_hash)
+    WHERE bot_token_hash <> '';
+
+CREATE INDEX users_bot_owner_id_idx ON users (bot_owner_id);
+
+CREATE TABLE webhook_deliveries
+(
+    id              TEXT PRIMARY KEY,
+    bot_id          TEXT        NOT NULL,
+    event_type      TEXT        NOT NULL,
+    payload         BYTEA       NOT NULL,
+    attempts        INTEGER     NOT NULL,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error      TEXT        NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ NOT NULL,
+    delivered_at    TIMESTAMPTZ NULL,
+    failed_at       TIMESTAMPTZ NULL,
+    FOREIGN KEY (bot_id) REFERENCES users ON DELETE CASCADE
+);
+
+CREATE INDEX webhook_deliveries_pending_next_attempt_at_idx ON webhook_deliveries (next_attempt_at)
+    WHERE delivered_at IS NULL AND failed_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE messages
+    DROP COLUMN integration_name;
+
+DROP TABLE incoming_webhooks;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE incoming_webhooks
+(
+    id         TEXT PRIMARY KEY,
+    chat_id    TEXT        NOT NULL,
+    name       TEXT        NOT NULL,
+    token_hash TEXT        NOT NULL,
+    creator_id TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ NULL,
+    FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE
+);
+
+CREATE INDEX incoming_webhooks_chat_id_idx ON incoming_webhooks (chat_id);
+
+CREATE UNIQUE INDEX incoming_webhooks_token_hash_idx ON incoming_webhooks (token_hash)
+    WHERE token_hash <> '';
+
+ALTER TABLE messages
+    ADD COLUMN integration_name TEXT NOT NULL DEFAULT '';
\ No newline at end of file
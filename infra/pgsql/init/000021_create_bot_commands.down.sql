@@ -0,0 +1 @@
+DROP TABLE bot_commands;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE bot_commands
+(
+    bot_id      TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL,
+    position    INTEGER NOT NULL,
+    PRIMARY KEY (bot_id, name),
+    FOREIGN KEY (bot_id) REFERENCES users ON DELETE CASCADE
+);
+
+CREATE INDEX bot_commands_name_idx ON bot_commands (name);
\ No newline at end of file
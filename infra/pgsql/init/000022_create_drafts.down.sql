@@ -0,0 +1 @@
+DROP TABLE drafts;
\ No newline at end of file
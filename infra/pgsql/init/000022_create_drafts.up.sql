@@ -0,0 +1,11 @@
+CREATE TABLE drafts
+(
+    user_id    TEXT        NOT NULL,
+    chat_id    TEXT        NOT NULL,
+    text       TEXT        NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, chat_id),
+    FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE
+);
+
+CREATE INDEX drafts_chat_id_idx ON drafts (chat_id);
\ No newline at end of file
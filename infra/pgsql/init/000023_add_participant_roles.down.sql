@@ -0,0 +1,2 @@
+ALTER TABLE participants
+    DROP COLUMN role;
\ No newline at end of file
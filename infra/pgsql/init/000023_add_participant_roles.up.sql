@@ -0,0 +1,8 @@
+ALTER TABLE participants
+    ADD COLUMN role TEXT NOT NULL DEFAULT 'member';
+
+UPDATE participants p
+SET role = 'owner'
+FROM chats c
+WHERE c.id = p.chat_id
+  AND c.chief_id = p.user_id;
\ No newline at end of file
@@ -0,0 +1,26 @@
+ALTER TABLE scheduled_messages
+    DROP CONSTRAINT scheduled_messages_chat_id_fkey,
+    ADD CONSTRAINT scheduled_messages_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT;
+
+ALTER TABLE chat_pins
+    DROP CONSTRAINT chat_pins_chat_id_fkey,
+    ADD CONSTRAINT chat_pins_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT;
+
+ALTER TABLE attachments
+    DROP CONSTRAINT attachments_chat_id_fkey,
+    ADD CONSTRAINT attachments_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT;
+
+ALTER TABLE messages
+    DROP CONSTRAINT messages_chat_id_fkey,
+    ADD CONSTRAINT messages_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT;
+
+ALTER TABLE invitations
+    DROP CONSTRAINT invitations_chat_id_fkey,
+    ADD CONSTRAINT invitations_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT;
+
+ALTER TABLE participants
+    DROP CONSTRAINT participants_chat_id_fkey,
+    ADD CONSTRAINT participants_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE RESTRICT;
+
+ALTER TABLE chats
+    DROP COLUMN archived_at;
\ No newline at end of file
@@ -0,0 +1,27 @@
+ALTER TABLE chats
+    ADD COLUMN archived_at TIMESTAMPTZ NULL;
+
+-- Содержимое чата удаляется вместе с чатом
+ALTER TABLE participants
+    DROP CONSTRAINT participants_chat_id_fkey,
+    ADD CONSTRAINT participants_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE;
+
+ALTER TABLE invitations
+    DROP CONSTRAINT invitations_chat_id_fkey,
+    ADD CONSTRAINT invitations_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE;
+
+ALTER TABLE messages
+    DROP CONSTRAINT messages_chat_id_fkey,
+    ADD CONSTRAINT messages_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE;
+
+ALTER TABLE attachments
+    DROP CONSTRAINT attachments_chat_id_fkey,
+    ADD CONSTRAINT attachments_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE;
+
+ALTER TABLE chat_pins
+    DROP CONSTRAINT chat_pins_chat_id_fkey,
+    ADD CONSTRAINT chat_pins_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE;
+
+ALTER TABLE scheduled_messages
+    DROP CONSTRAINT scheduled_messages_chat_id_fkey,
+    ADD CONSTRAINT scheduled_messages_chat_id_fkey FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX chats_direct_key_idx;
+
+ALTER TABLE chats
+    DROP COLUMN kind,
+    DROP COLUMN direct_key;
\ No newline at end of file
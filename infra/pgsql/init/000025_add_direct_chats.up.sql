@@ -0,0 +1,7 @@
+ALTER TABLE chats
+    ADD COLUMN kind       TEXT NOT NULL DEFAULT 'group',
+    ADD COLUMN direct_key TEXT NULL;
+
+-- У пары пользователей может быть только один личный чат
+CREATE UNIQUE INDEX chats_direct_key_idx ON chats (direct_key)
+    WHERE direct_key IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TABLE invite_link_uses;
+
+DROP TABLE invite_links;
\ No newline at end of file
@@ -0,0 +1,29 @@
+CREATE TABLE invite_links
+(
+    id                TEXT PRIMARY KEY,
+    chat_id           TEXT        NOT NULL,
+    token_hash        TEXT        NOT NULL,
+    creator_id        TEXT        NOT NULL,
+    expires_at        TIMESTAMPTZ NULL,
+    max_uses          INTEGER     NOT NULL DEFAULT 0,
+    requires_approval BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at        TIMESTAMPTZ NOT NULL,
+    revoked_at        TIMESTAMPTZ NULL,
+    FOREIGN KEY (chat_id) REFERENCES chats ON DELETE CASCADE
+);
+
+CREATE INDEX invite_links_chat_id_idx ON invite_links (chat_id);
+
+CREATE UNIQUE INDEX invite_links_token_hash_idx ON invite_links (token_hash)
+    WHERE token_hash <> '';
+
+CREATE TABLE invite_link_uses
+(
+    link_id     TEXT        NOT NULL,
+    user_id     TEXT        NOT NULL,
+    status      TEXT        NOT NULL,
+    used_at     TIMESTAMPTZ NOT NULL,
+    reviewed_by TEXT        NULL,
+    PRIMARY KEY (link_id, user_id),
+    FOREIGN KEY (link_id) REFERENCES invite_links ON DELETE CASCADE
+);
\ No newline at end of file
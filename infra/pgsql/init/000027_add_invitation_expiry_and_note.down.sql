@@ -0,0 +1,8 @@
+DROP INDEX invitations_expires_at_idx;
+
+ALTER TABLE invitations
+    DROP COLUMN expires_at;
+ALTER TABLE invitations
+    DROP COLUMN created_at;
+ALTER TABLE invitations
+    DROP COLUMN note;
\ No newline at end of file
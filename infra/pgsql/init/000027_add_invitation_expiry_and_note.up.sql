@@ -0,0 +1,9 @@
+ALTER TABLE invitations
+    ADD COLUMN note TEXT NOT NULL DEFAULT '';
+ALTER TABLE invitations
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE invitations
+    ADD COLUMN expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX invitations_expires_at_idx ON invitations (expires_at)
+    WHERE expires_at IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,5 @@
+package blobStorage
+
+type Config struct {
+	Dir string // Директория локального хранилища содержимого вложений
+}
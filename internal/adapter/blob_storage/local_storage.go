@@ -0,0 +1,99 @@
+package blobStorage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+)
+
+var (
+	ErrInvalidKey = errors.New("некорректный ключ содержимого")
+)
+
+// LocalStorage хранит содержимое вложений в файлах локальной файловой системы.
+// Каждому ключу соответствует файл в директории Dir
+type LocalStorage struct {
+	Dir string // Директория для хранения файлов
+}
+
+// Put сохраняет содержимое под ключом key.
+// Файл сначала записывается во временный, а затем переименовывается,
+// поэтому читатели никогда не видят частично записанное содержимое
+func (s *LocalStorage) Put(key string, content io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(s.Dir, 0o750); err != nil {
+		return fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	// Записать содержимое во временный файл
+	tmp, err := os.CreateTemp(s.Dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("os.CreateTemp: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err = io.Copy(tmp, content); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("io.Copy: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("tmp.Close: %w", err)
+	}
+
+	// Переместить временный файл на постоянное место
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("os.Rename: %w", err)
+	}
+
+	return nil
+}
+
+// Get возвращает содержимое по ключу key
+func (s *LocalStorage) Get(key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, attachmentt.ErrBlobNotExists
+	} else if err != nil {
+		return nil, fmt.Errorf("os.Open: %w", err)
+	}
+
+	return f, nil
+}
+
+// Delete удаляет содержимое по ключу key
+func (s *LocalStorage) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("os.Remove: %w", err)
+	}
+
+	return nil
+}
+
+// path возвращает путь к файлу для ключа key.
+// Ключ не может выходить за пределы директории хранилища
+func (s *LocalStorage) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || strings.HasPrefix(key, ".") {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(s.Dir, key), nil
+}
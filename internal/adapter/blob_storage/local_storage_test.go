@@ -0,0 +1,91 @@
+package blobStorage
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+)
+
+func Test_LocalStorage(t *testing.T) {
+	t.Run("сохраненное содержимое можно прочитать", func(t *testing.T) {
+		s := &LocalStorage{Dir: t.TempDir()}
+		key := uuid.NewString()
+		require.NoError(t, s.Put(key, strings.NewReader("content")))
+
+		r, err := s.Get(key)
+		require.NoError(t, err)
+		defer func() { _ = r.Close() }()
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(b))
+	})
+
+	t.Run("повторное сохранение перезаписывает содержимое", func(t *testing.T) {
+		s := &LocalStorage{Dir: t.TempDir()}
+		key := uuid.NewString()
+		require.NoError(t, s.Put(key, strings.NewReader("first")))
+		require.NoError(t, s.Put(key, strings.NewReader("second")))
+
+		r, err := s.Get(key)
+		require.NoError(t, err)
+		defer func() { _ = r.Close() }()
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "second", string(b))
+	})
+
+	t.Run("директория создается при первом сохранении", func(t *testing.T) {
+		s := &LocalStorage{Dir: t.TempDir() + "/nested/dir"}
+		require.NoError(t, s.Put(uuid.NewString(), strings.NewReader("content")))
+	})
+
+	t.Run("после сохранения не остается временных файлов", func(t *testing.T) {
+		s := &LocalStorage{Dir: t.TempDir()}
+		key := uuid.NewString()
+		require.NoError(t, s.Put(key, strings.NewReader("content")))
+
+		entries, err := os.ReadDir(s.Dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, key, entries[0].Name())
+	})
+
+	t.Run("чтение несуществующего содержимого вернет ошибку", func(t *testing.T) {
+		s := &LocalStorage{Dir: t.TempDir()}
+		r, err := s.Get(uuid.NewString())
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, attachmentt.ErrBlobNotExists)
+	})
+
+	t.Run("удаленное содержимое нельзя прочитать", func(t *testing.T) {
+		s := &LocalStorage{Dir: t.TempDir()}
+		key := uuid.NewString()
+		require.NoError(t, s.Put(key, strings.NewReader("content")))
+		require.NoError(t, s.Delete(key))
+
+		_, err := s.Get(key)
+		assert.ErrorIs(t, err, attachmentt.ErrBlobNotExists)
+	})
+
+	t.Run("удаление несуществующего содержимого не является ошибкой", func(t *testing.T) {
+		s := &LocalStorage{Dir: t.TempDir()}
+		assert.NoError(t, s.Delete(uuid.NewString()))
+	})
+
+	t.Run("ключ не может выходить за пределы директории", func(t *testing.T) {
+		s := &LocalStorage{Dir: t.TempDir()}
+		for _, key := range []string{"", ".", "..", "../key", "dir/key", ".hidden"} {
+			assert.ErrorIs(t, s.Put(key, strings.NewReader("content")), ErrInvalidKey, key)
+			_, err := s.Get(key)
+			assert.ErrorIs(t, err, ErrInvalidKey, key)
+			assert.ErrorIs(t, s.Delete(key), ErrInvalidKey, key)
+		}
+	})
+}
@@ -116,6 +116,9 @@ func (u *EventsBus) Consume(ee []events.Event) {
 	for _, event := range ee {
 		// Найти получателей события
 		recipients := lo.Filter(snapshot, func(l *listener, _ int) bool {
+			if slices.Contains(event.ExcludedSessions, l.sessionID) {
+				return false
+			}
 			return slices.ContainsFunc(event.Recipients, func(userID uuid.UUID) bool {
 				return l.userID == userID
 			})
@@ -156,6 +156,36 @@ func Test_EventsBus(t *testing.T) {
 		assert.Equal(t, 3, eventsCountByUserID[userIDs[2]])
 	})
 
+	t.Run("события не приходят в исключенные сессии пользователя", func(t *testing.T) {
+		b := new(EventsBus)
+		userID := uuid.New()
+		sessionIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+		// Счетчик событий
+		eventsCountBySessionID := map[uuid.UUID]int{}
+		var mu sync.Mutex
+
+		// Запустить прослушивание в нескольких сессиях пользователя
+		for _, sessionID := range sessionIDs {
+			_, err := b.AddListener(userID, sessionID, func(event events.Event, _ error) {
+				mu.Lock()
+				eventsCountBySessionID[sessionID]++
+				mu.Unlock()
+			})
+			require.NoError(t, err)
+		}
+
+		// Отправить событие, исключив первую сессию
+		b.Consume([]events.Event{{
+			Recipients:       []uuid.UUID{userID},
+			ExcludedSessions: []uuid.UUID{sessionIDs[0]},
+		}})
+
+		// Проверить, что событие получили только остальные сессии
+		assert.Equal(t, 0, eventsCountBySessionID[sessionIDs[0]])
+		assert.Equal(t, 1, eventsCountBySessionID[sessionIDs[1]])
+		assert.Equal(t, 1, eventsCountBySessionID[sessionIDs[2]])
+	})
+
 	t.Run("прослушивание можно отменять стороной шины", func(t *testing.T) {
 		b := new(EventsBus)
 
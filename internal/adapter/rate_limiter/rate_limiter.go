@@ -0,0 +1,45 @@
+package rateLimiter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RateLimiter ограничивает частоту операций: по каждому ключу разрешается
+// не более одной операции за интервал Interval
+type RateLimiter struct {
+	Interval time.Duration // Минимальный интервал между операциями по одному ключу
+
+	allowedAt      map[uuid.UUID]time.Time // Время последней разрешенной операции по ключу
+	allowedAtMutex sync.Mutex              // Синхронизация доступа к allowedAt
+}
+
+// Allow сообщает, разрешена ли операция по ключу в данный момент.
+// Разрешенная операция запоминается и блокирует следующие до истечения интервала
+func (r *RateLimiter) Allow(key uuid.UUID) bool {
+	r.allowedAtMutex.Lock()
+	defer r.allowedAtMutex.Unlock()
+
+	now := time.Now()
+	if r.allowedAt == nil {
+		r.allowedAt = make(map[uuid.UUID]time.Time)
+	}
+
+	// Запретить операцию, если интервал еще не истек
+	if last, ok := r.allowedAt[key]; ok && now.Sub(last) < r.Interval {
+		return false
+	}
+
+	// Удалить устаревшие записи, чтобы не накапливать ключи
+	for k, last := range r.allowedAt {
+		if now.Sub(last) >= r.Interval {
+			delete(r.allowedAt, k)
+		}
+	}
+
+	r.allowedAt[key] = now
+
+	return true
+}
@@ -0,0 +1,67 @@
+package rateLimiter
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RateLimiter(t *testing.T) {
+	t.Run("первая операция по ключу разрешена", func(t *testing.T) {
+		r := &RateLimiter{Interval: time.Minute}
+		assert.True(t, r.Allow(uuid.New()))
+	})
+
+	t.Run("повторная операция до истечения интервала запрещена", func(t *testing.T) {
+		r := &RateLimiter{Interval: time.Minute}
+		key := uuid.New()
+		assert.True(t, r.Allow(key))
+		assert.False(t, r.Allow(key))
+	})
+
+	t.Run("ключи ограничиваются независимо", func(t *testing.T) {
+		r := &RateLimiter{Interval: time.Minute}
+		assert.True(t, r.Allow(uuid.New()))
+		assert.True(t, r.Allow(uuid.New()))
+	})
+
+	t.Run("после истечения интервала операция снова разрешена", func(t *testing.T) {
+		r := &RateLimiter{Interval: 10 * time.Millisecond}
+		key := uuid.New()
+		assert.True(t, r.Allow(key))
+		time.Sleep(20 * time.Millisecond)
+		assert.True(t, r.Allow(key))
+	})
+
+	t.Run("устаревшие ключи удаляются", func(t *testing.T) {
+		r := &RateLimiter{Interval: 10 * time.Millisecond}
+		for range 10 {
+			r.Allow(uuid.New())
+		}
+		time.Sleep(20 * time.Millisecond)
+		r.Allow(uuid.New())
+		assert.Len(t, r.allowedAt, 1)
+	})
+
+	t.Run("при конкурентных вызовах разрешается только одна операция", func(t *testing.T) {
+		r := &RateLimiter{Interval: time.Minute}
+		key := uuid.New()
+		var allowed atomic.Int32
+		var wg sync.WaitGroup
+		for range 100 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if r.Allow(key) {
+					allowed.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), allowed.Load())
+	})
+}
@@ -0,0 +1,85 @@
+package webhookSender
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+)
+
+// ErrNonPublicAddr ошибка соединения с адресом, не ведущим во внешнюю сеть
+var ErrNonPublicAddr = errors.New("адрес получателя вебхука не ведет во внешнюю сеть")
+
+// HttpSender отправляет вебхуки POST запросами по HTTP.
+// Успешными считаются только ответы со статусом 2xx.
+// Соединения устанавливаются только с публичными IP-адресами: адрес проверяется
+// после разрешения имени, поэтому имя, указывающее во внутреннюю сеть, тоже будет отклонено
+type HttpSender struct {
+	Timeout           time.Duration // Максимальное время ожидания ответа
+	AllowNonPublicIPs bool          // Разрешить соединения с непубличными адресами, например в тестах
+}
+
+// Send отправляет тело body с заголовками headers на адрес url.
+func (s *HttpSender) Send(url string, headers map[string]string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	dialer := &net.Dialer{
+		Timeout: s.Timeout,
+		Control: s.control,
+	}
+	client := &http.Client{
+		Timeout: s.Timeout,
+		Transport: &http.Transport{
+			// Прокси не используется, иначе проверялся бы адрес прокси, а не получателя
+			Proxy:             nil,
+			DialContext:       dialer.DialContext,
+			DisableKeepAlives: true,
+		},
+		// Перенаправления не выполняются, чтобы подписанное тело не ушло на другой адрес
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("client.Do: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("получатель ответил статусом %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// control проверяет адрес, с которым устанавливается соединение
+func (s *HttpSender) control(_, address string, _ syscall.RawConn) error {
+	if s.AllowNonPublicIPs {
+		return nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("netip.ParseAddrPort: %w", err)
+	}
+	if !userr.IsPublicWebhookAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddr, addrPort.Addr())
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package webhookSender
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HttpSender(t *testing.T) {
+	t.Run("тело и заголовки доходят до получателя", func(t *testing.T) {
+		var gotBody []byte
+		var gotHeader string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotBody, _ = io.ReadAll(r.Body)
+			gotHeader = r.Header.Get("X-Test")
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		s := &HttpSender{Timeout: time.Second, AllowNonPublicIPs: true}
+		require.NoError(t, s.Send(srv.URL, map[string]string{"X-Test": "value"}, []byte("{}")))
+		assert.Equal(t, "{}", string(gotBody))
+		assert.Equal(t, "value", gotHeader)
+	})
+
+	t.Run("неуспешный статус это ошибка", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		s := &HttpSender{Timeout: time.Second, AllowNonPublicIPs: true}
+		assert.Error(t, s.Send(srv.URL, nil, []byte("{}")))
+	})
+
+	t.Run("перенаправление не выполняется", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/other", http.StatusFound)
+		}))
+		defer srv.Close()
+
+		s := &HttpSender{Timeout: time.Second, AllowNonPublicIPs: true}
+		assert.Error(t, s.Send(srv.URL, nil, []byte("{}")))
+	})
+
+	t.Run("недоступный получатель это ошибка", func(t *testing.T) {
+		s := &HttpSender{Timeout: time.Second, AllowNonPublicIPs: true}
+		assert.Error(t, s.Send("http://127.0.0.1:1", nil, []byte("{}")))
+	})
+
+	t.Run("соединение с непубличным адресом запрещено", func(t *testing.T) {
+		var called bool
+		srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			called = true
+		}))
+		defer srv.Close()
+
+		s := &HttpSender{Timeout: time.Second}
+		assert.ErrorIs(t, s.Send(srv.URL, nil, []byte("{}")), ErrNonPublicAddr)
+		assert.False(t, called)
+	})
+
+	t.Run("имя, указывающее на непубличный адрес, тоже запрещено", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+		defer srv.Close()
+
+		s := &HttpSender{Timeout: time.Second}
+		assert.ErrorIs(t, s.Send(strings.Replace(srv.URL, "127.0.0.1", "localhost", 1), nil, []byte("{}")), ErrNonPublicAddr)
+	})
+}
@@ -3,14 +3,20 @@ package app
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
+	blobStorage "github.com/nice-pea/npchat/internal/adapter/blob_storage"
 	eventsBus "github.com/nice-pea/npchat/internal/adapter/events_bus"
 	jwt2 "github.com/nice-pea/npchat/internal/adapter/jwt"
 	jwtIssuer "github.com/nice-pea/npchat/internal/adapter/jwt/issuer"
 	jwtParser "github.com/nice-pea/npchat/internal/adapter/jwt/parser"
 	oauthProvider "github.com/nice-pea/npchat/internal/adapter/oauth_provider"
+	rateLimiter "github.com/nice-pea/npchat/internal/adapter/rate_limiter"
+	webhookSender "github.com/nice-pea/npchat/internal/adapter/webhook_sender"
 	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
 	registerHandler "github.com/nice-pea/npchat/internal/controller/http2/register_handler"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
 	"github.com/nice-pea/npchat/internal/usecases/users/oauth"
 )
 
@@ -19,12 +25,21 @@ type adapters struct {
 	eventBus       *eventsBus.EventsBus
 	jwtParser      middleware.JwtParser
 	jwtIssuer      registerHandler.JwtIssuer
+	typingLimiter  *rateLimiter.RateLimiter
+	blobStorage    attachmentt.Storage
+	webhookSender  webhookk.Sender
 }
 
 func (a *adapters) OauthProviders() oauth.Providers {
 	return a.oauthProviders
 }
 
+// typingInterval минимальный интервал между уведомлениями о наборе текста от одной сессии
+const typingInterval = 2 * time.Second
+
+// webhookTimeout максимальное время ожидания ответа получателя вебхука
+const webhookTimeout = 10 * time.Second
+
 func initAdapters(cfg Config) (*adapters, error) {
 	oauthProviders := oauth.Providers{}
 	if cfg.OauthGoogle != (oauthProvider.GoogleConfig{}) {
@@ -58,5 +73,8 @@ func initAdapters(cfg Config) (*adapters, error) {
 		eventBus:       new(eventsBus.EventsBus),
 		jwtParser:      jwtParser2,
 		jwtIssuer:      jwtIssuer2,
+		typingLimiter:  &rateLimiter.RateLimiter{Interval: typingInterval},
+		blobStorage:    &blobStorage.LocalStorage{Dir: cfg.BlobStorage.Dir},
+		webhookSender:  &webhookSender.HttpSender{Timeout: webhookTimeout},
 	}, nil
 }
@@ -9,6 +9,10 @@ import (
 
 	"github.com/nice-pea/npchat/internal/common"
 	"github.com/nice-pea/npchat/internal/controller/http2"
+	removeExpiredInvitations "github.com/nice-pea/npchat/internal/usecases/chats/remove_expired_invitations"
+	deliverScheduledMessages "github.com/nice-pea/npchat/internal/usecases/messages/deliver_scheduled_messages"
+	purgeExpiredMessages "github.com/nice-pea/npchat/internal/usecases/messages/purge_expired_messages"
+	deliverWebhooks "github.com/nice-pea/npchat/internal/usecases/webhooks/deliver_webhooks"
 )
 
 func Run(ctx context.Context, cfg Config, buildInfo common.BuildInfo) error {
@@ -30,13 +34,53 @@ func Run(ctx context.Context, cfg Config, buildInfo common.BuildInfo) error {
 		return err
 	}
 
+	// События доставляются подписчикам шины и ботам через вебхуки
+	eventConsumer := newEventConsumer(rr, aa)
+
 	// Инициализация сервисов
-	uc := initUsecases(rr, aa)
+	uc := initUsecases(cfg, rr, aa, eventConsumer)
 
 	// Инициализация и Запуск http контроллера
 	g.Go(func() error {
 		return http2.RunHttpServer(ctx, uc, aa.eventBus, aa.jwtIssuer, aa.jwtParser, cfg.Http2, buildInfo)
 	})
 
+	// Запуск фоновой отправки отложенных сообщений.
+	// Сообщения отправляются тем же сценарием, что и обычные
+	g.Go(func() error {
+		return runScheduledMessagesWorker(ctx, &deliverScheduledMessages.DeliverScheduledMessagesUsecase{
+			Repo:   rr.scheduled,
+			Sender: uc.SendMessageUsecase,
+		})
+	})
+
+	// Запуск фонового удаления сообщений с истекшим сроком хранения
+	g.Go(func() error {
+		return runPurgeExpiredMessagesWorker(ctx, &purgeExpiredMessages.PurgeExpiredMessagesUsecase{
+			Repo:            rr.messages,
+			ChatsRepo:       rr.chats,
+			AttachmentsRepo: rr.attachments,
+			Storage:         aa.blobStorage,
+			EventConsumer:   eventConsumer,
+		})
+	})
+
+	// Запуск фонового удаления приглашений с истекшим сроком действия
+	g.Go(func() error {
+		return runRemoveExpiredInvitationsWorker(ctx, &removeExpiredInvitations.RemoveExpiredInvitationsUsecase{
+			Repo:          rr.chats,
+			EventConsumer: eventConsumer,
+		})
+	})
+
+	// Запуск фоновой доставки событий ботам через вебхуки
+	g.Go(func() error {
+		return runWebhookDeliveryWorker(ctx, &deliverWebhooks.DeliverWebhooksUsecase{
+			Repo:      rr.webhooks,
+			UsersRepo: rr.users,
+			Sender:    aa.webhookSender,
+		})
+	})
+
 	return g.Wait()
 }
@@ -1,6 +1,7 @@
 package app
 
 import (
+	blobStorage "github.com/nice-pea/npchat/internal/adapter/blob_storage"
 	jwt2 "github.com/nice-pea/npchat/internal/adapter/jwt"
 	oauthProvider "github.com/nice-pea/npchat/internal/adapter/oauth_provider"
 	"github.com/nice-pea/npchat/internal/controller/http2"
@@ -14,4 +15,7 @@ type Config struct {
 	OauthGoogle oauthProvider.GoogleConfig
 	OauthGithub oauthProvider.GithubConfig
 	Jwt         jwt2.Config
+	BlobStorage blobStorage.Config
+
+	MaxPinsPerChat int // Максимальное количество закрепленных сообщений в чате
 }
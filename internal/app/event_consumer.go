@@ -0,0 +1,35 @@
+package app
+
+import (
+	"log/slog"
+
+	eventsBus "github.com/nice-pea/npchat/internal/adapter/events_bus"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	enqueueWebhooks "github.com/nice-pea/npchat/internal/usecases/webhooks/enqueue_webhooks"
+)
+
+// eventConsumer передает события подписчикам шины и ставит в очередь их доставку ботам через вебхуки
+type eventConsumer struct {
+	bus      *eventsBus.EventsBus
+	webhooks *enqueueWebhooks.EnqueueWebhooksUsecase
+}
+
+func newEventConsumer(rr *repositories, aa *adapters) *eventConsumer {
+	return &eventConsumer{
+		bus: aa.eventBus,
+		webhooks: &enqueueWebhooks.EnqueueWebhooksUsecase{
+			Repo:      rr.webhooks,
+			UsersRepo: rr.users,
+		},
+	}
+}
+
+// Consume помещает события в шину и в очередь доставки вебхуков.
+// Ошибка постановки в очередь только логируется, потому что Consume не может вернуть ошибку
+func (c *eventConsumer) Consume(ee []events.Event) {
+	c.bus.Consume(ee)
+
+	if _, err := c.webhooks.EnqueueWebhooks(enqueueWebhooks.In{Events: ee}); err != nil {
+		slog.Error("Поставить в очередь вебхуки: uc.EnqueueWebhooks: " + err.Error())
+	}
+}
@@ -4,16 +4,30 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
 	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
 	"github.com/nice-pea/npchat/internal/domain/sessionn"
 	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
 	pgsqlRepository "github.com/nice-pea/npchat/internal/repository/pgsql_repository"
 )
 
 type repositories struct {
-	chats    chatt.Repository
-	users    userr.Repository
-	sessions sessionn.Repository
+	attachments  attachmentt.Repository
+	chats        chatt.Repository
+	drafts       draftt.Repository
+	integrations integrationn.Repository
+	inviteLinks  invitelinkk.Repository
+	messages     messagee.Repository
+	scheduled    schedulee.Repository
+	users        userr.Repository
+	sessions     sessionn.Repository
+	webhooks     webhookk.Repository
 }
 
 func initPgsqlRepositories(cfg pgsqlRepository.Config) (*repositories, func(), error) {
@@ -23,9 +37,16 @@ func initPgsqlRepositories(cfg pgsqlRepository.Config) (*repositories, func(), e
 	}
 
 	rs := &repositories{
-		chats:    factory.NewChattRepository(),
-		users:    factory.NewUserrRepository(),
-		sessions: factory.NewSessionnRepository(),
+		attachments:  factory.NewAttachmenttRepository(),
+		chats:        factory.NewChattRepository(),
+		drafts:       factory.NewDrafttRepository(),
+		integrations: factory.NewIntegrationnRepository(),
+		inviteLinks:  factory.NewInvitelinkkRepository(),
+		messages:     factory.NewMessageeRepository(),
+		scheduled:    factory.NewScheduleeRepository(),
+		users:        factory.NewUserrRepository(),
+		sessions:     factory.NewSessionnRepository(),
+		webhooks:     factory.NewWebhookkRepository(),
 	}
 
 	closer := func() {
@@ -1,20 +1,75 @@
 package app
 
 import (
+	downloadAttachment "github.com/nice-pea/npchat/internal/usecases/attachments/download_attachment"
+	uploadAttachment "github.com/nice-pea/npchat/internal/usecases/attachments/upload_attachment"
 	acceptInvitation "github.com/nice-pea/npchat/internal/usecases/chats/accept_invitation"
+	archiveChat "github.com/nice-pea/npchat/internal/usecases/chats/archive_chat"
 	cancelInvitation "github.com/nice-pea/npchat/internal/usecases/chats/cancel_invitation"
 	chatInvitations "github.com/nice-pea/npchat/internal/usecases/chats/chat_invitations"
 	chatMembers "github.com/nice-pea/npchat/internal/usecases/chats/chat_members"
 	createChat "github.com/nice-pea/npchat/internal/usecases/chats/create_chat"
+	createDirectChat "github.com/nice-pea/npchat/internal/usecases/chats/create_direct_chat"
+	declineInvitation "github.com/nice-pea/npchat/internal/usecases/chats/decline_invitation"
+	deleteChat "github.com/nice-pea/npchat/internal/usecases/chats/delete_chat"
 	deleteMember "github.com/nice-pea/npchat/internal/usecases/chats/delete_member"
+	forceTransferChief "github.com/nice-pea/npchat/internal/usecases/chats/force_transfer_chief"
 	leaveChat "github.com/nice-pea/npchat/internal/usecases/chats/leave_chat"
 	myChats "github.com/nice-pea/npchat/internal/usecases/chats/my_chats"
 	receivedInvitations "github.com/nice-pea/npchat/internal/usecases/chats/received_invitations"
 	sendInvitation "github.com/nice-pea/npchat/internal/usecases/chats/send_invitation"
+	setParticipantRole "github.com/nice-pea/npchat/internal/usecases/chats/set_participant_role"
+	setRetention "github.com/nice-pea/npchat/internal/usecases/chats/set_retention"
+	transferChief "github.com/nice-pea/npchat/internal/usecases/chats/transfer_chief"
+	"github.com/nice-pea/npchat/internal/usecases/chats/typing"
+	unarchiveChat "github.com/nice-pea/npchat/internal/usecases/chats/unarchive_chat"
 	updateName "github.com/nice-pea/npchat/internal/usecases/chats/update_name"
+	"github.com/nice-pea/npchat/internal/usecases/commands"
+	replyCommand "github.com/nice-pea/npchat/internal/usecases/commands/reply_command"
+	runCommand "github.com/nice-pea/npchat/internal/usecases/commands/run_command"
+	clearDraft "github.com/nice-pea/npchat/internal/usecases/drafts/clear_draft"
+	putDraft "github.com/nice-pea/npchat/internal/usecases/drafts/put_draft"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	chatWebhooks "github.com/nice-pea/npchat/internal/usecases/integrations/chat_webhooks"
+	createWebhook "github.com/nice-pea/npchat/internal/usecases/integrations/create_webhook"
+	postWebhookMessage "github.com/nice-pea/npchat/internal/usecases/integrations/post_webhook_message"
+	revokeWebhook "github.com/nice-pea/npchat/internal/usecases/integrations/revoke_webhook"
+	rotateWebhookToken "github.com/nice-pea/npchat/internal/usecases/integrations/rotate_webhook_token"
+	approveJoinRequest "github.com/nice-pea/npchat/internal/usecases/invite_links/approve_join_request"
+	chatInviteLinks "github.com/nice-pea/npchat/internal/usecases/invite_links/chat_invite_links"
+	createInviteLink "github.com/nice-pea/npchat/internal/usecases/invite_links/create_invite_link"
+	joinByLink "github.com/nice-pea/npchat/internal/usecases/invite_links/join_by_link"
+	rejectJoinRequest "github.com/nice-pea/npchat/internal/usecases/invite_links/reject_join_request"
+	revokeInviteLink "github.com/nice-pea/npchat/internal/usecases/invite_links/revoke_invite_link"
+	addReaction "github.com/nice-pea/npchat/internal/usecases/messages/add_reaction"
+	cancelScheduledMessage "github.com/nice-pea/npchat/internal/usecases/messages/cancel_scheduled_message"
+	chatMessages "github.com/nice-pea/npchat/internal/usecases/messages/chat_messages"
+	chatPins "github.com/nice-pea/npchat/internal/usecases/messages/chat_pins"
+	createPoll "github.com/nice-pea/npchat/internal/usecases/messages/create_poll"
+	deleteMessage "github.com/nice-pea/npchat/internal/usecases/messages/delete_message"
+	editMessage "github.com/nice-pea/npchat/internal/usecases/messages/edit_message"
+	forwardMessage "github.com/nice-pea/npchat/internal/usecases/messages/forward_message"
+	markRead "github.com/nice-pea/npchat/internal/usecases/messages/mark_read"
+	"github.com/nice-pea/npchat/internal/usecases/messages/mentions"
+	messageRevisions "github.com/nice-pea/npchat/internal/usecases/messages/message_revisions"
+	pinMessage "github.com/nice-pea/npchat/internal/usecases/messages/pin_message"
+	removeReaction "github.com/nice-pea/npchat/internal/usecases/messages/remove_reaction"
+	retractVote "github.com/nice-pea/npchat/internal/usecases/messages/retract_vote"
+	scheduleMessage "github.com/nice-pea/npchat/internal/usecases/messages/schedule_message"
+	scheduledMessages "github.com/nice-pea/npchat/internal/usecases/messages/scheduled_messages"
+	searchMessages "github.com/nice-pea/npchat/internal/usecases/messages/search_messages"
+	sendMessage "github.com/nice-pea/npchat/internal/usecases/messages/send_message"
+	threadMessages "github.com/nice-pea/npchat/internal/usecases/messages/thread_messages"
+	unpinMessage "github.com/nice-pea/npchat/internal/usecases/messages/unpin_message"
+	votePoll "github.com/nice-pea/npchat/internal/usecases/messages/vote_poll"
 	findSession "github.com/nice-pea/npchat/internal/usecases/sessions/find_session"
 	basicAuthLogin "github.com/nice-pea/npchat/internal/usecases/users/basic_auth/basic_auth_login"
 	basicAuthRegistration "github.com/nice-pea/npchat/internal/usecases/users/basic_auth/basic_auth_registration"
+	authenticateBot "github.com/nice-pea/npchat/internal/usecases/users/bots/authenticate_bot"
+	createBot "github.com/nice-pea/npchat/internal/usecases/users/bots/create_bot"
+	myBots "github.com/nice-pea/npchat/internal/usecases/users/bots/my_bots"
+	rotateBotToken "github.com/nice-pea/npchat/internal/usecases/users/bots/rotate_bot_token"
+	setBotCommands "github.com/nice-pea/npchat/internal/usecases/users/bots/set_bot_commands"
 	oauthAuthorize "github.com/nice-pea/npchat/internal/usecases/users/oauth/oauth_authorize"
 	oauthComplete "github.com/nice-pea/npchat/internal/usecases/users/oauth/oauth_complete"
 	userProfile "github.com/nice-pea/npchat/internal/usecases/users/user_profile"
@@ -25,20 +80,64 @@ type usecasesBase struct {
 
 	*findSession.FindSessionsUsecase
 
+	// Attachments
+
+	*downloadAttachment.DownloadAttachmentUsecase
+	*uploadAttachment.UploadAttachmentUsecase
+
 	// Chats
 
 	*acceptInvitation.AcceptInvitationUsecase
+	*archiveChat.ArchiveChatUsecase
 	*cancelInvitation.CancelInvitationUsecase
 	*chatInvitations.ChatInvitationsUsecase
 	*chatMembers.ChatMembersUsecase
 	*createChat.CreateChatUsecase
+	*createDirectChat.CreateDirectChatUsecase
+	*declineInvitation.DeclineInvitationUsecase
+	*deleteChat.DeleteChatUsecase
 	*deleteMember.DeleteMemberUsecase
+	*forceTransferChief.ForceTransferChiefUsecase
 	*leaveChat.LeaveChatUsecase
 	*myChats.MyChatsUsecase
 	*receivedInvitations.ReceivedInvitationsUsecase
 	*sendInvitation.SendInvitationUsecase
+	*setParticipantRole.SetParticipantRoleUsecase
+	*setRetention.SetRetentionUsecase
+	*transferChief.TransferChiefUsecase
+	*typing.TypingUsecase
+	*unarchiveChat.UnarchiveChatUsecase
 	*updateName.UpdateNameUsecase
 
+	// Messages
+
+	*addReaction.AddReactionUsecase
+	*cancelScheduledMessage.CancelScheduledMessageUsecase
+	*chatMessages.ChatMessagesUsecase
+	*chatPins.ChatPinsUsecase
+	*createPoll.CreatePollUsecase
+	*deleteMessage.DeleteMessageUsecase
+	*editMessage.EditMessageUsecase
+	*forwardMessage.ForwardMessageUsecase
+	*markRead.MarkReadUsecase
+	*mentions.MentionsUsecase
+	*messageRevisions.MessageRevisionsUsecase
+	*pinMessage.PinMessageUsecase
+	*removeReaction.RemoveReactionUsecase
+	*retractVote.RetractVoteUsecase
+	*scheduleMessage.ScheduleMessageUsecase
+	*scheduledMessages.ScheduledMessagesUsecase
+	*searchMessages.SearchMessagesUsecase
+	*sendMessage.SendMessageUsecase
+	*threadMessages.ThreadMessagesUsecase
+	*unpinMessage.UnpinMessageUsecase
+	*votePoll.VotePollUsecase
+
+	// Drafts
+
+	*clearDraft.ClearDraftUsecase
+	*putDraft.PutDraftUsecase
+
 	// Users
 
 	*basicAuthRegistration.BasicAuthRegistrationUsecase
@@ -46,20 +145,67 @@ type usecasesBase struct {
 	*oauthAuthorize.OauthAuthorizeUsecase
 	*oauthComplete.OauthCompleteUsecase
 	*userProfile.UserProfileUsecase
+
+	// Bots
+
+	*authenticateBot.AuthenticateBotUsecase
+	*createBot.CreateBotUsecase
+	*myBots.MyBotsUsecase
+	*rotateBotToken.RotateBotTokenUsecase
+	*setBotCommands.SetBotCommandsUsecase
+
+	// Commands
+
+	*replyCommand.ReplyCommandUsecase
+
+	// Integrations
+
+	*chatWebhooks.ChatWebhooksUsecase
+	*createWebhook.CreateWebhookUsecase
+	*postWebhookMessage.PostWebhookMessageUsecase
+	*revokeWebhook.RevokeWebhookUsecase
+	*rotateWebhookToken.RotateWebhookTokenUsecase
+
+	// Invite links
+
+	*approveJoinRequest.ApproveJoinRequestUsecase
+	*chatInviteLinks.ChatInviteLinksUsecase
+	*createInviteLink.CreateInviteLinkUsecase
+	*joinByLink.JoinByLinkUsecase
+	*rejectJoinRequest.RejectJoinRequestUsecase
+	*revokeInviteLink.RevokeInviteLinkUsecase
 }
 
-func initUsecases(rr *repositories, aa *adapters) usecasesBase {
-	return usecasesBase{
+func initUsecases(cfg Config, rr *repositories, aa *adapters, eventConsumer events.Consumer) usecasesBase {
+	uc := usecasesBase{
 		FindSessionsUsecase: &findSession.FindSessionsUsecase{
 			Repo: rr.sessions,
 		},
+		DownloadAttachmentUsecase: &downloadAttachment.DownloadAttachmentUsecase{
+			Repo:      rr.attachments,
+			ChatsRepo: rr.chats,
+			Storage:   aa.blobStorage,
+		},
+		UploadAttachmentUsecase: &uploadAttachment.UploadAttachmentUsecase{
+			Repo:      rr.attachments,
+			ChatsRepo: rr.chats,
+			Storage:   aa.blobStorage,
+		},
 		AcceptInvitationUsecase: &acceptInvitation.AcceptInvitationUsecase{
 			Repo:          rr.chats,
-			EventConsumer: aa.eventBus,
+			EventConsumer: eventConsumer,
+		},
+		ArchiveChatUsecase: &archiveChat.ArchiveChatUsecase{
+			Repo:          rr.chats,
+			EventConsumer: eventConsumer,
 		},
 		CancelInvitationUsecase: &cancelInvitation.CancelInvitationUsecase{
 			Repo:          rr.chats,
-			EventConsumer: aa.eventBus,
+			EventConsumer: eventConsumer,
+		},
+		DeclineInvitationUsecase: &declineInvitation.DeclineInvitationUsecase{
+			Repo:          rr.chats,
+			EventConsumer: eventConsumer,
 		},
 		ChatInvitationsUsecase: &chatInvitations.ChatInvitationsUsecase{
 			Repo: rr.chats,
@@ -69,29 +215,174 @@ func initUsecases(rr *repositories, aa *adapters) usecasesBase {
 		},
 		CreateChatUsecase: &createChat.CreateChatUsecase{
 			Repo:          rr.chats,
-			EventConsumer: aa.eventBus,
+			EventConsumer: eventConsumer,
+		},
+		CreateDirectChatUsecase: &createDirectChat.CreateDirectChatUsecase{
+			Repo:          rr.chats,
+			UsersRepo:     rr.users,
+			EventConsumer: eventConsumer,
+		},
+		DeleteChatUsecase: &deleteChat.DeleteChatUsecase{
+			Repo:            rr.chats,
+			AttachmentsRepo: rr.attachments,
+			Storage:         aa.blobStorage,
+			EventConsumer:   eventConsumer,
 		},
 		DeleteMemberUsecase: &deleteMember.DeleteMemberUsecase{
 			Repo:          rr.chats,
-			EventConsumer: aa.eventBus,
+			EventConsumer: eventConsumer,
+		},
+		ForceTransferChiefUsecase: &forceTransferChief.ForceTransferChiefUsecase{
+			Repo:          rr.chats,
+			EventConsumer: eventConsumer,
 		},
 		LeaveChatUsecase: &leaveChat.LeaveChatUsecase{
 			Repo:          rr.chats,
-			EventConsumer: aa.eventBus,
+			EventConsumer: eventConsumer,
 		},
 		MyChatsUsecase: &myChats.MyChatsUsecase{
-			Repo: rr.chats,
+			Repo:         rr.chats,
+			MessagesRepo: rr.messages,
+			DraftsRepo:   rr.drafts,
 		},
 		ReceivedInvitationsUsecase: &receivedInvitations.ReceivedInvitationsUsecase{
 			Repo: rr.chats,
 		},
 		SendInvitationUsecase: &sendInvitation.SendInvitationUsecase{
 			Repo:          rr.chats,
-			EventConsumer: aa.eventBus,
+			EventConsumer: eventConsumer,
+		},
+		SetParticipantRoleUsecase: &setParticipantRole.SetParticipantRoleUsecase{
+			Repo:          rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		SetRetentionUsecase: &setRetention.SetRetentionUsecase{
+			Repo:          rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		TransferChiefUsecase: &transferChief.TransferChiefUsecase{
+			Repo:          rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		TypingUsecase: &typing.TypingUsecase{
+			Repo:          rr.chats,
+			RateLimiter:   aa.typingLimiter,
+			EventConsumer: eventConsumer,
+		},
+		UnarchiveChatUsecase: &unarchiveChat.UnarchiveChatUsecase{
+			Repo:          rr.chats,
+			EventConsumer: eventConsumer,
 		},
 		UpdateNameUsecase: &updateName.UpdateNameUsecase{
 			Repo:          rr.chats,
-			EventConsumer: aa.eventBus,
+			EventConsumer: eventConsumer,
+		},
+		AddReactionUsecase: &addReaction.AddReactionUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		CancelScheduledMessageUsecase: &cancelScheduledMessage.CancelScheduledMessageUsecase{
+			Repo: rr.scheduled,
+		},
+		ChatMessagesUsecase: &chatMessages.ChatMessagesUsecase{
+			Repo:      rr.messages,
+			ChatsRepo: rr.chats,
+		},
+		ChatPinsUsecase: &chatPins.ChatPinsUsecase{
+			Repo:      rr.messages,
+			ChatsRepo: rr.chats,
+		},
+		CreatePollUsecase: &createPoll.CreatePollUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		DeleteMessageUsecase: &deleteMessage.DeleteMessageUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		EditMessageUsecase: &editMessage.EditMessageUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			UsersRepo:     rr.users,
+			EventConsumer: eventConsumer,
+		},
+		ForwardMessageUsecase: &forwardMessage.ForwardMessageUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		MarkReadUsecase: &markRead.MarkReadUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		MentionsUsecase: &mentions.MentionsUsecase{
+			Repo:      rr.messages,
+			ChatsRepo: rr.chats,
+		},
+		MessageRevisionsUsecase: &messageRevisions.MessageRevisionsUsecase{
+			Repo:      rr.messages,
+			ChatsRepo: rr.chats,
+		},
+		PinMessageUsecase: &pinMessage.PinMessageUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			MaxPins:       cfg.MaxPinsPerChat,
+			EventConsumer: eventConsumer,
+		},
+		RemoveReactionUsecase: &removeReaction.RemoveReactionUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		RetractVoteUsecase: &retractVote.RetractVoteUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		ScheduleMessageUsecase: &scheduleMessage.ScheduleMessageUsecase{
+			Repo:         rr.scheduled,
+			ChatsRepo:    rr.chats,
+			MessagesRepo: rr.messages,
+		},
+		ScheduledMessagesUsecase: &scheduledMessages.ScheduledMessagesUsecase{
+			Repo: rr.scheduled,
+		},
+		SearchMessagesUsecase: &searchMessages.SearchMessagesUsecase{
+			Repo:      rr.messages,
+			ChatsRepo: rr.chats,
+		},
+		SendMessageUsecase: &sendMessage.SendMessageUsecase{
+			Repo:            rr.messages,
+			ChatsRepo:       rr.chats,
+			AttachmentsRepo: rr.attachments,
+			UsersRepo:       rr.users,
+			EventConsumer:   eventConsumer,
+		},
+		ThreadMessagesUsecase: &threadMessages.ThreadMessagesUsecase{
+			Repo:      rr.messages,
+			ChatsRepo: rr.chats,
+		},
+		UnpinMessageUsecase: &unpinMessage.UnpinMessageUsecase{
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		VotePollUsecase: &votePoll.VotePollUsecase{
+			Repo:          rr.messages,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		ClearDraftUsecase: &clearDraft.ClearDraftUsecase{
+			Repo:          rr.drafts,
+			EventConsumer: eventConsumer,
+		},
+		PutDraftUsecase: &putDraft.PutDraftUsecase{
+			Repo:          rr.drafts,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
 		},
 		BasicAuthRegistrationUsecase: &basicAuthRegistration.BasicAuthRegistrationUsecase{
 			Repo:         rr.users,
@@ -112,5 +403,82 @@ func initUsecases(rr *repositories, aa *adapters) usecasesBase {
 		UserProfileUsecase: &userProfile.UserProfileUsecase{
 			Repo: rr.users,
 		},
+		AuthenticateBotUsecase: &authenticateBot.AuthenticateBotUsecase{
+			Repo: rr.users,
+		},
+		CreateBotUsecase: &createBot.CreateBotUsecase{
+			Repo: rr.users,
+		},
+		MyBotsUsecase: &myBots.MyBotsUsecase{
+			Repo: rr.users,
+		},
+		RotateBotTokenUsecase: &rotateBotToken.RotateBotTokenUsecase{
+			Repo: rr.users,
+		},
+		SetBotCommandsUsecase: &setBotCommands.SetBotCommandsUsecase{
+			Repo: rr.users,
+		},
+		ReplyCommandUsecase: &replyCommand.ReplyCommandUsecase{
+			ChatsRepo:     rr.chats,
+			UsersRepo:     rr.users,
+			EventConsumer: eventConsumer,
+		},
+		ChatWebhooksUsecase: &chatWebhooks.ChatWebhooksUsecase{
+			Repo:      rr.integrations,
+			ChatsRepo: rr.chats,
+		},
+		CreateWebhookUsecase: &createWebhook.CreateWebhookUsecase{
+			Repo:      rr.integrations,
+			ChatsRepo: rr.chats,
+		},
+		PostWebhookMessageUsecase: &postWebhookMessage.PostWebhookMessageUsecase{
+			Repo:          rr.integrations,
+			ChatsRepo:     rr.chats,
+			MessagesRepo:  rr.messages,
+			EventConsumer: eventConsumer,
+		},
+		RevokeWebhookUsecase: &revokeWebhook.RevokeWebhookUsecase{
+			Repo:      rr.integrations,
+			ChatsRepo: rr.chats,
+		},
+		RotateWebhookTokenUsecase: &rotateWebhookToken.RotateWebhookTokenUsecase{
+			Repo:      rr.integrations,
+			ChatsRepo: rr.chats,
+		},
+		ApproveJoinRequestUsecase: &approveJoinRequest.ApproveJoinRequestUsecase{
+			Repo:          rr.inviteLinks,
+			EventConsumer: eventConsumer,
+		},
+		ChatInviteLinksUsecase: &chatInviteLinks.ChatInviteLinksUsecase{
+			Repo:      rr.inviteLinks,
+			ChatsRepo: rr.chats,
+		},
+		CreateInviteLinkUsecase: &createInviteLink.CreateInviteLinkUsecase{
+			Repo:      rr.inviteLinks,
+			ChatsRepo: rr.chats,
+		},
+		JoinByLinkUsecase: &joinByLink.JoinByLinkUsecase{
+			Repo:          rr.inviteLinks,
+			EventConsumer: eventConsumer,
+		},
+		RejectJoinRequestUsecase: &rejectJoinRequest.RejectJoinRequestUsecase{
+			Repo:          rr.inviteLinks,
+			ChatsRepo:     rr.chats,
+			EventConsumer: eventConsumer,
+		},
+		RevokeInviteLinkUsecase: &revokeInviteLink.RevokeInviteLinkUsecase{
+			Repo:      rr.inviteLinks,
+			ChatsRepo: rr.chats,
+		},
 	}
+
+	// Встроенные команды опираются на уже созданные сценарии
+	uc.SendMessageUsecase.Commands = &runCommand.RunCommandUsecase{
+		Registry:      commands.NewBuiltinRegistry(uc, rr.users),
+		ChatsRepo:     rr.chats,
+		UsersRepo:     rr.users,
+		EventConsumer: eventConsumer,
+	}
+
+	return uc
 }
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	removeExpiredInvitations "github.com/nice-pea/npchat/internal/usecases/chats/remove_expired_invitations"
+	deliverScheduledMessages "github.com/nice-pea/npchat/internal/usecases/messages/deliver_scheduled_messages"
+	purgeExpiredMessages "github.com/nice-pea/npchat/internal/usecases/messages/purge_expired_messages"
+	deliverWebhooks "github.com/nice-pea/npchat/internal/usecases/webhooks/deliver_webhooks"
+)
+
+const (
+	// scheduledMessagesInterval период проверки наступивших отложенных сообщений
+	scheduledMessagesInterval = 5 * time.Second
+	// purgeExpiredMessagesInterval период удаления сообщений с истекшим сроком хранения
+	purgeExpiredMessagesInterval = 10 * time.Second
+	// webhookDeliveryInterval период проверки наступивших доставок вебхуков
+	webhookDeliveryInterval = 2 * time.Second
+	// removeExpiredInvitationsInterval период удаления приглашений с истекшим сроком действия
+	removeExpiredInvitationsInterval = 30 * time.Second
+)
+
+// runScheduledMessagesWorker периодически отправляет наступившие отложенные сообщения до момента отмены контекста
+func runScheduledMessagesWorker(ctx context.Context, uc *deliverScheduledMessages.DeliverScheduledMessagesUsecase) error {
+	runEvery(ctx, scheduledMessagesInterval, func(now time.Time) {
+		out, err := uc.DeliverScheduledMessages(deliverScheduledMessages.In{
+			Now: now,
+		})
+		if err != nil {
+			slog.Error("Отправить отложенные сообщения: uc.DeliverScheduledMessages: " + err.Error())
+		}
+		if out.Sent > 0 || out.Failed > 0 {
+			slog.Info(fmt.Sprintf("Отложенные сообщения: отправлено %d, не удалось отправить %d", out.Sent, out.Failed))
+		}
+	})
+
+	return nil
+}
+
+// runPurgeExpiredMessagesWorker периодически удаляет сообщения с истекшим сроком хранения до момента отмены контекста.
+// Сообщения удаляются пачками, пока истекшие сообщения не закончатся
+func runPurgeExpiredMessagesWorker(ctx context.Context, uc *purgeExpiredMessages.PurgeExpiredMessagesUsecase) error {
+	runEvery(ctx, purgeExpiredMessagesInterval, func(now time.Time) {
+		for ctx.Err() == nil {
+			out, err := uc.PurgeExpiredMessages(purgeExpiredMessages.In{
+				Now: now,
+			})
+			if err != nil {
+				slog.Error("Удалить истекшие сообщения: uc.PurgeExpiredMessages: " + err.Error())
+				return
+			}
+			if out.Purged == 0 {
+				return
+			}
+			slog.Info(fmt.Sprintf("Истекшие сообщения: удалено %d", out.Purged))
+		}
+	})
+
+	return nil
+}
+
+// runWebhookDeliveryWorker периодически доставляет ботам события через вебхуки до момента отмены контекста
+func runWebhookDeliveryWorker(ctx context.Context, uc *deliverWebhooks.DeliverWebhooksUsecase) error {
+	runEvery(ctx, webhookDeliveryInterval, func(now time.Time) {
+		out, err := uc.DeliverWebhooks(deliverWebhooks.In{
+			Now: now,
+		})
+		if err != nil {
+			slog.Error("Доставить вебхуки: uc.DeliverWebhooks: " + err.Error())
+		}
+		if out.Delivered > 0 || out.Failed > 0 {
+			slog.Info(fmt.Sprintf("Вебхуки: доставлено %d, не удалось доставить %d", out.Delivered, out.Failed))
+		}
+	})
+
+	return nil
+}
+
+// runRemoveExpiredInvitationsWorker периодически удаляет истекшие приглашения до момента отмены контекста.
+// Чаты обрабатываются пачками, пока истекшие приглашения не закончатся
+func runRemoveExpiredInvitationsWorker(ctx context.Context, uc *removeExpiredInvitations.RemoveExpiredInvitationsUsecase) error {
+	runEvery(ctx, removeExpiredInvitationsInterval, func(now time.Time) {
+		for ctx.Err() == nil {
+			out, err := uc.RemoveExpiredInvitations(removeExpiredInvitations.In{
+				Now: now,
+			})
+			if err != nil {
+				slog.Error("Удалить истекшие приглашения: uc.RemoveExpiredInvitations: " + err.Error())
+				return
+			}
+			if out.Removed == 0 {
+				return
+			}
+			slog.Info(fmt.Sprintf("Истекшие приглашения: удалено %d", out.Removed))
+		}
+	})
+
+	return nil
+}
+
+// runEvery вызывает fn с периодом interval до момента отмены контекста
+func runEvery(ctx context.Context, interval time.Duration, fn func(now time.Time)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			fn(now)
+		}
+	}
+}
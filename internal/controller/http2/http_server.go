@@ -17,7 +17,8 @@ import (
 )
 
 type Config struct {
-	HttpAddr string
+	HttpAddr   string
+	AdminToken string // Токен для административных методов. Если не задан, методы недоступны
 }
 
 // RunHttpServer запускает http сервер до момента отмена контекста
@@ -32,8 +33,13 @@ func RunHttpServer(
 ) error {
 	fiberApp := fiber.New(fiber.Config{
 		ErrorHandler: fiberErrorHandler,
+		// Тела больше BodyLimit не отклоняются сервером, а передаются потоком,
+		// размер ограничивается для каждого маршрута middleware.LimitBody
+		BodyLimit:                    fiber.DefaultBodyLimit,
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
 	})
-	registerHandlers(fiberApp, uc, jwtIssuer, jwtParser, eventListener, buildInfo)
+	registerHandlers(fiberApp, uc, jwtIssuer, jwtParser, eventListener, cfg.AdminToken, buildInfo)
 
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -62,6 +68,7 @@ func registerHandlers(
 	jwtIssuer registerHandler.JwtIssuer,
 	jwtParser middleware.JwtParser,
 	eventListener registerHandler.EventListener,
+	adminToken string,
 	buildInfo common.BuildInfo,
 ) {
 	// Подключение middleware для логирования
@@ -69,6 +76,13 @@ func registerHandlers(
 		TimeFormat: "2006-01-02 15:04:05",
 	}))
 
+	// Загрузка вложений регистрируется до общего ограничения размера тела запроса,
+	// так как допускает тела большего размера
+	registerHandler.UploadAttachment(r, uc, jwtParser)
+
+	// Общее ограничение размера тела запроса
+	r.Use(middleware.LimitBody(fiber.DefaultBodyLimit))
+
 	// Служебные
 	registerHandler.Ping(r)
 	registerHandler.Info(r, buildInfo)
@@ -86,23 +100,103 @@ func registerHandlers(
 	// Чат /chats
 	registerHandler.MyChats(r, uc, jwtParser)
 	registerHandler.CreateChat(r, uc, jwtParser)
+	registerHandler.CreateDirectChat(r, uc, jwtParser)
 	registerHandler.UpdateChatName(r, uc, jwtParser)
+	registerHandler.SetChatRetention(r, uc, jwtParser)
 	registerHandler.LeaveChat(r, uc, jwtParser)
+	registerHandler.TransferChief(r, uc, jwtParser)
+	registerHandler.ArchiveChat(r, uc, jwtParser)
+	registerHandler.UnarchiveChat(r, uc, jwtParser)
+	registerHandler.DeleteChat(r, uc, jwtParser)
 	registerHandler.ChatMembers(r, uc, jwtParser)
 	registerHandler.ChatInvitations(r, uc, jwtParser)
+	registerHandler.Typing(r, uc, jwtParser)
 
 	// Участники /chats//members
 	registerHandler.DeleteMember(r, uc, jwtParser)
+	registerHandler.SetParticipantRole(r, uc, jwtParser)
+
+	// Сообщения /chats/{chatID}/messages
+	registerHandler.SendMessage(r, uc, jwtParser)
+	registerHandler.ChatMessages(r, uc, jwtParser)
+	registerHandler.EditMessage(r, uc, jwtParser)
+	registerHandler.DeleteMessage(r, uc, jwtParser)
+	registerHandler.MessageRevisions(r, uc, jwtParser)
+	registerHandler.ThreadMessages(r, uc, jwtParser)
+	registerHandler.ForwardMessage(r, uc, jwtParser)
+
+	// Черновики /chats/{chatID}/draft
+	registerHandler.PutDraft(r, uc, jwtParser)
+	registerHandler.ClearDraft(r, uc, jwtParser)
+
+	// Упоминания /mentions
+	registerHandler.Mentions(r, uc, jwtParser)
+
+	// Реакции /chats/{chatID}/messages/{messageID}/reactions
+	registerHandler.AddReaction(r, uc, jwtParser)
+	registerHandler.RemoveReaction(r, uc, jwtParser)
+
+	// Опросы /chats/{chatID}/polls, /chats/{chatID}/messages/{messageID}/votes
+	registerHandler.CreatePoll(r, uc, jwtParser)
+	registerHandler.VotePoll(r, uc, jwtParser)
+	registerHandler.RetractVote(r, uc, jwtParser)
+
+	// Отметки прочтения /chats/{chatID}/messages/{messageID}/read
+	registerHandler.MarkRead(r, uc, jwtParser)
+
+	// Закрепленные сообщения /chats/{chatID}/pins, /chats/{chatID}/messages/{messageID}/pin
+	registerHandler.ChatPins(r, uc, jwtParser)
+	registerHandler.PinMessage(r, uc, jwtParser)
+	registerHandler.UnpinMessage(r, uc, jwtParser)
+
+	// Отложенные сообщения /chats/{chatID}/scheduled-messages, /scheduled-messages
+	registerHandler.ScheduleMessage(r, uc, jwtParser)
+	registerHandler.ScheduledMessages(r, uc, jwtParser)
+	registerHandler.CancelScheduledMessage(r, uc, jwtParser)
+
+	// Поиск /search
+	registerHandler.SearchMessages(r, uc, jwtParser)
+
+	// Вложения /attachments, загрузка зарегистрирована выше
+	registerHandler.DownloadAttachment(r, uc, jwtParser)
 
 	// Приглашения /invitations
 	registerHandler.MyInvitations(r, uc, jwtParser)
 	registerHandler.SendInvitation(r, uc, jwtParser)
 	registerHandler.AcceptInvitation(r, uc, jwtParser)
 	registerHandler.CancelInvitation(r, uc, jwtParser)
+	registerHandler.DeclineInvitation(r, uc, jwtParser)
 
 	// Пользователи /users
 	registerHandler.GetUser(r, uc, jwtParser)
 	registerHandler.Me(r, uc, jwtParser)
+
+	// Боты /bots
+	registerHandler.CreateBot(r, uc, jwtParser)
+	registerHandler.MyBots(r, uc, jwtParser)
+	registerHandler.RotateBotToken(r, uc, jwtParser)
+	registerHandler.SetBotCommands(r, uc, jwtParser)
+
+	// Команды /chats/{chatID}/command-replies
+	registerHandler.ReplyCommand(r, uc, jwtParser)
+
+	// Входящие вебхуки /chats/{chatID}/webhooks, /hooks/{token}
+	registerHandler.CreateWebhook(r, uc, jwtParser)
+	registerHandler.ChatWebhooks(r, uc, jwtParser)
+	registerHandler.RotateWebhookToken(r, uc, jwtParser)
+	registerHandler.RevokeWebhook(r, uc, jwtParser)
+	registerHandler.PostWebhookMessage(r, uc)
+
+	// Пригласительные ссылки /chats/{chatID}/invite-links, /invite-links/{token}
+	registerHandler.CreateInviteLink(r, uc, jwtParser)
+	registerHandler.ChatInviteLinks(r, uc, jwtParser)
+	registerHandler.RevokeInviteLink(r, uc, jwtParser)
+	registerHandler.JoinByLink(r, uc, jwtParser)
+	registerHandler.ApproveJoinRequest(r, uc, jwtParser)
+	registerHandler.RejectJoinRequest(r, uc, jwtParser)
+
+	// Администрирование /admin
+	registerHandler.ForceTransferChief(r, uc, adminToken)
 }
 
 // fiberErrorHandler разделяет составные ошибки и помещает в body.
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LimitBody ограничивает размер тела запроса значением limit.
+// Рассчитан на сервер с включенным StreamRequestBody: тело, превышающее
+// BodyLimit сервера, или тело неизвестной длины не буферизуется заранее,
+// поэтому здесь оно дочитывается из потока не более чем на limit байт
+func LimitBody(limit int) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		req := ctx.Request()
+
+		// Отклонить запрос с заявленной длиной больше лимита, не читая тело
+		if req.Header.ContentLength() > limit {
+			// Непрочитанное тело не позволит разобрать следующий запрос в этом соединении
+			ctx.Context().SetConnectionClose()
+			return fiber.ErrRequestEntityTooLarge
+		}
+
+		// Длина тела неизвестна (chunked) - прочитать с ограничением
+		if req.Header.ContentLength() < 0 && req.IsBodyStream() {
+			body, err := io.ReadAll(io.LimitReader(req.BodyStream(), int64(limit)+1))
+			if err != nil {
+				return err
+			}
+			if len(body) > limit {
+				ctx.Context().SetConnectionClose()
+				return fiber.ErrRequestEntityTooLarge
+			}
+			req.SetBody(body)
+		}
+
+		return ctx.Next()
+	}
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LimitBody(t *testing.T) {
+	const (
+		serverLimit = 16
+		uploadLimit = 64
+	)
+
+	// newApp создает приложение с теми же настройками потоковой передачи тела, что и сервер
+	newApp := func() *fiber.App {
+		fiberApp := fiber.New(fiber.Config{
+			DisableStartupMessage:        true,
+			BodyLimit:                    serverLimit,
+			StreamRequestBody:            true,
+			DisablePreParseMultipartForm: true,
+		})
+		echo := func(ctx *fiber.Ctx) error {
+			return ctx.Send(ctx.Body())
+		}
+		fiberApp.Post("/upload", LimitBody(uploadLimit), echo)
+		fiberApp.Use(LimitBody(serverLimit))
+		fiberApp.Post("/", echo)
+		return fiberApp
+	}
+
+	// send отправляет тело запроса, не указывая его длину, если chunked
+	send := func(t *testing.T, path, body string, chunked bool) (int, string) {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if chunked {
+			req.ContentLength = -1
+			req.TransferEncoding = []string{"chunked"}
+		}
+		resp, err := newApp().Test(req)
+		require.NoError(t, err)
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(respBody)
+	}
+
+	t.Run("тело в пределах лимита доходит до обработчика", func(t *testing.T) {
+		body := strings.Repeat("a", serverLimit)
+		status, respBody := send(t, "/", body, false)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, body, respBody)
+	})
+
+	t.Run("тело с длиной больше лимита отклоняется - вернет StatusRequestEntityTooLarge (413)", func(t *testing.T) {
+		status, _ := send(t, "/", strings.Repeat("a", serverLimit+1), false)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+	})
+
+	t.Run("тело неизвестной длины читается с ограничением", func(t *testing.T) {
+		body := strings.Repeat("a", serverLimit)
+		status, respBody := send(t, "/", body, true)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, body, respBody)
+
+		status, _ = send(t, "/", strings.Repeat("a", serverLimit+1), true)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+	})
+
+	t.Run("маршрут, зарегистрированный до общего ограничения, принимает тела своего размера", func(t *testing.T) {
+		body := strings.Repeat("a", uploadLimit)
+		status, respBody := send(t, "/upload", body, false)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, body, respBody)
+
+		status, _ = send(t, "/upload", strings.Repeat("a", uploadLimit+1), false)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+	})
+}
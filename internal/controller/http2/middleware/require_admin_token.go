@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireAdminToken требует административный токен в заголовке Authorization вида "Admin <token>".
+// Если токен не задан в конфигурации, административные методы недоступны
+func RequireAdminToken(adminToken string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if adminToken == "" {
+			return fiber.ErrNotFound
+		}
+
+		// Прочитать заголовок
+		authType, token, ok := strings.Cut(ctx.Get("Authorization"), " ")
+		if !ok || authType != AdminToken {
+			return fiber.ErrUnauthorized
+		}
+
+		// Сравнить за постоянное время, чтобы не раскрывать токен по времени ответа
+		if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+			return fiber.ErrUnauthorized
+		}
+
+		return ctx.Next()
+	}
+}
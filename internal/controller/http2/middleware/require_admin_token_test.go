@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequireAdminToken(t *testing.T) {
+	// request выполняет запрос с заголовком Authorization к приложению с административным токеном adminToken
+	request := func(t *testing.T, adminToken, header string) int {
+		fiberApp := fiber.New(fiber.Config{DisableStartupMessage: true})
+		fiberApp.Get("/", RequireAdminToken(adminToken), func(ctx *fiber.Ctx) error {
+			return nil
+		})
+
+		req := httptest.NewRequest("GET", "/", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		resp, err := fiberApp.Test(req)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	t.Run("с верным токеном запрос выполнится", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request(t, "secret", "Admin secret"))
+	})
+
+	t.Run("без токена или с неверным токеном - вернет StatusUnauthorized (401)", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request(t, "secret", ""))
+		assert.Equal(t, http.StatusUnauthorized, request(t, "secret", "Admin wrong"))
+		assert.Equal(t, http.StatusUnauthorized, request(t, "secret", "Bearer secret"))
+		assert.Equal(t, http.StatusUnauthorized, request(t, "secret", "Admin"))
+	})
+
+	t.Run("если токен не задан, метод недоступен - вернет StatusNotFound (404)", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, request(t, "", "Admin "))
+	})
+}
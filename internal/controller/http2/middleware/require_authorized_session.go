@@ -6,6 +6,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 
 	findSession "github.com/nice-pea/npchat/internal/usecases/sessions/find_session"
+	authenticateBot "github.com/nice-pea/npchat/internal/usecases/users/bots/authenticate_bot"
 )
 
 // Контекстные ключи
@@ -18,6 +19,8 @@ const (
 const (
 	SessionToken = "SessionToken"
 	Bearer       = "Bearer"
+	BotToken     = "Bot"
+	AdminToken   = "Admin"
 )
 
 // RequireAuthorizedSession требует авторизованную сессии
@@ -51,6 +54,14 @@ func RequireAuthorizedSession(uc UsecasesForRequireAuthorizedSession, jwtParser
 			}
 			ctx.Locals(CtxKeyUserID, out.UserID)
 			ctx.Locals(CtxKeySessionID, out.SessionID)
+		case authType == BotToken:
+			out, err := authenticateBotf(uc, token)
+			if err != nil {
+				return err
+			}
+			// У бота нет сессии, поэтому ее роль играет сам бот
+			ctx.Locals(CtxKeyUserID, out.Bot.ID)
+			ctx.Locals(CtxKeySessionID, out.Bot.ID)
 
 		default:
 			return fiber.ErrUnauthorized
@@ -63,6 +74,7 @@ func RequireAuthorizedSession(uc UsecasesForRequireAuthorizedSession, jwtParser
 // UsecasesForRequireAuthorizedSession определяет интерфейс для доступа к сценариям использования бизнес-логики
 type UsecasesForRequireAuthorizedSession interface {
 	FindSessions(findSession.In) (findSession.Out, error)
+	AuthenticateBot(authenticateBot.In) (authenticateBot.Out, error)
 }
 
 func findSessionf(uc UsecasesForRequireAuthorizedSession, token string) (findSession.Out, error) {
@@ -80,6 +92,18 @@ func findSessionf(uc UsecasesForRequireAuthorizedSession, token string) (findSes
 	return out, nil
 }
 
+func authenticateBotf(uc UsecasesForRequireAuthorizedSession, token string) (authenticateBot.Out, error) {
+	// Найти бота по токену
+	out, err := uc.AuthenticateBot(authenticateBot.In{
+		Token: token,
+	})
+	if err != nil {
+		return authenticateBot.Out{}, fiber.ErrUnauthorized
+	}
+
+	return out, nil
+}
+
 type OutJwt struct {
 	UserID    string
 	SessionID string
@@ -13,7 +13,9 @@ import (
 
 	// middleware_mock "github.com/nice-pea/npchat/internal/controller/http2/middleware/mocks"
 	"github.com/nice-pea/npchat/internal/domain/sessionn"
+	"github.com/nice-pea/npchat/internal/domain/userr"
 	findSession "github.com/nice-pea/npchat/internal/usecases/sessions/find_session"
+	authenticateBot "github.com/nice-pea/npchat/internal/usecases/users/bots/authenticate_bot"
 )
 
 func Test_RequireAuthorizedSession(t *testing.T) {
@@ -202,6 +204,49 @@ func Test_RequireAuthorizedSession(t *testing.T) {
 			req := httptest.NewRequest("GET", "/", nil)
 			req.Header.Set("Authorization", "Bearer "+jwtToken)
 
+			resp, err := fiberApp.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		})
+	})
+	t.Run("bots", func(t *testing.T) {
+		t.Run("UserID и SessionID бота можно прочитать", func(t *testing.T) {
+			botID := uuid.New()
+			uc := mockUsecasesForRequireAuthorizedSession{
+				AuthenticateBotFunc: func(in authenticateBot.In) (authenticateBot.Out, error) {
+					require.Equal(t, "bot_token", in.Token)
+					return authenticateBot.Out{Bot: userr.User{ID: botID, Kind: userr.KindBot}}, nil
+				},
+			}
+
+			fiberApp := fiber.New(fiber.Config{DisableStartupMessage: true})
+			fiberApp.Get(
+				"/", RequireAuthorizedSession(uc, nil),
+				func(ctx *fiber.Ctx) error {
+					userID, ok := ctx.Locals(CtxKeyUserID).(uuid.UUID)
+					require.True(t, ok)
+					require.Equal(t, botID, userID)
+					sessionID, ok := ctx.Locals(CtxKeySessionID).(uuid.UUID)
+					require.True(t, ok)
+					require.Equal(t, botID, sessionID)
+					return nil
+				})
+
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("Authorization", "Bot bot_token")
+
+			resp, err := fiberApp.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+		t.Run("неизвестный токен бота - 401", func(t *testing.T) {
+			uc := mockUsecasesForRequireAuthorizedSession{}
+			fiberApp := fiber.New(fiber.Config{DisableStartupMessage: true})
+			fiberApp.Get("/", RequireAuthorizedSession(uc, nil))
+
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("Authorization", "Bot bot_token")
+
 			resp, err := fiberApp.Test(req)
 			require.NoError(t, err)
 			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
@@ -210,7 +255,8 @@ func Test_RequireAuthorizedSession(t *testing.T) {
 }
 
 type mockUsecasesForRequireAuthorizedSession struct {
-	FindSessionsFunc func(findSession.In) (findSession.Out, error)
+	FindSessionsFunc    func(findSession.In) (findSession.Out, error)
+	AuthenticateBotFunc func(authenticateBot.In) (authenticateBot.Out, error)
 }
 
 var mockSession = sessionn.Session{
@@ -245,3 +291,10 @@ func (m mockJWTParser) Parse(token string) (OutJwt, error) {
 	}
 	return mockParseJWT, nil
 }
+
+func (m mockUsecasesForRequireAuthorizedSession) AuthenticateBot(in authenticateBot.In) (authenticateBot.Out, error) {
+	if m.AuthenticateBotFunc != nil {
+		return m.AuthenticateBotFunc(in)
+	}
+	return authenticateBot.Out{}, userr.ErrInvalidBotToken
+}
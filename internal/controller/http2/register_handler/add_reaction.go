@@ -0,0 +1,52 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	addReaction "github.com/nice-pea/npchat/internal/usecases/messages/add_reaction"
+)
+
+// AddReaction регистрирует обработчик, позволяющий поставить реакцию на сообщение.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: POST /chats/{chatID}/messages/{messageID}/reactions
+func AddReaction(router *fiber.App, uc UsecasesForAddReaction, jwtParser middleware.JwtParser) {
+	// Тело запроса для добавления реакции.
+	type requestBody struct {
+		Emoji string `json:"emoji"`
+	}
+	router.Post(
+		"/chats/:chatID/messages/:messageID/reactions",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := addReaction.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+				Emoji:     rb.Emoji,
+			}
+
+			out, err := uc.AddReaction(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForAddReaction определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForAddReaction interface {
+	AddReaction(addReaction.In) (addReaction.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,42 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	approveJoinRequest "github.com/nice-pea/npchat/internal/usecases/invite_links/approve_join_request"
+)
+
+// ApproveJoinRequest регистрирует обработчик, позволяющий одобрить заявку на вступление по ссылке.
+// Доступен только авторизованным пользователям, которые могут управлять приглашениями в чате.
+//
+// Метод: POST /chats/{chatID}/invite-links/{linkID}/requests/{userID}
+func ApproveJoinRequest(router *fiber.App, uc UsecasesForApproveJoinRequest, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/chats/:chatID/invite-links/:linkID/requests/:userID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := approveJoinRequest.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				LinkID:    ParamsUUID(ctx, "linkID"),
+				UserID:    ParamsUUID(ctx, "userID"),
+			}
+
+			out, err := uc.ApproveJoinRequest(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForApproveJoinRequest определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForApproveJoinRequest interface {
+	ApproveJoinRequest(approveJoinRequest.In) (approveJoinRequest.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
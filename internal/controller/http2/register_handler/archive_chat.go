@@ -0,0 +1,40 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	archiveChat "github.com/nice-pea/npchat/internal/usecases/chats/archive_chat"
+)
+
+// ArchiveChat регистрирует обработчик, позволяющий перенести чат в архив, после чего он доступен только для чтения.
+// Доступен только авторизованному главному администратору чата.
+//
+// Метод: POST /chats/{chatID}/archive
+func ArchiveChat(router *fiber.App, uc UsecasesForArchiveChat, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/chats/:chatID/archive",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := archiveChat.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+			}
+
+			out, err := uc.ArchiveChat(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForArchiveChat определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForArchiveChat interface {
+	ArchiveChat(archiveChat.In) (archiveChat.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,40 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	cancelScheduledMessage "github.com/nice-pea/npchat/internal/usecases/messages/cancel_scheduled_message"
+)
+
+// CancelScheduledMessage регистрирует обработчик, позволяющий отменить отправку отложенного сообщения.
+// Доступен только авторизованным пользователям, которые являются авторами сообщения.
+//
+// Метод: DELETE /scheduled-messages/{scheduledMessageID}
+func CancelScheduledMessage(router *fiber.App, uc UsecasesForCancelScheduledMessage, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/scheduled-messages/:scheduledMessageID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := cancelScheduledMessage.In{
+				SubjectID:          UserID(ctx),
+				ScheduledMessageID: ParamsUUID(ctx, "scheduledMessageID"),
+			}
+
+			out, err := uc.CancelScheduledMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForCancelScheduledMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForCancelScheduledMessage interface {
+	CancelScheduledMessage(cancelScheduledMessage.In) (cancelScheduledMessage.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	chatInviteLinks "github.com/nice-pea/npchat/internal/usecases/invite_links/chat_invite_links"
+)
+
+// ChatInviteLinks регистрирует обработчик, позволяющий получить пригласительные ссылки чата
+// вместе с историей их использования.
+// Доступен только авторизованным пользователям, которые могут управлять приглашениями в чате.
+//
+// Метод: GET /chats/{chatID}/invite-links
+func ChatInviteLinks(router *fiber.App, uc UsecasesForChatInviteLinks, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/chats/:chatID/invite-links",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := chatInviteLinks.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+			}
+
+			out, err := uc.ChatInviteLinks(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForChatInviteLinks определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForChatInviteLinks interface {
+	ChatInviteLinks(chatInviteLinks.In) (chatInviteLinks.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
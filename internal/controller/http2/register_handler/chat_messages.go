@@ -0,0 +1,55 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	chatMessages "github.com/nice-pea/npchat/internal/usecases/messages/chat_messages"
+)
+
+// ChatMessages регистрирует HTTP-обработчик для получения истории сообщений чата.
+// Данный обработчик доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: GET /chats/{chatID}/messages
+func ChatMessages(router *fiber.App, uc UsecasesForChatMessages, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/chats/:chatID/messages",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			keyset, err := decodeKeyset[chatMessages.Keyset](ctx.Query("page_token"))
+			if err != nil {
+				return err
+			}
+
+			input := chatMessages.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				Keyset:    keyset,
+			}
+
+			out, err := uc.ChatMessages(input)
+			if err != nil {
+				return err
+			}
+			nextPageToken, err := encodeKeyset(out.NextKeyset)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(fiber.Map{
+				"Messages":        out.Messages,
+				"Reactions":       out.Reactions,
+				"Polls":           out.Polls,
+				"next_page_token": nextPageToken,
+			})
+		},
+	)
+}
+
+// UsecasesForChatMessages определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForChatMessages interface {
+	ChatMessages(chatMessages.In) (chatMessages.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,40 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	chatPins "github.com/nice-pea/npchat/internal/usecases/messages/chat_pins"
+)
+
+// ChatPins регистрирует обработчик, позволяющий получить список закрепленных в чате сообщений.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: GET /chats/{chatID}/pins
+func ChatPins(router *fiber.App, uc UsecasesForChatPins, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/chats/:chatID/pins",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := chatPins.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+			}
+
+			out, err := uc.ChatPins(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForChatPins определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForChatPins interface {
+	ChatPins(chatPins.In) (chatPins.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
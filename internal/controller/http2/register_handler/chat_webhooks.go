@@ -0,0 +1,40 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	chatWebhooks "github.com/nice-pea/npchat/internal/usecases/integrations/chat_webhooks"
+)
+
+// ChatWebhooks регистрирует обработчик, позволяющий получить список входящих вебхуков чата.
+// Доступен только авторизованным пользователям, которые являются главными администраторами чата.
+//
+// Метод: GET /chats/{chatID}/webhooks
+func ChatWebhooks(router *fiber.App, uc UsecasesForChatWebhooks, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/chats/:chatID/webhooks",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := chatWebhooks.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+			}
+
+			out, err := uc.ChatWebhooks(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForChatWebhooks определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForChatWebhooks interface {
+	ChatWebhooks(chatWebhooks.In) (chatWebhooks.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,42 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	clearDraft "github.com/nice-pea/npchat/internal/usecases/drafts/clear_draft"
+)
+
+// ClearDraft регистрирует обработчик, позволяющий очистить черновик сообщения в чате.
+// Доступен только авторизованным пользователям.
+// Остальные сессии пользователя получат событие draft_updated.
+//
+// Метод: DELETE /chats/{chatID}/draft
+func ClearDraft(router *fiber.App, uc UsecasesForClearDraft, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID/draft",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := clearDraft.In{
+				SubjectID: UserID(ctx),
+				SessionID: SessionID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+			}
+
+			out, err := uc.ClearDraft(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForClearDraft определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForClearDraft interface {
+	ClearDraft(clearDraft.In) (clearDraft.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,54 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	createBot "github.com/nice-pea/npchat/internal/usecases/users/bots/create_bot"
+)
+
+// CreateBot регистрирует обработчик, позволяющий создать бота.
+// Доступен только авторизованным пользователям. Токен бота возвращается только в ответе на этот запрос.
+//
+// Метод: POST /bots
+func CreateBot(router *fiber.App, uc UsecasesForCreateBot, jwtParser middleware.JwtParser) {
+	// Тело запроса для создания бота.
+	type requestBody struct {
+		Name       string `json:"name"`
+		Nick       string `json:"nick"`
+		WebhookURL string `json:"webhook_url"`
+	}
+	router.Post(
+		"/bots",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := createBot.In{
+				SubjectID:  UserID(ctx),
+				Name:       rb.Name,
+				Nick:       rb.Nick,
+				WebhookURL: rb.WebhookURL,
+			}
+
+			out, err := uc.CreateBot(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForCreateBot определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForCreateBot interface {
+	CreateBot(createBot.In) (createBot.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,52 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	createDirectChat "github.com/nice-pea/npchat/internal/usecases/chats/create_direct_chat"
+)
+
+// CreateDirectChat регистрирует обработчик, позволяющий начать личную переписку с пользователем.
+// Если личный чат с этим пользователем уже есть, возвращается он.
+// Доступен только авторизованным пользователям.
+//
+// Метод: POST /chats/direct
+func CreateDirectChat(router *fiber.App, uc UsecasesForCreateDirectChat, jwtParser middleware.JwtParser) {
+	// Тело запроса для создания личного чата.
+	type requestBody struct {
+		UserID uuid.UUID `json:"user_id"`
+	}
+	router.Post(
+		"/chats/direct",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := createDirectChat.In{
+				SubjectID: UserID(ctx),
+				UserID:    rb.UserID,
+			}
+
+			out, err := uc.CreateDirectChat(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForCreateDirectChat определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForCreateDirectChat interface {
+	CreateDirectChat(createDirectChat.In) (createDirectChat.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
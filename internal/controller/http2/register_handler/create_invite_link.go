@@ -0,0 +1,58 @@
+package registerHandler
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	createInviteLink "github.com/nice-pea/npchat/internal/usecases/invite_links/create_invite_link"
+)
+
+// CreateInviteLink регистрирует обработчик, позволяющий создать пригласительную ссылку в чат.
+// Доступен только авторизованным пользователям, которые могут управлять приглашениями в чате.
+// Токен ссылки возвращается только один раз.
+//
+// Метод: POST /chats/{chatID}/invite-links
+func CreateInviteLink(router *fiber.App, uc UsecasesForCreateInviteLink, jwtParser middleware.JwtParser) {
+	// Тело запроса для создания пригласительной ссылки.
+	type requestBody struct {
+		ExpiresAt        time.Time `json:"expires_at"`
+		MaxUses          int       `json:"max_uses"`
+		RequiresApproval bool      `json:"requires_approval"`
+	}
+	router.Post(
+		"/chats/:chatID/invite-links",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := createInviteLink.In{
+				SubjectID:        UserID(ctx),
+				ChatID:           ParamsUUID(ctx, "chatID"),
+				ExpiresAt:        rb.ExpiresAt,
+				MaxUses:          rb.MaxUses,
+				RequiresApproval: rb.RequiresApproval,
+			}
+
+			out, err := uc.CreateInviteLink(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForCreateInviteLink определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForCreateInviteLink interface {
+	CreateInviteLink(createInviteLink.In) (createInviteLink.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,61 @@
+package registerHandler
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	createPoll "github.com/nice-pea/npchat/internal/usecases/messages/create_poll"
+)
+
+// CreatePoll регистрирует обработчик, позволяющий отправить в чат сообщение с опросом.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: POST /chats/{chatID}/polls
+func CreatePoll(router *fiber.App, uc UsecasesForCreatePoll, jwtParser middleware.JwtParser) {
+	// Тело запроса для создания опроса.
+	type requestBody struct {
+		Question       string    `json:"question"`
+		Options        []string  `json:"options"`
+		MultipleChoice bool      `json:"multiple_choice"`
+		Anonymous      bool      `json:"anonymous"`
+		ClosesAt       time.Time `json:"closes_at"`
+	}
+	router.Post(
+		"/chats/:chatID/polls",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := createPoll.In{
+				SubjectID:      UserID(ctx),
+				ChatID:         ParamsUUID(ctx, "chatID"),
+				Question:       rb.Question,
+				Options:        rb.Options,
+				MultipleChoice: rb.MultipleChoice,
+				Anonymous:      rb.Anonymous,
+				ClosesAt:       rb.ClosesAt,
+			}
+
+			out, err := uc.CreatePoll(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForCreatePoll определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForCreatePoll interface {
+	CreatePoll(createPoll.In) (createPoll.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,56 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	createWebhook "github.com/nice-pea/npchat/internal/usecases/integrations/create_webhook"
+)
+
+// CreateWebhook регистрирует обработчик, позволяющий создать входящий вебхук чата.
+// Доступен только авторизованным пользователям, которые являются главными администраторами чата.
+// Токен и адрес вебхука возвращаются только один раз.
+//
+// Метод: POST /chats/{chatID}/webhooks
+func CreateWebhook(router *fiber.App, uc UsecasesForCreateWebhook, jwtParser middleware.JwtParser) {
+	// Тело запроса для создания входящего вебхука.
+	type requestBody struct {
+		Name string `json:"name"`
+	}
+	router.Post(
+		"/chats/:chatID/webhooks",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := createWebhook.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				Name:      rb.Name,
+			}
+
+			out, err := uc.CreateWebhook(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(fiber.Map{
+				"Webhook": out.Webhook,
+				"Token":   out.Token,
+				"URL":     ctx.BaseURL() + "/hooks/" + out.Token,
+			})
+		},
+	)
+}
+
+// UsecasesForCreateWebhook определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForCreateWebhook interface {
+	CreateWebhook(createWebhook.In) (createWebhook.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,40 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	declineInvitation "github.com/nice-pea/npchat/internal/usecases/chats/decline_invitation"
+)
+
+// DeclineInvitation регистрирует обработчик, позволяющий отклонить полученное приглашение в чат.
+// Доступен только авторизованным пользователям, которым направлено приглашение.
+//
+// Метод: POST /invitations/{invitationID}/decline
+func DeclineInvitation(router *fiber.App, uc UsecasesForDeclineInvitation, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/invitations/:invitationID/decline",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := declineInvitation.In{
+				SubjectID:    UserID(ctx),
+				InvitationID: ParamsUUID(ctx, "invitationID"),
+			}
+
+			out, err := uc.DeclineInvitation(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForDeclineInvitation определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForDeclineInvitation interface {
+	DeclineInvitation(declineInvitation.In) (declineInvitation.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
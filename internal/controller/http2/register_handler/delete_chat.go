@@ -0,0 +1,40 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	deleteChat "github.com/nice-pea/npchat/internal/usecases/chats/delete_chat"
+)
+
+// DeleteChat регистрирует обработчик, позволяющий удалить чат вместе со всем содержимым.
+// Доступен только авторизованному главному администратору чата.
+//
+// Метод: DELETE /chats/{chatID}
+func DeleteChat(router *fiber.App, uc UsecasesForDeleteChat, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := deleteChat.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+			}
+
+			out, err := uc.DeleteChat(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForDeleteChat определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForDeleteChat interface {
+	DeleteChat(deleteChat.In) (deleteChat.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
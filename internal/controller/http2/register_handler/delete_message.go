@@ -0,0 +1,42 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	deleteMessage "github.com/nice-pea/npchat/internal/usecases/messages/delete_message"
+)
+
+// DeleteMessage регистрирует обработчик, позволяющий удалить сообщение.
+// Доступен только авторизованным пользователям, которые являются авторами сообщения
+// или главными администраторами чата.
+//
+// Метод: DELETE /chats/{chatID}/messages/{messageID}
+func DeleteMessage(router *fiber.App, uc UsecasesForDeleteMessage, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID/messages/:messageID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := deleteMessage.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+			}
+
+			out, err := uc.DeleteMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForDeleteMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForDeleteMessage interface {
+	DeleteMessage(deleteMessage.In) (deleteMessage.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,48 @@
+package registerHandler
+
+import (
+	"mime"
+
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	downloadAttachment "github.com/nice-pea/npchat/internal/usecases/attachments/download_attachment"
+)
+
+// DownloadAttachment регистрирует обработчик, позволяющий скачать содержимое вложения.
+// Доступен только авторизованным пользователям, которые являются участниками чата вложения.
+//
+// Метод: GET /attachments/{attachmentID}
+func DownloadAttachment(router *fiber.App, uc UsecasesForDownloadAttachment, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/attachments/:attachmentID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := downloadAttachment.In{
+				SubjectID:    UserID(ctx),
+				AttachmentID: ParamsUUID(ctx, "attachmentID"),
+			}
+
+			out, err := uc.DownloadAttachment(input)
+			if err != nil {
+				return err
+			}
+
+			ctx.Set(fiber.HeaderContentType, out.Attachment.MimeType)
+			ctx.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{
+				"filename": out.Attachment.Name,
+			}))
+
+			// Поток будет закрыт после отправки ответа
+			return ctx.SendStream(out.Content, int(out.Attachment.Size))
+		},
+	)
+}
+
+// UsecasesForDownloadAttachment определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForDownloadAttachment interface {
+	DownloadAttachment(downloadAttachment.In) (downloadAttachment.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
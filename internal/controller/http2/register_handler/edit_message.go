@@ -0,0 +1,52 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	editMessage "github.com/nice-pea/npchat/internal/usecases/messages/edit_message"
+)
+
+// EditMessage регистрирует обработчик, позволяющий изменить текст сообщения.
+// Доступен только авторизованным пользователям, которые являются авторами сообщения.
+//
+// Метод: PUT /chats/{chatID}/messages/{messageID}
+func EditMessage(router *fiber.App, uc UsecasesForEditMessage, jwtParser middleware.JwtParser) {
+	// Тело запроса для изменения сообщения.
+	type requestBody struct {
+		Text string `json:"text"`
+	}
+	router.Put(
+		"/chats/:chatID/messages/:messageID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := editMessage.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+				Text:      rb.Text,
+			}
+
+			out, err := uc.EditMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForEditMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForEditMessage interface {
+	EditMessage(editMessage.In) (editMessage.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,39 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	forceTransferChief "github.com/nice-pea/npchat/internal/usecases/chats/force_transfer_chief"
+)
+
+// ForceTransferChief регистрирует административный обработчик, позволяющий принудительно
+// передать владение всеми чатами пользователя их старшим участникам.
+// Доступен только с административным токеном.
+//
+// Метод: POST /admin/users/{userID}/force-transfer-chief
+func ForceTransferChief(router *fiber.App, uc UsecasesForForceTransferChief, adminToken string) {
+	router.Post(
+		"/admin/users/:userID/force-transfer-chief",
+		recover2.New(),
+		middleware.RequireAdminToken(adminToken),
+		func(ctx *fiber.Ctx) error {
+			input := forceTransferChief.In{
+				UserID: ParamsUUID(ctx, "userID"),
+			}
+
+			out, err := uc.ForceTransferChief(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForForceTransferChief определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForForceTransferChief interface {
+	ForceTransferChief(forceTransferChief.In) (forceTransferChief.Out, error)
+}
@@ -0,0 +1,53 @@
+package registerHandler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mockRegisterHandler "github.com/nice-pea/npchat/internal/controller/http2/register_handler/mocks"
+	forceTransferChief "github.com/nice-pea/npchat/internal/usecases/chats/force_transfer_chief"
+)
+
+func TestForceTransferChief(t *testing.T) {
+	const adminToken = "secret"
+
+	t.Run("с административным токеном владение будет передано", func(t *testing.T) {
+		fiberApp := fiber.New(fiber.Config{DisableStartupMessage: true})
+		// Настройка мока
+		userID := uuid.New()
+		mockUsecases := mockRegisterHandler.NewUsecasesForForceTransferChief(t)
+		mockUsecases.EXPECT().
+			ForceTransferChief(forceTransferChief.In{UserID: userID}).
+			Return(forceTransferChief.Out{}, nil).Once()
+
+		// Регистрация обработчика
+		ForceTransferChief(fiberApp, mockUsecases, adminToken)
+
+		// Выполнить запрос
+		req := httptest.NewRequest("POST", "/admin/users/"+userID.String()+"/force-transfer-chief", nil)
+		req.Header.Set("Authorization", "Admin "+adminToken)
+		resp, err := fiberApp.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("без административного токена сценарий не вызывается", func(t *testing.T) {
+		fiberApp := fiber.New(fiber.Config{DisableStartupMessage: true})
+		mockUsecases := mockRegisterHandler.NewUsecasesForForceTransferChief(t)
+
+		// Регистрация обработчика
+		ForceTransferChief(fiberApp, mockUsecases, adminToken)
+
+		// Выполнить запрос с токеном сессии пользователя
+		req := httptest.NewRequest("POST", "/admin/users/"+uuid.NewString()+"/force-transfer-chief", nil)
+		req.Header.Set("Authorization", "SessionToken "+adminToken)
+		resp, err := fiberApp.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -0,0 +1,54 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	forwardMessage "github.com/nice-pea/npchat/internal/usecases/messages/forward_message"
+)
+
+// ForwardMessage регистрирует обработчик, позволяющий переслать сообщения чата в другой чат.
+// Доступен только авторизованным пользователям, которые являются участниками обоих чатов.
+//
+// Метод: POST /chats/{chatID}/messages/forward
+func ForwardMessage(router *fiber.App, uc UsecasesForForwardMessage, jwtParser middleware.JwtParser) {
+	// Тело запроса для пересылки сообщений.
+	type requestBody struct {
+		TargetChatID uuid.UUID   `json:"target_chat_id"`
+		MessageIDs   []uuid.UUID `json:"message_ids"`
+	}
+	router.Post(
+		"/chats/:chatID/messages/forward",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := forwardMessage.In{
+				SubjectID:    UserID(ctx),
+				SourceChatID: ParamsUUID(ctx, "chatID"),
+				TargetChatID: rb.TargetChatID,
+				MessageIDs:   rb.MessageIDs,
+			}
+
+			out, err := uc.ForwardMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForForwardMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForForwardMessage interface {
+	ForwardMessage(forwardMessage.In) (forwardMessage.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
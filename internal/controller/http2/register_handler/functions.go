@@ -1,6 +1,11 @@
 package registerHandler
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
@@ -19,3 +24,60 @@ func ParamsUUID(ctx *fiber.Ctx, name string) uuid.UUID {
 	val, _ := uuid.Parse(ctx.Params(name))
 	return val
 }
+
+// queryUUID возвращает необязательное значение из строки запроса как uuid.
+// Если параметр не задан, возвращается uuid.Nil
+func queryUUID(ctx *fiber.Ctx, name string) (uuid.UUID, error) {
+	val := ctx.Query(name)
+	if val == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(val)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parse %s: %w", name, err)
+	}
+
+	return id, nil
+}
+
+// queryTime возвращает необязательное значение из строки запроса как время в формате RFC3339.
+// Если параметр не задан, возвращается нулевое время
+func queryTime(ctx *fiber.Ctx, name string) (time.Time, error) {
+	val := ctx.Query(name)
+	if val == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse %s: %w", name, err)
+	}
+
+	return t, nil
+}
+
+// decodeKeyset расшифровывает строку в формате base64 и разбирает ее на Keyset
+func decodeKeyset[K any](pageToken string) (K, error) {
+	var keyset K
+	if pageToken == "" {
+		return keyset, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return keyset, fmt.Errorf("decode page_token: %w", err)
+	}
+	return keyset, json.Unmarshal(b, &keyset)
+}
+
+// encodeKeyset преобразует keyset в json и кодирует в строку в base64
+func encodeKeyset[K comparable](keyset K) (string, error) {
+	var zero K
+	if keyset == zero {
+		return "", nil
+	}
+	b, err := json.Marshal(keyset)
+	if err != nil {
+		return "", fmt.Errorf("marshal keyset: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(b), nil
+}
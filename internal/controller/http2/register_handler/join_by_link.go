@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	joinByLink "github.com/nice-pea/npchat/internal/usecases/invite_links/join_by_link"
+)
+
+// JoinByLink регистрирует обработчик, позволяющий вступить в чат по пригласительной ссылке.
+// Доступен только авторизованным пользователям.
+// Если ссылка требует одобрения, вместо вступления создается заявка.
+//
+// Метод: POST /invite-links/{token}/join
+func JoinByLink(router *fiber.App, uc UsecasesForJoinByLink, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/invite-links/:token/join",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := joinByLink.In{
+				SubjectID: UserID(ctx),
+				Token:     ctx.Params("token"),
+			}
+
+			out, err := uc.JoinByLink(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForJoinByLink определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForJoinByLink interface {
+	JoinByLink(joinByLink.In) (joinByLink.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
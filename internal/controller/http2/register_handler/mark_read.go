@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	markRead "github.com/nice-pea/npchat/internal/usecases/messages/mark_read"
+)
+
+// MarkRead регистрирует обработчик, позволяющий отметить сообщение как прочитанное.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: POST /chats/{chatID}/messages/{messageID}/read
+func MarkRead(router *fiber.App, uc UsecasesForMarkRead, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/chats/:chatID/messages/:messageID/read",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := markRead.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+			}
+
+			out, err := uc.MarkRead(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForMarkRead определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForMarkRead interface {
+	MarkRead(markRead.In) (markRead.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
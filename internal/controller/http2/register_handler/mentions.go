@@ -0,0 +1,52 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	"github.com/nice-pea/npchat/internal/usecases/messages/mentions"
+)
+
+// Mentions регистрирует HTTP-обработчик для получения сообщений, в которых упомянут пользователь.
+// Данный обработчик доступен только авторизованным пользователям.
+//
+// Метод: GET /mentions
+func Mentions(router *fiber.App, uc UsecasesForMentions, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/mentions",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			keyset, err := decodeKeyset[mentions.Keyset](ctx.Query("page_token"))
+			if err != nil {
+				return err
+			}
+
+			input := mentions.In{
+				SubjectID: UserID(ctx),
+				Keyset:    keyset,
+			}
+
+			out, err := uc.Mentions(input)
+			if err != nil {
+				return err
+			}
+			nextPageToken, err := encodeKeyset(out.NextKeyset)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(fiber.Map{
+				"Messages":        out.Messages,
+				"next_page_token": nextPageToken,
+			})
+		},
+	)
+}
+
+// UsecasesForMentions определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForMentions interface {
+	Mentions(mentions.In) (mentions.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	messageRevisions "github.com/nice-pea/npchat/internal/usecases/messages/message_revisions"
+)
+
+// MessageRevisions регистрирует HTTP-обработчик для получения истории версий сообщения.
+// Данный обработчик доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: GET /chats/{chatID}/messages/{messageID}/revisions
+func MessageRevisions(router *fiber.App, uc UsecasesForMessageRevisions, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/chats/:chatID/messages/:messageID/revisions",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := messageRevisions.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+			}
+
+			out, err := uc.MessageRevisions(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForMessageRevisions определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForMessageRevisions interface {
+	MessageRevisions(messageRevisions.In) (messageRevisions.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -7,6 +7,7 @@ package mockRegisterHandler
 import (
 	"github.com/nice-pea/npchat/internal/usecases/chats/cancel_invitation"
 	"github.com/nice-pea/npchat/internal/usecases/sessions/find_session"
+	"github.com/nice-pea/npchat/internal/usecases/users/bots/authenticate_bot"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,6 +38,66 @@ func (_m *UsecasesForCancelInvitation) EXPECT() *UsecasesForCancelInvitation_Exp
 	return &UsecasesForCancelInvitation_Expecter{mock: &_m.Mock}
 }
 
+// AuthenticateBot provides a mock function for the type UsecasesForCancelInvitation
+func (_mock *UsecasesForCancelInvitation) AuthenticateBot(in authenticateBot.In) (authenticateBot.Out, error) {
+	ret := _mock.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateBot")
+	}
+
+	var r0 authenticateBot.Out
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(authenticateBot.In) (authenticateBot.Out, error)); ok {
+		return returnFunc(in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(authenticateBot.In) authenticateBot.Out); ok {
+		r0 = returnFunc(in)
+	} else {
+		r0 = ret.Get(0).(authenticateBot.Out)
+	}
+	if returnFunc, ok := ret.Get(1).(func(authenticateBot.In) error); ok {
+		r1 = returnFunc(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UsecasesForCancelInvitation_AuthenticateBot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticateBot'
+type UsecasesForCancelInvitation_AuthenticateBot_Call struct {
+	*mock.Call
+}
+
+// AuthenticateBot is a helper method to define mock.On call
+//   - in authenticateBot.In
+func (_e *UsecasesForCancelInvitation_Expecter) AuthenticateBot(in interface{}) *UsecasesForCancelInvitation_AuthenticateBot_Call {
+	return &UsecasesForCancelInvitation_AuthenticateBot_Call{Call: _e.mock.On("AuthenticateBot", in)}
+}
+
+func (_c *UsecasesForCancelInvitation_AuthenticateBot_Call) Run(run func(in authenticateBot.In)) *UsecasesForCancelInvitation_AuthenticateBot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 authenticateBot.In
+		if args[0] != nil {
+			arg0 = args[0].(authenticateBot.In)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UsecasesForCancelInvitation_AuthenticateBot_Call) Return(out authenticateBot.Out, err error) *UsecasesForCancelInvitation_AuthenticateBot_Call {
+	_c.Call.Return(out, err)
+	return _c
+}
+
+func (_c *UsecasesForCancelInvitation_AuthenticateBot_Call) RunAndReturn(run func(in authenticateBot.In) (authenticateBot.Out, error)) *UsecasesForCancelInvitation_AuthenticateBot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelInvitation provides a mock function for the type UsecasesForCancelInvitation
 func (_mock *UsecasesForCancelInvitation) CancelInvitation(in cancelInvitation.In) (cancelInvitation.Out, error) {
 	ret := _mock.Called(in)
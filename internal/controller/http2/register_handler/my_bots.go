@@ -0,0 +1,39 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	myBots "github.com/nice-pea/npchat/internal/usecases/users/bots/my_bots"
+)
+
+// MyBots регистрирует обработчик, позволяющий получить список ботов пользователя.
+// Доступен только авторизованным пользователям.
+//
+// Метод: GET /bots
+func MyBots(router *fiber.App, uc UsecasesForMyBots, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/bots",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := myBots.In{
+				SubjectID: UserID(ctx),
+			}
+
+			out, err := uc.MyBots(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForMyBots определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForMyBots interface {
+	MyBots(myBots.In) (myBots.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
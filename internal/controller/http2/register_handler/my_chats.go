@@ -1,19 +1,18 @@
 package registerHandler
 
 import (
-	"encoding/base64"
-	"encoding/json"
-	"fmt"
-
 	"github.com/gofiber/fiber/v2"
 	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
 
 	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
 	myChats "github.com/nice-pea/npchat/internal/usecases/chats/my_chats"
 )
 
 // MyChats регистрирует HTTP-обработчик для получения списка чатов пользователя.
 // Данный обработчик доступен только авторизованным пользователям.
+// Архивные чаты возвращаются только с параметром archived=true,
+// параметр kind ограничивает список чатами одного вида.
 //
 // Метод: GET /chats
 func MyChats(router *fiber.App, uc UsecasesForMyChats, jwtParser middleware.JwtParser) {
@@ -22,7 +21,7 @@ func MyChats(router *fiber.App, uc UsecasesForMyChats, jwtParser middleware.JwtP
 		recover2.New(),
 		middleware.RequireAuthorizedSession(uc, jwtParser),
 		func(ctx *fiber.Ctx) error {
-			keyset, err := decodeKeyset(ctx.Query("page_token"))
+			keyset, err := decodeKeyset[myChats.Keyset](ctx.Query("page_token"))
 			if err != nil {
 				return err
 			}
@@ -30,6 +29,8 @@ func MyChats(router *fiber.App, uc UsecasesForMyChats, jwtParser middleware.JwtP
 			input := myChats.In{
 				SubjectID: UserID(ctx),
 				UserID:    UserID(ctx),
+				Archived:  ctx.QueryBool("archived"),
+				Kind:      chatt.Kind(ctx.Query("kind")),
 				Keyset:    keyset,
 			}
 
@@ -44,6 +45,8 @@ func MyChats(router *fiber.App, uc UsecasesForMyChats, jwtParser middleware.JwtP
 
 			return ctx.JSON(fiber.Map{
 				"Chats":           out.Chats,
+				"UnreadCounts":    out.UnreadCounts,
+				"Drafts":          out.Drafts,
 				"next_page_token": nextPageToken,
 			})
 		},
@@ -55,29 +58,3 @@ type UsecasesForMyChats interface {
 	MyChats(myChats.In) (myChats.Out, error)
 	middleware.UsecasesForRequireAuthorizedSession
 }
-
-// decodeKeyset расшифровывает строку в формате base64 и разбирает ее на Keyset
-func decodeKeyset(pageToken string) (myChats.Keyset, error) {
-	if pageToken == "" {
-		return myChats.Keyset{}, nil
-	}
-	b, err := base64.StdEncoding.DecodeString(pageToken)
-	if err != nil {
-		return myChats.Keyset{}, fmt.Errorf("decode page_token: %w", err)
-	}
-	var keyset myChats.Keyset
-	return keyset, json.Unmarshal(b, &keyset)
-}
-
-// encodeKeyset преобразует keyset в json и кодирует в строку в base64
-func encodeKeyset(keyset myChats.Keyset) (string, error) {
-	if keyset == (myChats.Keyset{}) {
-		return "", nil
-	}
-	b, err := json.Marshal(keyset)
-	if err != nil {
-		return "", fmt.Errorf("marshal keyset: %w", err)
-	}
-
-	return base64.StdEncoding.EncodeToString(b), nil
-}
@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	pinMessage "github.com/nice-pea/npchat/internal/usecases/messages/pin_message"
+)
+
+// PinMessage регистрирует обработчик, позволяющий закрепить сообщение в чате.
+// Доступен только авторизованным пользователям, которые являются главными администраторами чата.
+//
+// Метод: POST /chats/{chatID}/messages/{messageID}/pin
+func PinMessage(router *fiber.App, uc UsecasesForPinMessage, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/chats/:chatID/messages/:messageID/pin",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := pinMessage.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+			}
+
+			out, err := uc.PinMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForPinMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForPinMessage interface {
+	PinMessage(pinMessage.In) (pinMessage.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
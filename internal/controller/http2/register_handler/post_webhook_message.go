@@ -0,0 +1,48 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	postWebhookMessage "github.com/nice-pea/npchat/internal/usecases/integrations/post_webhook_message"
+)
+
+// PostWebhookMessage регистрирует обработчик, позволяющий внешней системе отправить сообщение в чат
+// через входящий вебхук.
+// Доступен без предварительной аутентификации, доступ определяется токеном вебхука в адресе.
+//
+// Метод: POST /hooks/{token}
+func PostWebhookMessage(router *fiber.App, uc UsecasesForPostWebhookMessage) {
+	// Тело запроса для отправки сообщения.
+	type requestBody struct {
+		Text string `json:"text"`
+	}
+	router.Post(
+		"/hooks/:token",
+		recover2.New(),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := postWebhookMessage.In{
+				Token: ctx.Params("token"),
+				Text:  rb.Text,
+			}
+
+			out, err := uc.PostWebhookMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForPostWebhookMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForPostWebhookMessage interface {
+	PostWebhookMessage(postWebhookMessage.In) (postWebhookMessage.Out, error)
+}
@@ -0,0 +1,53 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	putDraft "github.com/nice-pea/npchat/internal/usecases/drafts/put_draft"
+)
+
+// PutDraft регистрирует обработчик, позволяющий сохранить черновик сообщения в чате.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+// Остальные сессии пользователя получат событие draft_updated.
+//
+// Метод: PUT /chats/{chatID}/draft
+func PutDraft(router *fiber.App, uc UsecasesForPutDraft, jwtParser middleware.JwtParser) {
+	// Тело запроса для сохранения черновика.
+	type requestBody struct {
+		Text string `json:"text"`
+	}
+	router.Put(
+		"/chats/:chatID/draft",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := putDraft.In{
+				SubjectID: UserID(ctx),
+				SessionID: SessionID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				Text:      rb.Text,
+			}
+
+			out, err := uc.PutDraft(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForPutDraft определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForPutDraft interface {
+	PutDraft(putDraft.In) (putDraft.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
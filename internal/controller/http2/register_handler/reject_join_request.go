@@ -0,0 +1,42 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	rejectJoinRequest "github.com/nice-pea/npchat/internal/usecases/invite_links/reject_join_request"
+)
+
+// RejectJoinRequest регистрирует обработчик, позволяющий отклонить заявку на вступление по ссылке.
+// Доступен только авторизованным пользователям, которые могут управлять приглашениями в чате.
+//
+// Метод: DELETE /chats/{chatID}/invite-links/{linkID}/requests/{userID}
+func RejectJoinRequest(router *fiber.App, uc UsecasesForRejectJoinRequest, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID/invite-links/:linkID/requests/:userID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := rejectJoinRequest.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				LinkID:    ParamsUUID(ctx, "linkID"),
+				UserID:    ParamsUUID(ctx, "userID"),
+			}
+
+			out, err := uc.RejectJoinRequest(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForRejectJoinRequest определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForRejectJoinRequest interface {
+	RejectJoinRequest(rejectJoinRequest.In) (rejectJoinRequest.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
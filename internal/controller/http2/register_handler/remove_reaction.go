@@ -0,0 +1,52 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	removeReaction "github.com/nice-pea/npchat/internal/usecases/messages/remove_reaction"
+)
+
+// RemoveReaction регистрирует обработчик, позволяющий убрать свою реакцию с сообщения.
+// Доступен только авторизованным пользователям.
+//
+// Метод: DELETE /chats/{chatID}/messages/{messageID}/reactions
+func RemoveReaction(router *fiber.App, uc UsecasesForRemoveReaction, jwtParser middleware.JwtParser) {
+	// Тело запроса для удаления реакции.
+	type requestBody struct {
+		Emoji string `json:"emoji"`
+	}
+	router.Delete(
+		"/chats/:chatID/messages/:messageID/reactions",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := removeReaction.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+				Emoji:     rb.Emoji,
+			}
+
+			out, err := uc.RemoveReaction(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForRemoveReaction определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForRemoveReaction interface {
+	RemoveReaction(removeReaction.In) (removeReaction.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,57 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	replyCommand "github.com/nice-pea/npchat/internal/usecases/commands/reply_command"
+)
+
+// ReplyCommand регистрирует обработчик, позволяющий боту ответить на вызов его команды.
+// Ответ видит только вызвавший команду пользователь, в чате он не сохраняется.
+// Доступен только ботам, которые являются участниками чата.
+//
+// Метод: POST /chats/{chatID}/command-replies
+func ReplyCommand(router *fiber.App, uc UsecasesForReplyCommand, jwtParser middleware.JwtParser) {
+	// Тело запроса для ответа на команду.
+	type requestBody struct {
+		UserID  uuid.UUID `json:"user_id"`
+		Command string    `json:"command"`
+		Text    string    `json:"text"`
+	}
+	router.Post(
+		"/chats/:chatID/command-replies",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := replyCommand.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				UserID:    rb.UserID,
+				Command:   rb.Command,
+				Text:      rb.Text,
+			}
+
+			out, err := uc.ReplyCommand(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForReplyCommand определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForReplyCommand interface {
+	ReplyCommand(replyCommand.In) (replyCommand.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	retractVote "github.com/nice-pea/npchat/internal/usecases/messages/retract_vote"
+)
+
+// RetractVote регистрирует обработчик, позволяющий отозвать свой голос в опросе.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: DELETE /chats/{chatID}/messages/{messageID}/votes
+func RetractVote(router *fiber.App, uc UsecasesForRetractVote, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID/messages/:messageID/votes",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := retractVote.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+			}
+
+			out, err := uc.RetractVote(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForRetractVote определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForRetractVote interface {
+	RetractVote(retractVote.In) (retractVote.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	revokeInviteLink "github.com/nice-pea/npchat/internal/usecases/invite_links/revoke_invite_link"
+)
+
+// RevokeInviteLink регистрирует обработчик, позволяющий отозвать пригласительную ссылку чата.
+// Доступен только авторизованным пользователям, которые могут управлять приглашениями в чате.
+//
+// Метод: DELETE /chats/{chatID}/invite-links/{linkID}
+func RevokeInviteLink(router *fiber.App, uc UsecasesForRevokeInviteLink, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID/invite-links/:linkID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := revokeInviteLink.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				LinkID:    ParamsUUID(ctx, "linkID"),
+			}
+
+			out, err := uc.RevokeInviteLink(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForRevokeInviteLink определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForRevokeInviteLink interface {
+	RevokeInviteLink(revokeInviteLink.In) (revokeInviteLink.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	revokeWebhook "github.com/nice-pea/npchat/internal/usecases/integrations/revoke_webhook"
+)
+
+// RevokeWebhook регистрирует обработчик, позволяющий отозвать входящий вебхук чата.
+// Доступен только авторизованным пользователям, которые являются главными администраторами чата.
+//
+// Метод: DELETE /chats/{chatID}/webhooks/{webhookID}
+func RevokeWebhook(router *fiber.App, uc UsecasesForRevokeWebhook, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID/webhooks/:webhookID",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := revokeWebhook.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				WebhookID: ParamsUUID(ctx, "webhookID"),
+			}
+
+			out, err := uc.RevokeWebhook(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForRevokeWebhook определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForRevokeWebhook interface {
+	RevokeWebhook(revokeWebhook.In) (revokeWebhook.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,40 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	rotateBotToken "github.com/nice-pea/npchat/internal/usecases/users/bots/rotate_bot_token"
+)
+
+// RotateBotToken регистрирует обработчик, позволяющий выпустить боту новый токен.
+// Доступен только авторизованным пользователям, которые являются владельцами бота.
+//
+// Метод: POST /bots/{botID}/token
+func RotateBotToken(router *fiber.App, uc UsecasesForRotateBotToken, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/bots/:botID/token",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := rotateBotToken.In{
+				SubjectID: UserID(ctx),
+				BotID:     ParamsUUID(ctx, "botID"),
+			}
+
+			out, err := uc.RotateBotToken(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForRotateBotToken определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForRotateBotToken interface {
+	RotateBotToken(rotateBotToken.In) (rotateBotToken.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,44 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	rotateWebhookToken "github.com/nice-pea/npchat/internal/usecases/integrations/rotate_webhook_token"
+)
+
+// RotateWebhookToken регистрирует обработчик, позволяющий выпустить входящему вебхуку новый токен.
+// Доступен только авторизованным пользователям, которые являются главными администраторами чата.
+//
+// Метод: POST /chats/{chatID}/webhooks/{webhookID}/token
+func RotateWebhookToken(router *fiber.App, uc UsecasesForRotateWebhookToken, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/chats/:chatID/webhooks/:webhookID/token",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := rotateWebhookToken.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				WebhookID: ParamsUUID(ctx, "webhookID"),
+			}
+
+			out, err := uc.RotateWebhookToken(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(fiber.Map{
+				"Token": out.Token,
+				"URL":   ctx.BaseURL() + "/hooks/" + out.Token,
+			})
+		},
+	)
+}
+
+// UsecasesForRotateWebhookToken определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForRotateWebhookToken interface {
+	RotateWebhookToken(rotateWebhookToken.In) (rotateWebhookToken.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
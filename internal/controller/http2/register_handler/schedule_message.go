@@ -0,0 +1,58 @@
+package registerHandler
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	scheduleMessage "github.com/nice-pea/npchat/internal/usecases/messages/schedule_message"
+)
+
+// ScheduleMessage регистрирует обработчик, позволяющий запланировать отправку сообщения в чат.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: POST /chats/{chatID}/scheduled-messages
+func ScheduleMessage(router *fiber.App, uc UsecasesForScheduleMessage, jwtParser middleware.JwtParser) {
+	// Тело запроса для планирования сообщения.
+	type requestBody struct {
+		Text     string    `json:"text"`
+		ParentID uuid.UUID `json:"parent_id"`
+		SendAt   time.Time `json:"send_at"`
+	}
+	router.Post(
+		"/chats/:chatID/scheduled-messages",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := scheduleMessage.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				Text:      rb.Text,
+				ParentID:  rb.ParentID,
+				SendAt:    rb.SendAt,
+			}
+
+			out, err := uc.ScheduleMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForScheduleMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForScheduleMessage interface {
+	ScheduleMessage(scheduleMessage.In) (scheduleMessage.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,46 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	scheduledMessages "github.com/nice-pea/npchat/internal/usecases/messages/scheduled_messages"
+)
+
+// ScheduledMessages регистрирует обработчик, позволяющий получить ожидающие отправки сообщения пользователя.
+// Доступен только авторизованным пользователям.
+// Необязательный параметр chat_id ограничивает выборку одним чатом.
+//
+// Метод: GET /scheduled-messages
+func ScheduledMessages(router *fiber.App, uc UsecasesForScheduledMessages, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/scheduled-messages",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			chatID, err := queryUUID(ctx, "chat_id")
+			if err != nil {
+				return err
+			}
+
+			input := scheduledMessages.In{
+				SubjectID: UserID(ctx),
+				ChatID:    chatID,
+			}
+
+			out, err := uc.ScheduledMessages(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForScheduledMessages определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForScheduledMessages interface {
+	ScheduledMessages(scheduledMessages.In) (scheduledMessages.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
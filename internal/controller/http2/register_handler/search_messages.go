@@ -0,0 +1,74 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	searchMessages "github.com/nice-pea/npchat/internal/usecases/messages/search_messages"
+)
+
+// SearchMessages регистрирует HTTP-обработчик для полнотекстового поиска сообщений.
+// Поиск выполняется только по чатам, в которых участвует пользователь.
+// Данный обработчик доступен только авторизованным пользователям.
+//
+// Метод: GET /search/messages?q={query}
+func SearchMessages(router *fiber.App, uc UsecasesForSearchMessages, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/search/messages",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			keyset, err := decodeKeyset[searchMessages.Keyset](ctx.Query("page_token"))
+			if err != nil {
+				return err
+			}
+			chatID, err := queryUUID(ctx, "chat_id")
+			if err != nil {
+				return err
+			}
+			authorID, err := queryUUID(ctx, "author_id")
+			if err != nil {
+				return err
+			}
+			createdAfter, err := queryTime(ctx, "created_after")
+			if err != nil {
+				return err
+			}
+			createdBefore, err := queryTime(ctx, "created_before")
+			if err != nil {
+				return err
+			}
+
+			input := searchMessages.In{
+				SubjectID:     UserID(ctx),
+				Query:         ctx.Query("q"),
+				ChatID:        chatID,
+				AuthorID:      authorID,
+				CreatedAfter:  createdAfter,
+				CreatedBefore: createdBefore,
+				Keyset:        keyset,
+			}
+
+			out, err := uc.SearchMessages(input)
+			if err != nil {
+				return err
+			}
+			nextPageToken, err := encodeKeyset(out.NextKeyset)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(fiber.Map{
+				"Results":         out.Results,
+				"next_page_token": nextPageToken,
+			})
+		},
+	)
+}
+
+// UsecasesForSearchMessages определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForSearchMessages interface {
+	SearchMessages(searchMessages.In) (searchMessages.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -1,6 +1,8 @@
 package registerHandler
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/google/uuid"
@@ -16,8 +18,10 @@ import (
 func SendInvitation(router *fiber.App, uc UsecasesForSendInvitation, jwtParser middleware.JwtParser) {
 	// Тело запроса для отправки приглашения.
 	type requestBody struct {
-		ChatID uuid.UUID `json:"chat_id"`
-		UserID uuid.UUID `json:"user_id"`
+		ChatID    uuid.UUID `json:"chat_id"`
+		UserID    uuid.UUID `json:"user_id"`
+		Note      string    `json:"note"`
+		ExpiresAt time.Time `json:"expires_at"`
 	}
 	router.Post(
 		"/invitations",
@@ -34,6 +38,8 @@ func SendInvitation(router *fiber.App, uc UsecasesForSendInvitation, jwtParser m
 				SubjectID: UserID(ctx),
 				ChatID:    rb.ChatID,
 				UserID:    rb.UserID,
+				Note:      rb.Note,
+				ExpiresAt: rb.ExpiresAt,
 			}
 
 			out, err := uc.SendInvitation(input)
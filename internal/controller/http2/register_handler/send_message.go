@@ -0,0 +1,60 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	sendMessage "github.com/nice-pea/npchat/internal/usecases/messages/send_message"
+)
+
+// SendMessage регистрирует обработчик, позволяющий отправить сообщение в чат.
+// Если указан parent_id, сообщение отправляется ответом в тред.
+// В attachment_ids передаются ID загруженных в чат вложений.
+// Текст может содержать разметку, описанную в messagee.ParseMarkup; в ответе текст возвращается без разметки вместе с Entities.
+// Текст, начинающийся с команды (например /invite @nick), выполняется как команда, результат возвращается в Command.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: POST /chats/{chatID}/messages
+func SendMessage(router *fiber.App, uc UsecasesForSendMessage, jwtParser middleware.JwtParser) {
+	// Тело запроса для отправки сообщения.
+	type requestBody struct {
+		Text          string      `json:"text"`
+		ParentID      uuid.UUID   `json:"parent_id"`
+		AttachmentIDs []uuid.UUID `json:"attachment_ids"`
+	}
+	router.Post(
+		"/chats/:chatID/messages",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := sendMessage.In{
+				SubjectID:     UserID(ctx),
+				ChatID:        ParamsUUID(ctx, "chatID"),
+				Text:          rb.Text,
+				ParentID:      rb.ParentID,
+				AttachmentIDs: rb.AttachmentIDs,
+			}
+
+			out, err := uc.SendMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForSendMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForSendMessage interface {
+	SendMessage(sendMessage.In) (sendMessage.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
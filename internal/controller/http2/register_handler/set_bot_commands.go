@@ -0,0 +1,64 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	setBotCommands "github.com/nice-pea/npchat/internal/usecases/users/bots/set_bot_commands"
+)
+
+// SetBotCommands регистрирует обработчик, позволяющий заменить список команд бота.
+// Доступен только авторизованным пользователям, которые являются владельцами бота, и самому боту.
+//
+// Метод: PUT /bots/{botID}/commands
+func SetBotCommands(router *fiber.App, uc UsecasesForSetBotCommands, jwtParser middleware.JwtParser) {
+	// Команда бота в теле запроса.
+	type command struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	// Тело запроса для замены команд бота.
+	type requestBody struct {
+		Commands []command `json:"commands"`
+	}
+	router.Put(
+		"/bots/:botID/commands",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			commands := make([]userr.BotCommand, len(rb.Commands))
+			for i, c := range rb.Commands {
+				commands[i] = userr.BotCommand{
+					Name:        c.Name,
+					Description: c.Description,
+				}
+			}
+			input := setBotCommands.In{
+				SubjectID: UserID(ctx),
+				BotID:     ParamsUUID(ctx, "botID"),
+				Commands:  commands,
+			}
+
+			out, err := uc.SetBotCommands(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForSetBotCommands определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForSetBotCommands interface {
+	SetBotCommands(setBotCommands.In) (setBotCommands.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,59 @@
+package registerHandler
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	setRetention "github.com/nice-pea/npchat/internal/usecases/chats/set_retention"
+)
+
+// SetChatRetention регистрирует обработчик, позволяющий изменить политику хранения сообщений чата.
+// Доступен только авторизованным пользователям, которые являются главными администраторами чата.
+// Нулевые значения отключают соответствующее ограничение.
+//
+// Метод: PUT /chats/{chatID}/retention
+func SetChatRetention(router *fiber.App, uc UsecasesForSetRetention, jwtParser middleware.JwtParser) {
+	// Тело запроса для изменения политики хранения сообщений.
+	type requestBody struct {
+		MaxAgeDays          int `json:"max_age_days"`
+		SelfDestructSeconds int `json:"self_destruct_seconds"`
+	}
+	router.Put(
+		"/chats/:chatID/retention",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := setRetention.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				Retention: chatt.Retention{
+					MaxAge:       time.Duration(rb.MaxAgeDays) * 24 * time.Hour,
+					SelfDestruct: time.Duration(rb.SelfDestructSeconds) * time.Second,
+				},
+			}
+
+			out, err := uc.SetRetention(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForSetRetention определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForSetRetention interface {
+	SetRetention(setRetention.In) (setRetention.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,54 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	setParticipantRole "github.com/nice-pea/npchat/internal/usecases/chats/set_participant_role"
+)
+
+// SetParticipantRole регистрирует обработчик, позволяющий изменить роль участника чата.
+// Доступен только авторизованным пользователям с правом изменять роли,
+// для участников с ролью младше своей.
+//
+// Метод: PUT /chats/{chatID}/members/{userID}/role
+func SetParticipantRole(router *fiber.App, uc UsecasesForSetParticipantRole, jwtParser middleware.JwtParser) {
+	// Тело запроса для изменения роли участника.
+	type requestBody struct {
+		Role string `json:"role"`
+	}
+	router.Put(
+		"/chats/:chatID/members/:userID/role",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := setParticipantRole.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				UserID:    ParamsUUID(ctx, "userID"),
+				Role:      chatt.Role(rb.Role),
+			}
+
+			out, err := uc.SetParticipantRole(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForSetParticipantRole определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForSetParticipantRole interface {
+	SetParticipantRole(setParticipantRole.In) (setParticipantRole.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,56 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	threadMessages "github.com/nice-pea/npchat/internal/usecases/messages/thread_messages"
+)
+
+// ThreadMessages регистрирует HTTP-обработчик для получения ответов в треде сообщения.
+// Данный обработчик доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: GET /chats/{chatID}/messages/{messageID}/thread
+func ThreadMessages(router *fiber.App, uc UsecasesForThreadMessages, jwtParser middleware.JwtParser) {
+	router.Get(
+		"/chats/:chatID/messages/:messageID/thread",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			keyset, err := decodeKeyset[threadMessages.Keyset](ctx.Query("page_token"))
+			if err != nil {
+				return err
+			}
+
+			input := threadMessages.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+				Keyset:    keyset,
+			}
+
+			out, err := uc.ThreadMessages(input)
+			if err != nil {
+				return err
+			}
+			nextPageToken, err := encodeKeyset(out.NextKeyset)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(fiber.Map{
+				"Root":            out.Root,
+				"Messages":        out.Messages,
+				"Reactions":       out.Reactions,
+				"next_page_token": nextPageToken,
+			})
+		},
+	)
+}
+
+// UsecasesForThreadMessages определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForThreadMessages interface {
+	ThreadMessages(threadMessages.In) (threadMessages.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
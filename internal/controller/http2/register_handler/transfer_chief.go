@@ -0,0 +1,52 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	transferChief "github.com/nice-pea/npchat/internal/usecases/chats/transfer_chief"
+)
+
+// TransferChief регистрирует обработчик, позволяющий передать владение чатом другому участнику.
+// Доступен только авторизованному главному администратору чата.
+//
+// Метод: PUT /chats/{chatID}/chief
+func TransferChief(router *fiber.App, uc UsecasesForTransferChief, jwtParser middleware.JwtParser) {
+	// Тело запроса для передачи владения чатом.
+	type requestBody struct {
+		UserID uuid.UUID `json:"user_id"`
+	}
+	router.Put(
+		"/chats/:chatID/chief",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := transferChief.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				UserID:    rb.UserID,
+			}
+
+			out, err := uc.TransferChief(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForTransferChief определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForTransferChief interface {
+	TransferChief(transferChief.In) (transferChief.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
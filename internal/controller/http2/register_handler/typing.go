@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	"github.com/nice-pea/npchat/internal/usecases/chats/typing"
+)
+
+// Typing регистрирует обработчик, позволяющий сообщить о наборе текста в чате.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: POST /chats/{chatID}/typing
+func Typing(router *fiber.App, uc UsecasesForTyping, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/chats/:chatID/typing",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := typing.In{
+				SubjectID: UserID(ctx),
+				SessionID: SessionID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+			}
+
+			out, err := uc.Typing(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForTyping определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForTyping interface {
+	Typing(typing.In) (typing.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
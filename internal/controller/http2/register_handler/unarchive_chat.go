@@ -0,0 +1,40 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	unarchiveChat "github.com/nice-pea/npchat/internal/usecases/chats/unarchive_chat"
+)
+
+// UnarchiveChat регистрирует обработчик, позволяющий вернуть чат из архива.
+// Доступен только авторизованному главному администратору чата.
+//
+// Метод: DELETE /chats/{chatID}/archive
+func UnarchiveChat(router *fiber.App, uc UsecasesForUnarchiveChat, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID/archive",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := unarchiveChat.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+			}
+
+			out, err := uc.UnarchiveChat(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForUnarchiveChat определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForUnarchiveChat interface {
+	UnarchiveChat(unarchiveChat.In) (unarchiveChat.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,41 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	unpinMessage "github.com/nice-pea/npchat/internal/usecases/messages/unpin_message"
+)
+
+// UnpinMessage регистрирует обработчик, позволяющий открепить сообщение в чате.
+// Доступен только авторизованным пользователям, которые являются главными администраторами чата.
+//
+// Метод: DELETE /chats/{chatID}/messages/{messageID}/pin
+func UnpinMessage(router *fiber.App, uc UsecasesForUnpinMessage, jwtParser middleware.JwtParser) {
+	router.Delete(
+		"/chats/:chatID/messages/:messageID/pin",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			input := unpinMessage.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+			}
+
+			out, err := uc.UnpinMessage(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForUnpinMessage определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForUnpinMessage interface {
+	UnpinMessage(unpinMessage.In) (unpinMessage.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -0,0 +1,63 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	uploadAttachment "github.com/nice-pea/npchat/internal/usecases/attachments/upload_attachment"
+)
+
+// UploadAttachment регистрирует обработчик, позволяющий загрузить файл в чат.
+// Файл передается в поле file формы multipart/form-data.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: POST /chats/{chatID}/attachments
+func UploadAttachment(router *fiber.App, uc UsecasesForUploadAttachment, jwtParser middleware.JwtParser) {
+	router.Post(
+		"/chats/:chatID/attachments",
+		recover2.New(),
+		// Ограничение размера тела запроса с запасом на служебные части формы
+		middleware.LimitBody(attachmentt.MaxSize+1<<20),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			fileHeader, err := ctx.FormFile("file")
+			if err != nil {
+				return err
+			}
+			file, err := fileHeader.Open()
+			if err != nil {
+				return err
+			}
+			defer func() { _ = file.Close() }()
+
+			// Тип содержимого берется из заголовка части формы
+			mimeType := fileHeader.Header.Get(fiber.HeaderContentType)
+			if mimeType == "" {
+				mimeType = fiber.MIMEOctetStream
+			}
+
+			input := uploadAttachment.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				Name:      fileHeader.Filename,
+				MimeType:  mimeType,
+				Content:   file,
+			}
+
+			out, err := uc.UploadAttachment(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForUploadAttachment определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForUploadAttachment interface {
+	UploadAttachment(uploadAttachment.In) (uploadAttachment.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
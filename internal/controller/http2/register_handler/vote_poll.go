@@ -0,0 +1,53 @@
+package registerHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	recover2 "github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/nice-pea/npchat/internal/controller/http2/middleware"
+	votePoll "github.com/nice-pea/npchat/internal/usecases/messages/vote_poll"
+)
+
+// VotePoll регистрирует обработчик, позволяющий проголосовать в опросе.
+// Повторный голос заменяет прежний.
+// Доступен только авторизованным пользователям, которые являются участниками чата.
+//
+// Метод: PUT /chats/{chatID}/messages/{messageID}/votes
+func VotePoll(router *fiber.App, uc UsecasesForVotePoll, jwtParser middleware.JwtParser) {
+	// Тело запроса для голосования.
+	type requestBody struct {
+		Options []int `json:"options"`
+	}
+	router.Put(
+		"/chats/:chatID/messages/:messageID/votes",
+		recover2.New(),
+		middleware.RequireAuthorizedSession(uc, jwtParser),
+		func(ctx *fiber.Ctx) error {
+			var rb requestBody
+			// Декодируем тело запроса в структуру requestBody.
+			if err := ctx.BodyParser(&rb); err != nil {
+				return err
+			}
+
+			input := votePoll.In{
+				SubjectID: UserID(ctx),
+				ChatID:    ParamsUUID(ctx, "chatID"),
+				MessageID: ParamsUUID(ctx, "messageID"),
+				Options:   rb.Options,
+			}
+
+			out, err := uc.VotePoll(input)
+			if err != nil {
+				return err
+			}
+
+			return ctx.JSON(out)
+		},
+	)
+}
+
+// UsecasesForVotePoll определяет интерфейс для доступа к сценариям использования бизнес-логики
+type UsecasesForVotePoll interface {
+	VotePoll(votePoll.In) (votePoll.Out, error)
+	middleware.UsecasesForRequireAuthorizedSession
+}
@@ -8,10 +8,18 @@ type RequiredUsecases interface {
 	registerHandler.UsecasesForLoginByPassword
 	registerHandler.UsecasesForRegistrationByPassword
 	registerHandler.UsecasesForCancelInvitation
+	registerHandler.UsecasesForDeclineInvitation
 	registerHandler.UsecasesForChatInvitations
 	registerHandler.UsecasesForChatMembers
 	registerHandler.UsecasesForCreateChat
+	registerHandler.UsecasesForCreateDirectChat
 	registerHandler.UsecasesForDeleteMember
+	registerHandler.UsecasesForSetParticipantRole
+	registerHandler.UsecasesForTransferChief
+	registerHandler.UsecasesForForceTransferChief
+	registerHandler.UsecasesForArchiveChat
+	registerHandler.UsecasesForUnarchiveChat
+	registerHandler.UsecasesForDeleteChat
 	registerHandler.UsecasesForLeaveChat
 	registerHandler.UsecasesForMyChats
 	registerHandler.UsecasesForMyInvitations
@@ -19,6 +27,49 @@ type RequiredUsecases interface {
 	registerHandler.UsecasesForOauthCallback
 	registerHandler.UsecasesForSendInvitation
 	registerHandler.UsecasesForUpdateName
+	registerHandler.UsecasesForSetRetention
+	registerHandler.UsecasesForSendMessage
+	registerHandler.UsecasesForChatMessages
+	registerHandler.UsecasesForEditMessage
+	registerHandler.UsecasesForDeleteMessage
+	registerHandler.UsecasesForMessageRevisions
+	registerHandler.UsecasesForThreadMessages
+	registerHandler.UsecasesForForwardMessage
+	registerHandler.UsecasesForMentions
+	registerHandler.UsecasesForAddReaction
+	registerHandler.UsecasesForRemoveReaction
+	registerHandler.UsecasesForCreatePoll
+	registerHandler.UsecasesForVotePoll
+	registerHandler.UsecasesForRetractVote
+	registerHandler.UsecasesForMarkRead
+	registerHandler.UsecasesForPinMessage
+	registerHandler.UsecasesForUnpinMessage
+	registerHandler.UsecasesForChatPins
+	registerHandler.UsecasesForScheduleMessage
+	registerHandler.UsecasesForScheduledMessages
+	registerHandler.UsecasesForCancelScheduledMessage
+	registerHandler.UsecasesForTyping
+	registerHandler.UsecasesForPutDraft
+	registerHandler.UsecasesForClearDraft
+	registerHandler.UsecasesForSearchMessages
+	registerHandler.UsecasesForUploadAttachment
+	registerHandler.UsecasesForDownloadAttachment
 	registerHandler.UsecasesForGetUser
 	registerHandler.UsecasesForMe
+	registerHandler.UsecasesForCreateBot
+	registerHandler.UsecasesForMyBots
+	registerHandler.UsecasesForRotateBotToken
+	registerHandler.UsecasesForSetBotCommands
+	registerHandler.UsecasesForReplyCommand
+	registerHandler.UsecasesForCreateWebhook
+	registerHandler.UsecasesForChatWebhooks
+	registerHandler.UsecasesForRotateWebhookToken
+	registerHandler.UsecasesForRevokeWebhook
+	registerHandler.UsecasesForPostWebhookMessage
+	registerHandler.UsecasesForCreateInviteLink
+	registerHandler.UsecasesForChatInviteLinks
+	registerHandler.UsecasesForRevokeInviteLink
+	registerHandler.UsecasesForJoinByLink
+	registerHandler.UsecasesForApproveJoinRequest
+	registerHandler.UsecasesForRejectJoinRequest
 }
@@ -22,13 +22,3 @@ package domain
 //	Name  string // apn, fcm, gcm, sms
 //	AccessToken string // Токен для отправки уведомлений
 //}
-
-// Сообщение
-//
-//type MessageAggregate struct {
-//	ID      string // Уникальный идентификатор сообщения
-//	Text    string // Текст сообщения
-//	Timestamp time.Time // Время создания сообщения
-//	UserID string // Идентификатор пользователя, отправившего сообщение
-//	ChatID string // Идентификатор чата, к которому относится сообщение
-//}
@@ -0,0 +1,81 @@
+package attachmentt
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+// Attachment представляет собой агрегат вложения - файла, загруженного в чат.
+// Содержимое файла хранится в Storage под ключом StorageKey
+type Attachment struct {
+	ID         uuid.UUID // Уникальный ID вложения
+	ChatID     uuid.UUID // ID чата, в который загружено вложение
+	UploaderID uuid.UUID // ID пользователя, загрузившего вложение
+	Name       string    // Имя файла
+	MimeType   string    // MIME тип содержимого
+	Size       int64     // Размер содержимого в байтах
+	Checksum   string    // Контрольная сумма содержимого (SHA-256 в hex)
+	StorageKey string    // Ключ содержимого в хранилище
+	CreatedAt  time.Time // Время загрузки вложения
+}
+
+// NewAttachment создает новое вложение в чате.
+// Загружать вложения могут только участники чата, которым разрешено отправлять сообщения
+func NewAttachment(chat chatt.Chat, uploaderID uuid.UUID, name, mimeType string, size int64, checksum, storageKey string) (Attachment, error) {
+	if err := domain.ValidateID(uploaderID); err != nil {
+		return Attachment{}, errors.Join(err, ErrInvalidUploaderID)
+	}
+	if err := ValidateName(name); err != nil {
+		return Attachment{}, err
+	}
+	if err := ValidateMimeType(mimeType); err != nil {
+		return Attachment{}, err
+	}
+	if err := ValidateSize(size); err != nil {
+		return Attachment{}, err
+	}
+	if err := ValidateChecksum(checksum); err != nil {
+		return Attachment{}, err
+	}
+	if storageKey == "" {
+		return Attachment{}, ErrInvalidStorageKey
+	}
+
+	// Проверить право загружать вложения в чат
+	if err := ValidateUploader(chat, uploaderID); err != nil {
+		return Attachment{}, err
+	}
+
+	return Attachment{
+		ID:         uuid.New(),
+		ChatID:     chat.ID,
+		UploaderID: uploaderID,
+		Name:       name,
+		MimeType:   mimeType,
+		Size:       size,
+		Checksum:   checksum,
+		StorageKey: storageKey,
+		CreatedAt:  time.Now().UTC().Truncate(time.Microsecond),
+	}, nil
+}
+
+// ValidateUploader проверяет, что пользователь может загружать вложения в чат.
+// Загружать вложения могут только участники неархивного чата с правом отправлять сообщения
+func ValidateUploader(chat chatt.Chat, uploaderID uuid.UUID) error {
+	if !chat.HasParticipant(uploaderID) {
+		return ErrUploaderIsNotMember
+	}
+	if chat.IsArchived() {
+		return chatt.ErrChatIsArchived
+	}
+	if !chat.Can(uploaderID, chatt.PermissionSendMessages) {
+		return ErrUploaderCannotSend
+	}
+
+	return nil
+}
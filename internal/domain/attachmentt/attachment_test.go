@@ -0,0 +1,123 @@
+package attachmentt
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+// TestNewAttachment тестирует создание вложения.
+func TestNewAttachment(t *testing.T) {
+	checksum := rndChecksum()
+
+	t.Run("параметр uploaderID должен быть валидным UUID", func(t *testing.T) {
+		chat := newChat(t)
+		attachment, err := NewAttachment(chat, uuid.Nil, "file.txt", "text/plain", 10, checksum, "key")
+		assert.Zero(t, attachment)
+		assert.ErrorIs(t, err, ErrInvalidUploaderID)
+	})
+
+	t.Run("параметры файла должны быть валидными", func(t *testing.T) {
+		chat := newChat(t)
+		_, err := NewAttachment(chat, chat.ChiefID, "../file.txt", "text/plain", 10, checksum, "key")
+		assert.ErrorIs(t, err, ErrInvalidName)
+		_, err = NewAttachment(chat, chat.ChiefID, "file.txt", "text", 10, checksum, "key")
+		assert.ErrorIs(t, err, ErrInvalidMimeType)
+		_, err = NewAttachment(chat, chat.ChiefID, "file.txt", "text/plain", 0, checksum, "key")
+		assert.ErrorIs(t, err, ErrEmptyFile)
+		_, err = NewAttachment(chat, chat.ChiefID, "file.txt", "text/plain", MaxSize+1, checksum, "key")
+		assert.ErrorIs(t, err, ErrFileTooLarge)
+		_, err = NewAttachment(chat, chat.ChiefID, "file.txt", "text/plain", 10, "abc", "key")
+		assert.ErrorIs(t, err, ErrInvalidChecksum)
+		_, err = NewAttachment(chat, chat.ChiefID, "file.txt", "text/plain", 10, checksum, "")
+		assert.ErrorIs(t, err, ErrInvalidStorageKey)
+	})
+
+	t.Run("загружать вложения могут только участники чата", func(t *testing.T) {
+		chat := newChat(t)
+		attachment, err := NewAttachment(chat, uuid.New(), "file.txt", "text/plain", 10, checksum, "key")
+		assert.Zero(t, attachment)
+		assert.ErrorIs(t, err, ErrUploaderIsNotMember)
+	})
+
+	t.Run("в архивный чат нельзя загружать вложения", func(t *testing.T) {
+		chat := newChat(t)
+		require.NoError(t, chat.Archive(chat.ChiefID, nil))
+		attachment, err := NewAttachment(chat, chat.ChiefID, "file.txt", "text/plain", 10, checksum, "key")
+		assert.Zero(t, attachment)
+		assert.ErrorIs(t, err, chatt.ErrChatIsArchived)
+	})
+
+	t.Run("участник только для чтения не может загружать вложения", func(t *testing.T) {
+		chat := newChat(t)
+		participant, err := chatt.NewParticipant(uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, chat.AddParticipant(participant, nil))
+		require.NoError(t, chat.SetParticipantRole(chat.ChiefID, participant.UserID, chatt.RoleReadOnly, nil))
+		attachment, err := NewAttachment(chat, participant.UserID, "file.txt", "text/plain", 10, checksum, "key")
+		assert.Zero(t, attachment)
+		assert.ErrorIs(t, err, ErrUploaderCannotSend)
+	})
+
+	t.Run("новому вложению присваивается id, другие свойства равны переданным", func(t *testing.T) {
+		chat := newChat(t)
+		before := time.Now().UTC().Truncate(time.Microsecond)
+		attachment, err := NewAttachment(chat, chat.ChiefID, "отчет.pdf", "application/pdf", 1024, checksum, "key")
+		require.NoError(t, err)
+		assert.NotZero(t, attachment.ID)
+		assert.Equal(t, chat.ID, attachment.ChatID)
+		assert.Equal(t, chat.ChiefID, attachment.UploaderID)
+		assert.Equal(t, "отчет.pdf", attachment.Name)
+		assert.Equal(t, "application/pdf", attachment.MimeType)
+		assert.Equal(t, int64(1024), attachment.Size)
+		assert.Equal(t, checksum, attachment.Checksum)
+		assert.Equal(t, "key", attachment.StorageKey)
+		assert.False(t, attachment.CreatedAt.Before(before))
+	})
+}
+
+// TestValidateName тестирует валидацию имени файла.
+func TestValidateName(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		wantErr  bool
+	}{
+		{name: "пустая строка", fileName: "", wantErr: true},
+		{name: "только пробелы", fileName: "   ", wantErr: true},
+		{name: "ссылка на родительскую директорию", fileName: "..", wantErr: true},
+		{name: "содержит разделитель пути", fileName: "dir/file.txt", wantErr: true},
+		{name: "содержит обратный разделитель пути", fileName: `dir\file.txt`, wantErr: true},
+		{name: "содержит управляющий символ", fileName: "fi\nle.txt", wantErr: true},
+		{name: "обычное имя", fileName: "file.txt", wantErr: false},
+		{name: "имя на кириллице с пробелами", fileName: "мой отчет.pdf", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				assert.ErrorIs(t, ValidateName(tt.fileName), ErrInvalidName)
+			} else {
+				assert.NoError(t, ValidateName(tt.fileName))
+			}
+		})
+	}
+}
+
+func newChat(t *testing.T) chatt.Chat {
+	t.Helper()
+	chat, err := chatt.NewChat("test chat", uuid.New(), nil)
+	require.NoError(t, err)
+	return chat
+}
+
+func rndChecksum() string {
+	sum := sha256.Sum256([]byte(uuid.NewString()))
+	return hex.EncodeToString(sum[:])
+}
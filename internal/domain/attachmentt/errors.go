@@ -0,0 +1,20 @@
+package attachmentt
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidUploaderID   = errors.New("некорректное значение UploaderID")
+	ErrInvalidName         = errors.New("некорректное имя файла")
+	ErrInvalidMimeType     = errors.New("некорректное значение MIME типа")
+	ErrInvalidChecksum     = errors.New("некорректное значение контрольной суммы")
+	ErrInvalidStorageKey   = errors.New("некорректное значение ключа хранилища")
+	ErrEmptyFile           = errors.New("файл не может быть пустым")
+	ErrFileTooLarge        = fmt.Errorf("размер файла не может превышать %d байт", MaxSize)
+	ErrUploaderIsNotMember = errors.New("пользователь не является участником чата")
+	ErrUploaderCannotSend  = errors.New("у пользователя нет права отправлять сообщения в чат")
+	ErrAttachmentNotExists = errors.New("вложения не существует")
+	ErrBlobNotExists       = errors.New("содержимого вложения не существует в хранилище")
+)
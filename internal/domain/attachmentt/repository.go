@@ -0,0 +1,34 @@
+package attachmentt
+
+import (
+	"github.com/google/uuid"
+)
+
+// Repository представляет собой интерфейс для работы с репозиторием вложений.
+type Repository interface {
+	List(Filter) ([]Attachment, error)
+	Upsert(Attachment) error
+	// Delete удаляет вложения по ID. Вложения, на которые еще ссылаются сообщения, не удаляются
+	Delete(ids []uuid.UUID) error
+	InTransaction(func(txRepo Repository) error) error
+}
+
+// Filter представляет собой фильтр для выборки вложений.
+type Filter struct {
+	ID     uuid.UUID   // Фильтрация по ID вложения
+	IDs    []uuid.UUID // Фильтрация по списку ID вложений
+	ChatID uuid.UUID   // Фильтрация по ID чата
+}
+
+// Find возвращает вложение либо ошибку ErrAttachmentNotExists
+func Find(repo Repository, filter Filter) (Attachment, error) {
+	attachments, err := repo.List(filter)
+	if err != nil {
+		return Attachment{}, err
+	}
+	if len(attachments) != 1 {
+		return Attachment{}, ErrAttachmentNotExists
+	}
+
+	return attachments[0], nil
+}
@@ -0,0 +1,15 @@
+package attachmentt
+
+import (
+	"io"
+)
+
+// Storage представляет собой интерфейс хранилища содержимого вложений.
+type Storage interface {
+	// Put сохраняет содержимое под ключом key
+	Put(key string, content io.Reader) error
+	// Get возвращает содержимое по ключу key либо ошибку ErrBlobNotExists
+	Get(key string) (io.ReadCloser, error)
+	// Delete удаляет содержимое по ключу key. Отсутствие содержимого не является ошибкой
+	Delete(key string) error
+}
@@ -0,0 +1,64 @@
+package attachmentt
+
+import (
+	"encoding/hex"
+	"mime"
+	"strings"
+	"unicode"
+)
+
+// MaxSize максимальный размер вложения в байтах.
+const MaxSize = 25 << 20
+
+// NameMaxLen максимальная длина имени файла.
+const NameMaxLen = 255
+
+// ValidateName проверяет корректность имени файла.
+// Имя не может быть пустым, содержать разделители пути и управляющие символы
+func ValidateName(name string) error {
+	if strings.TrimSpace(name) == "" || len([]rune(name)) > NameMaxLen {
+		return ErrInvalidName
+	}
+	if name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return ErrInvalidName
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return ErrInvalidName
+		}
+	}
+
+	return nil
+}
+
+// ValidateMimeType проверяет корректность MIME типа.
+func ValidateMimeType(mimeType string) error {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil || !strings.Contains(mediaType, "/") {
+		return ErrInvalidMimeType
+	}
+
+	return nil
+}
+
+// ValidateSize проверяет, что размер вложения находится в допустимых пределах.
+func ValidateSize(size int64) error {
+	if size <= 0 {
+		return ErrEmptyFile
+	}
+	if size > MaxSize {
+		return ErrFileTooLarge
+	}
+
+	return nil
+}
+
+// ValidateChecksum проверяет, что контрольная сумма является SHA-256 в hex.
+func ValidateChecksum(checksum string) error {
+	b, err := hex.DecodeString(checksum)
+	if err != nil || len(b) != 32 {
+		return ErrInvalidChecksum
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package chatt
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// IsArchived проверяет, находится ли чат в архиве.
+// Архивный чат доступен участникам только для чтения
+func (c *Chat) IsArchived() bool {
+	return !c.ArchivedAt.IsZero()
+}
+
+// Archive переносит чат в архив.
+// Архивировать чат может только главный администратор, а личный чат любой из собеседников
+func (c *Chat) Archive(subjectID uuid.UUID, eventsBuf *events.Buffer) error {
+	if err := c.checkCanManage(subjectID); err != nil {
+		return err
+	}
+	if c.IsArchived() {
+		return ErrChatIsArchived
+	}
+
+	c.ArchivedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventChatUpdated())
+
+	return nil
+}
+
+// Unarchive возвращает чат из архива.
+// Вернуть чат из архива может только главный администратор, а личный чат любой из собеседников
+func (c *Chat) Unarchive(subjectID uuid.UUID, eventsBuf *events.Buffer) error {
+	if err := c.checkCanManage(subjectID); err != nil {
+		return err
+	}
+	if !c.IsArchived() {
+		return ErrChatIsNotArchived
+	}
+
+	c.ArchivedAt = time.Time{}
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventChatUpdated())
+
+	return nil
+}
+
+// Delete подготавливает чат к удалению и уведомляет об этом всех участников.
+// Удалить чат может только главный администратор, а личный чат любой из собеседников
+func (c *Chat) Delete(subjectID uuid.UUID, eventsBuf *events.Buffer) error {
+	if err := c.checkCanManage(subjectID); err != nil {
+		return err
+	}
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventChatDeleted())
+
+	return nil
+}
+
+// checkCanManage проверяет, может ли пользователь архивировать и удалять чат.
+// В личном чате у собеседников равные права, поэтому проверяется только участие
+func (c *Chat) checkCanManage(subjectID uuid.UUID) error {
+	if c.IsDirect() {
+		if !c.HasParticipant(subjectID) {
+			return ErrSubjectIsNotMember
+		}
+		return nil
+	}
+	if subjectID != c.ChiefID {
+		return ErrSubjectIsNotChief
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package chatt
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestChat_Archive тестирует перенос чата в архив и возврат из него.
+func TestChat_Archive(t *testing.T) {
+	t.Run("архивировать чат может только главный администратор", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		adminID := uuid.New()
+		require.NoError(t, chat.AddParticipant(Participant{UserID: adminID, Role: RoleAdmin}, nil))
+		assert.ErrorIs(t, chat.Archive(adminID, nil), ErrSubjectIsNotChief)
+		assert.False(t, chat.IsArchived())
+	})
+
+	t.Run("архивный чат нельзя архивировать повторно", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		require.NoError(t, chat.Archive(chat.ChiefID, nil))
+		assert.ErrorIs(t, chat.Archive(chat.ChiefID, nil), ErrChatIsArchived)
+	})
+
+	t.Run("в архивном чате нет прав на изменения", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		eventsBuf := new(events.Buffer)
+		require.NoError(t, chat.Archive(chat.ChiefID, eventsBuf))
+		assert.True(t, chat.IsArchived())
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventChatUpdated, eventsBuf.Events()[0].Type)
+
+		for _, permission := range rolePermissions[RoleOwner] {
+			assert.False(t, chat.Can(chat.ChiefID, permission))
+		}
+		err = chat.AddParticipant(Participant{UserID: uuid.New(), Role: RoleMember}, nil)
+		assert.ErrorIs(t, err, ErrChatIsArchived)
+		assert.ErrorIs(t, chat.Typing(chat.ChiefID, nil), ErrChatIsArchived)
+	})
+
+	t.Run("вернуть из архива можно только архивный чат", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		assert.ErrorIs(t, chat.Unarchive(chat.ChiefID, nil), ErrChatIsNotArchived)
+	})
+
+	t.Run("после возврата из архива права восстанавливаются", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		require.NoError(t, chat.Archive(chat.ChiefID, nil))
+		assert.ErrorIs(t, chat.Unarchive(uuid.New(), nil), ErrSubjectIsNotChief)
+		require.NoError(t, chat.Unarchive(chat.ChiefID, nil))
+		assert.False(t, chat.IsArchived())
+		assert.True(t, chat.Can(chat.ChiefID, PermissionSendMessages))
+	})
+
+	t.Run("личный чат может архивировать и вернуть из архива любой собеседник", func(t *testing.T) {
+		subjectID, userID := uuid.New(), uuid.New()
+		chat, err := NewDirectChat(subjectID, userID, nil)
+		require.NoError(t, err)
+		require.NoError(t, chat.Archive(userID, nil))
+		assert.True(t, chat.IsArchived())
+		require.NoError(t, chat.Unarchive(userID, nil))
+		assert.False(t, chat.IsArchived())
+		assert.ErrorIs(t, chat.Archive(uuid.New(), nil), ErrSubjectIsNotMember)
+	})
+}
+
+// TestChat_Delete тестирует удаление чата.
+func TestChat_Delete(t *testing.T) {
+	t.Run("удалить чат может только главный администратор", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		adminID := uuid.New()
+		require.NoError(t, chat.AddParticipant(Participant{UserID: adminID, Role: RoleAdmin}, nil))
+		assert.ErrorIs(t, chat.Delete(adminID, nil), ErrSubjectIsNotChief)
+	})
+
+	t.Run("все участники получают событие удаления", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		require.NoError(t, chat.AddParticipant(Participant{UserID: uuid.New(), Role: RoleMember}, nil))
+		eventsBuf := new(events.Buffer)
+		require.NoError(t, chat.Delete(chat.ChiefID, eventsBuf))
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventChatDeleted, event.Type)
+		assert.ElementsMatch(t, chat.ParticipantIDs(), event.Recipients)
+	})
+
+	t.Run("личный чат может удалить любой собеседник", func(t *testing.T) {
+		subjectID, userID := uuid.New(), uuid.New()
+		chat, err := NewDirectChat(subjectID, userID, nil)
+		require.NoError(t, err)
+		require.NoError(t, chat.Delete(userID, nil))
+		assert.ErrorIs(t, chat.Delete(uuid.New(), nil), ErrSubjectIsNotMember)
+	})
+}
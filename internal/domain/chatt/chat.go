@@ -13,12 +13,16 @@ import (
 // Chat представляет собой агрегат чата.
 type Chat struct {
 	ID           uuid.UUID // Уникальный ID чата
+	Kind         Kind      // Вид чата
 	Name         string    // Название чата
-	ChiefID      uuid.UUID // ID главного пользователя чата
+	ChiefID      uuid.UUID // ID главного пользователя чата, участника с ролью владельца
 	LastActiveAt time.Time // Время последней активности в чате
+	Retention    Retention // Политика хранения сообщений
+	ArchivedAt   time.Time // Время переноса чата в архив. Нулевое значение - чат не в архиве
 
 	Participants []Participant // Список участников чата
 	Invitations  []Invitation  // Список приглашений в чате
+	Pins         []Pin         // Список закрепленных сообщений
 }
 
 // NewChat создает новый чат.
@@ -32,13 +36,15 @@ func NewChat(name string, chiefID uuid.UUID, eventsBuf *events.Buffer) (Chat, er
 
 	chat := Chat{
 		ID:           uuid.New(),
+		Kind:         KindGroup,
 		Name:         name,
 		ChiefID:      chiefID,
 		LastActiveAt: time.Now().UTC().Truncate(time.Microsecond),
 		Participants: []Participant{
-			{UserID: chiefID}, // Главный администратор
+			{UserID: chiefID, Role: RoleOwner}, // Главный администратор
 		},
 		Invitations: []Invitation{},
+		Pins:        []Pin{},
 	}
 
 	// Добавить событие
@@ -49,6 +55,10 @@ func NewChat(name string, chiefID uuid.UUID, eventsBuf *events.Buffer) (Chat, er
 
 // UpdateName изменяет название чата.
 func (c *Chat) UpdateName(name string, eventsBuf *events.Buffer) error {
+	// У личного чата нет названия
+	if c.IsDirect() {
+		return ErrChatIsDirect
+	}
 	if err := ValidateChatName(name); err != nil {
 		return err
 	}
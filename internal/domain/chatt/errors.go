@@ -15,4 +15,26 @@ var (
 	ErrSubjectAndRecipientMustBeDifferent = errors.New("subject и recipient не могут быть одним лицом")
 	ErrChatNotExists                      = errors.New("чата с таким ID не существует")
 	ErrNewActiveLessThanActual            = errors.New("новое значение LastActiveAt меньше текущего")
+	ErrInvalidMessageID                   = errors.New("некорректное значение MessageID")
+	ErrReadMarkerMovesBackward            = errors.New("отметка прочтения не может сдвигаться назад")
+	ErrPinExists                          = errors.New("сообщение уже закреплено")
+	ErrPinNotExists                       = errors.New("сообщение не закреплено")
+	ErrTooManyPins                        = errors.New("превышено максимальное количество закрепленных сообщений")
+	ErrInvalidRetentionMaxAge             = errors.New("некорректный срок хранения сообщений")
+	ErrInvalidSelfDestruct                = errors.New("некорректное время самоуничтожения сообщений")
+	ErrInvalidRole                        = errors.New("некорректная роль участника")
+	ErrCannotAssignOwner                  = errors.New("роль владельца нельзя назначить, ее можно только передать")
+	ErrPermissionDenied                   = errors.New("у участника недостаточно прав для этого действия")
+	ErrSubjectIsNotChief                  = errors.New("пользователь не является главным администратором чата")
+	ErrUserIsAlreadyChief                 = errors.New("пользователь уже является главным администратором чата")
+	ErrNoChiefSuccessor                   = errors.New("в чате нет участника, которому можно передать владение")
+	ErrChatIsArchived                     = errors.New("чат находится в архиве и доступен только для чтения")
+	ErrChatIsNotArchived                  = errors.New("чат не находится в архиве")
+	ErrInvalidKind                        = errors.New("некорректный вид чата")
+	ErrDirectChatWithSelf                 = errors.New("нельзя создать личный чат с самим собой")
+	ErrChatIsDirect                       = errors.New("действие недоступно в личном чате")
+	ErrInvalidInvitationNote              = errors.New("некорректное сообщение к приглашению")
+	ErrInvalidInvitationExpiresAt         = errors.New("время окончания действия приглашения должно быть в будущем")
+	ErrInvitationIsExpired                = errors.New("срок действия приглашения истек")
+	ErrSubjectIsNotRecipient              = errors.New("пользователь не является получателем приглашения")
 )
@@ -4,17 +4,25 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
 
 	"github.com/nice-pea/npchat/internal/usecases/events"
 )
 
 const (
-	EventInvitationRemoved  = "invitation_removed"
-	EventInvitationAdded    = "invitation_added"
-	EventParticipantAdded   = "participant_added"
-	EventParticipantRemoved = "participant_removed"
-	EventChatCreated        = "chat_created"
-	EventChatUpdated        = "chat_updated"
+	EventInvitationRemoved      = "invitation_removed"
+	EventInvitationAdded        = "invitation_added"
+	EventInvitationDeclined     = "invitation_declined"
+	EventParticipantAdded       = "participant_added"
+	EventParticipantRemoved     = "participant_removed"
+	EventParticipantRoleChanged = "participant_role_changed"
+	EventChatCreated            = "chat_created"
+	EventChatUpdated            = "chat_updated"
+	EventChatDeleted            = "chat_deleted"
+	EventReadMarkerUpdated      = "read_marker_updated"
+	EventTyping                 = "typing"
+	EventMessagePinned          = "message_pinned"
+	EventMessageUnpinned        = "message_unpinned"
 )
 
 // NewEventInvitationRemoved описывает событие удаления приглашения
@@ -51,6 +59,23 @@ func (c *Chat) NewEventInvitationAdded(invitation Invitation) events.Event {
 	}
 }
 
+// NewEventInvitationDeclined описывает событие отклонения приглашения приглашенным пользователем
+func (c *Chat) NewEventInvitationDeclined(invitation Invitation) events.Event {
+	return events.Event{
+		Type:      EventInvitationDeclined,
+		CreatedIn: time.Now(),
+		Recipients: []uuid.UUID{
+			c.ChiefID,
+			invitation.SubjectID,
+			invitation.RecipientID,
+		},
+		Data: map[string]any{
+			"chat":       *c,
+			"invitation": invitation,
+		},
+	}
+}
+
 // NewEventParticipantAdded описывает событие добавления участника
 func (c *Chat) NewEventParticipantAdded(participant Participant) events.Event {
 	return events.Event{
@@ -77,6 +102,19 @@ func (c *Chat) NewEventParticipantRemoved(participant Participant) events.Event
 	}
 }
 
+// NewEventParticipantRoleChanged описывает событие изменения роли участника
+func (c *Chat) NewEventParticipantRoleChanged(participant Participant) events.Event {
+	return events.Event{
+		Type:       EventParticipantRoleChanged,
+		CreatedIn:  time.Now(),
+		Recipients: userIDs(c.Participants),
+		Data: map[string]any{
+			"chat":        *c,
+			"participant": participant,
+		},
+	}
+}
+
 // NewEventChatCreated описывает событие создания чата
 func (c *Chat) NewEventChatCreated() events.Event {
 	return events.Event{
@@ -100,3 +138,74 @@ func (c *Chat) NewEventChatUpdated() events.Event {
 		},
 	}
 }
+
+// NewEventChatDeleted описывает событие удаления чата
+func (c *Chat) NewEventChatDeleted() events.Event {
+	return events.Event{
+		Type:       EventChatDeleted,
+		CreatedIn:  time.Now(),
+		Recipients: userIDs(c.Participants),
+		Data: map[string]any{
+			"chat": *c,
+		},
+	}
+}
+
+// NewEventReadMarkerUpdated описывает событие перемещения отметки прочтения участника
+func (c *Chat) NewEventReadMarkerUpdated(participant Participant) events.Event {
+	return events.Event{
+		Type:       EventReadMarkerUpdated,
+		CreatedIn:  time.Now(),
+		Recipients: userIDs(c.Participants),
+		Data: map[string]any{
+			"chat_id":     c.ID,
+			"participant": participant,
+		},
+	}
+}
+
+// NewEventTyping описывает эфемерное событие набора текста участником.
+// Событие не сохраняется и теряет актуальность после expiresAt
+func (c *Chat) NewEventTyping(userID uuid.UUID, expiresAt time.Time) events.Event {
+	recipients := slices.DeleteFunc(userIDs(c.Participants), func(id uuid.UUID) bool {
+		return id == userID
+	})
+
+	return events.Event{
+		Type:       EventTyping,
+		CreatedIn:  time.Now(),
+		Recipients: recipients,
+		Data: map[string]any{
+			"chat_id":    c.ID,
+			"user_id":    userID,
+			"expires_at": expiresAt,
+		},
+		Ephemeral: true,
+	}
+}
+
+// NewEventMessagePinned описывает событие закрепления сообщения
+func (c *Chat) NewEventMessagePinned(pin Pin) events.Event {
+	return events.Event{
+		Type:       EventMessagePinned,
+		CreatedIn:  time.Now(),
+		Recipients: userIDs(c.Participants),
+		Data: map[string]any{
+			"chat_id": c.ID,
+			"pin":     pin,
+		},
+	}
+}
+
+// NewEventMessageUnpinned описывает событие открепления сообщения
+func (c *Chat) NewEventMessageUnpinned(pin Pin) events.Event {
+	return events.Event{
+		Type:       EventMessageUnpinned,
+		CreatedIn:  time.Now(),
+		Recipients: userIDs(c.Participants),
+		Data: map[string]any{
+			"chat_id": c.ID,
+			"pin":     pin,
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package chatt
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"golang.org/x/exp/slices"
 
@@ -8,15 +11,21 @@ import (
 	"github.com/nice-pea/npchat/internal/usecases/events"
 )
 
+// InvitationNoteMaxLen максимальная длина сообщения к приглашению
+const InvitationNoteMaxLen = 500
+
 // Invitation представляет собой отправленное приглашение в чат.
 type Invitation struct {
 	ID          uuid.UUID // Глобальный уникальный ID приглашения
 	RecipientID uuid.UUID // Пользователь, получивший приглашение
 	SubjectID   uuid.UUID // Пользователь, отправивший приглашение
+	Note        string    // Сообщение от пригласившего
+	CreatedAt   time.Time // Время создания
+	ExpiresAt   time.Time // Время окончания действия. Нулевое значение - приглашение бессрочное
 }
 
 // NewInvitation создает новое приглашение в чате
-func NewInvitation(subjectID, recipientID uuid.UUID) (Invitation, error) {
+func NewInvitation(subjectID, recipientID uuid.UUID, note string, expiresAt time.Time) (Invitation, error) {
 	if err := domain.ValidateID(subjectID); err != nil {
 		return Invitation{}, err
 	}
@@ -29,28 +38,72 @@ func NewInvitation(subjectID, recipientID uuid.UUID) (Invitation, error) {
 		return Invitation{}, ErrSubjectAndRecipientMustBeDifferent
 	}
 
+	note = strings.TrimSpace(note)
+	if err := ValidateInvitationNote(note); err != nil {
+		return Invitation{}, err
+	}
+
+	// Срок действия, если указан, должен заканчиваться в будущем
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return Invitation{}, ErrInvalidInvitationExpiresAt
+	}
+
 	return Invitation{
 		ID:          uuid.New(),
 		RecipientID: recipientID,
 		SubjectID:   subjectID,
+		Note:        note,
+		CreatedAt:   now,
+		ExpiresAt:   expiresAt.UTC().Truncate(time.Microsecond),
 	}, nil
 }
 
+// ValidateInvitationNote проверяет сообщение к приглашению.
+func ValidateInvitationNote(note string) error {
+	if len([]rune(note)) > InvitationNoteMaxLen {
+		return ErrInvalidInvitationNote
+	}
+
+	return nil
+}
+
+// IsExpired проверяет, истек ли срок действия приглашения на момент now.
+func (i Invitation) IsExpired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && !now.Before(i.ExpiresAt)
+}
+
 // AddInvitation добавляет приглашение в чат
 func (c *Chat) AddInvitation(invitation Invitation, eventsBuf *events.Buffer) error {
+	// В личный чат нельзя приглашать
+	if c.IsDirect() {
+		return ErrChatIsDirect
+	}
+
 	// Проверить является ли subject участником чата
 	if !c.HasParticipant(invitation.SubjectID) {
 		return ErrSubjectIsNotMember
 	}
 
+	// Проверить, может ли subject приглашать пользователей
+	if !c.Can(invitation.SubjectID, PermissionInvite) {
+		return ErrPermissionDenied
+	}
+
 	// Проверить является ли user участником чата
 	if c.HasParticipant(invitation.RecipientID) {
 		return ErrParticipantExists
 	}
 
-	// Проверить, не существует ли приглашение для этого пользователя в этот чат
-	if c.HasInvitationWithRecipient(invitation.RecipientID) {
-		return ErrUserIsAlreadyInvited
+	// Проверить, не существует ли приглашение для этого пользователя в этот чат.
+	// Истекшее приглашение заменяется новым
+	if existing, err := c.RecipientInvitation(invitation.RecipientID); err == nil {
+		if !existing.IsExpired(time.Now()) {
+			return ErrUserIsAlreadyInvited
+		}
+		if err = c.RemoveInvitation(existing.ID, eventsBuf); err != nil {
+			return err
+		}
 	}
 
 	c.Invitations = append(c.Invitations, invitation)
@@ -84,6 +137,51 @@ func (c *Chat) RemoveInvitation(id uuid.UUID, eventsBuf *events.Buffer) error {
 	return nil
 }
 
+// DeclineInvitation отклоняет приглашение от лица приглашенного пользователя
+func (c *Chat) DeclineInvitation(id, subjectID uuid.UUID, eventsBuf *events.Buffer) error {
+	invitation, err := c.Invitation(id)
+	if err != nil {
+		return err
+	}
+
+	// Отклонить приглашение может только приглашенный
+	if invitation.RecipientID != subjectID {
+		return ErrSubjectIsNotRecipient
+	}
+
+	// Удалить приглашение из списка
+	c.Invitations = slices.DeleteFunc(c.Invitations, func(i Invitation) bool {
+		return i.ID == id
+	})
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventInvitationDeclined(invitation))
+
+	return nil
+}
+
+// RemoveExpiredInvitations удаляет приглашения, срок действия которых истек на момент now.
+// Возвращает количество удаленных приглашений
+func (c *Chat) RemoveExpiredInvitations(now time.Time, eventsBuf *events.Buffer) int {
+	if len(c.Invitations) == 0 {
+		return 0
+	}
+
+	kept := c.Invitations[:0]
+	for _, invitation := range c.Invitations {
+		if invitation.IsExpired(now) {
+			eventsBuf.AddSafety(c.NewEventInvitationRemoved(invitation))
+			continue
+		}
+		kept = append(kept, invitation)
+	}
+
+	removed := len(c.Invitations) - len(kept)
+	c.Invitations = kept
+
+	return removed
+}
+
 // RemoveSubjectInvitations удаляет все приглашения, отправленные указанным пользователем
 func (c *Chat) removeSubjectInvitations(subjectID uuid.UUID, eventsBuf *events.Buffer) {
 	if len(c.Invitations) == 0 {
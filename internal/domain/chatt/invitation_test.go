@@ -1,7 +1,9 @@
 package chatt
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -13,20 +15,20 @@ import (
 // TestNewInvitation тестирует создание приглашения.
 func TestNewInvitation(t *testing.T) {
 	t.Run("параметр subjectID не должен быть пустым", func(t *testing.T) {
-		inv, err := NewInvitation(uuid.Nil, uuid.New())
+		inv, err := NewInvitation(uuid.Nil, uuid.New(), "", time.Time{})
 		assert.Zero(t, inv)
 		assert.Error(t, err)
 	})
 
 	t.Run("параметр recipientID не должен быть пустым", func(t *testing.T) {
-		inv, err := NewInvitation(uuid.New(), uuid.Nil)
+		inv, err := NewInvitation(uuid.New(), uuid.Nil, "", time.Time{})
 		assert.Zero(t, inv)
 		assert.Error(t, err)
 	})
 
 	t.Run("subjectID и recipientID не могут быть одинаковыми", func(t *testing.T) {
 		id := uuid.New()
-		inv, err := NewInvitation(id, id)
+		inv, err := NewInvitation(id, id, "", time.Time{})
 		assert.Zero(t, inv)
 		assert.ErrorIs(t, err, ErrSubjectAndRecipientMustBeDifferent)
 	})
@@ -34,7 +36,7 @@ func TestNewInvitation(t *testing.T) {
 	t.Run("создание валидного приглашения", func(t *testing.T) {
 		subjectID := uuid.New()
 		recipientID := uuid.New()
-		inv, err := NewInvitation(subjectID, recipientID)
+		inv, err := NewInvitation(subjectID, recipientID, "", time.Time{})
 		assert.NotZero(t, inv)
 		assert.NoError(t, err)
 		// В id устанавливается случайное значение ID
@@ -42,6 +44,31 @@ func TestNewInvitation(t *testing.T) {
 		// Свойства из параметров конструктора
 		assert.Equal(t, subjectID, inv.SubjectID)
 		assert.Equal(t, recipientID, inv.RecipientID)
+		assert.NotZero(t, inv.CreatedAt)
+		assert.Zero(t, inv.ExpiresAt)
+	})
+
+	t.Run("сообщение и срок действия сохраняются в приглашении", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+		inv, err := NewInvitation(uuid.New(), uuid.New(), " Присоединяйся ", expiresAt)
+		require.NoError(t, err)
+		assert.Equal(t, "Присоединяйся", inv.Note)
+		assert.Equal(t, expiresAt, inv.ExpiresAt)
+		assert.False(t, inv.IsExpired(time.Now()))
+		assert.True(t, inv.IsExpired(expiresAt))
+	})
+
+	t.Run("сообщение не может быть слишком длинным", func(t *testing.T) {
+		note := strings.Repeat("я", InvitationNoteMaxLen+1)
+		inv, err := NewInvitation(uuid.New(), uuid.New(), note, time.Time{})
+		assert.Zero(t, inv)
+		assert.ErrorIs(t, err, ErrInvalidInvitationNote)
+	})
+
+	t.Run("срок действия не может заканчиваться в прошлом", func(t *testing.T) {
+		inv, err := NewInvitation(uuid.New(), uuid.New(), "", time.Now().Add(-time.Minute))
+		assert.Zero(t, inv)
+		assert.ErrorIs(t, err, ErrInvalidInvitationExpiresAt)
 	})
 }
 
@@ -53,7 +80,7 @@ func TestChat_AddInvitation(t *testing.T) {
 		require.NoError(t, err)
 
 		// Создать и добавить первое приглашение
-		inv, err := NewInvitation(uuid.New(), uuid.New())
+		inv, err := NewInvitation(uuid.New(), uuid.New(), "", time.Time{})
 		require.NoError(t, err)
 		err = chat.AddInvitation(inv, nil)
 		assert.ErrorIs(t, err, ErrSubjectIsNotMember)
@@ -72,7 +99,7 @@ func TestChat_AddInvitation(t *testing.T) {
 		require.NoError(t, err)
 
 		// Добавить приглашение пользователю, который уже участник
-		inv, err := NewInvitation(chief, p.UserID)
+		inv, err := NewInvitation(chief, p.UserID, "", time.Time{})
 		require.NoError(t, err)
 		err = chat.AddInvitation(inv, nil)
 		assert.ErrorIs(t, err, ErrParticipantExists)
@@ -86,18 +113,43 @@ func TestChat_AddInvitation(t *testing.T) {
 		require.NoError(t, err)
 
 		// Создать и добавить первое приглашение
-		inv1, err := NewInvitation(chiefID, recipientID)
+		inv1, err := NewInvitation(chiefID, recipientID, "", time.Time{})
 		require.NoError(t, err)
 		err = chat.AddInvitation(inv1, nil)
 		require.NoError(t, err)
 
 		//  Создать и добавить второе приглашение
-		inv2, err := NewInvitation(chiefID, recipientID)
+		inv2, err := NewInvitation(chiefID, recipientID, "", time.Time{})
 		require.NoError(t, err)
 		err = chat.AddInvitation(inv2, nil)
 		assert.ErrorIs(t, err, ErrUserIsAlreadyInvited)
 	})
 
+	t.Run("истекшее приглашение заменяется новым", func(t *testing.T) {
+		// Создать чат
+		chiefID := uuid.New()
+		recipientID := uuid.New()
+		chat, err := NewChat("chatName", chiefID, nil)
+		require.NoError(t, err)
+
+		// Добавить истекшее приглашение
+		inv1, err := NewInvitation(chiefID, recipientID, "", time.Time{})
+		require.NoError(t, err)
+		inv1.ExpiresAt = time.Now().Add(-time.Minute)
+		chat.Invitations = append(chat.Invitations, inv1)
+
+		// Добавить новое приглашение тому же пользователю
+		eventsBuf := new(events.Buffer)
+		inv2, err := NewInvitation(chiefID, recipientID, "", time.Time{})
+		require.NoError(t, err)
+		err = chat.AddInvitation(inv2, eventsBuf)
+		require.NoError(t, err)
+		assert.Equal(t, []Invitation{inv2}, chat.Invitations)
+		require.Len(t, eventsBuf.Events(), 2)
+		assert.Equal(t, EventInvitationRemoved, eventsBuf.Events()[0].Type)
+		assert.Equal(t, EventInvitationAdded, eventsBuf.Events()[1].Type)
+	})
+
 	t.Run("успешное добавление приглашения", func(t *testing.T) {
 		// Создать чат
 		chiefID := uuid.New()
@@ -105,7 +157,7 @@ func TestChat_AddInvitation(t *testing.T) {
 		require.NoError(t, err)
 
 		// Создать и добавить приглашение
-		inv, err := NewInvitation(chiefID, uuid.New())
+		inv, err := NewInvitation(chiefID, uuid.New(), "", time.Time{})
 		require.NoError(t, err)
 		err = chat.AddInvitation(inv, nil)
 		assert.NoError(t, err)
@@ -118,7 +170,7 @@ func TestChat_AddInvitation(t *testing.T) {
 		chat, _ := NewChat("chatName", chiefID, nil)
 
 		// Создать приглашение
-		inv, _ := NewInvitation(chiefID, uuid.New())
+		inv, _ := NewInvitation(chiefID, uuid.New(), "", time.Time{})
 
 		// Инициализировать буфер событий
 		eventsBuf := new(events.Buffer)
@@ -159,7 +211,7 @@ func TestChat_RemoveInvitation(t *testing.T) {
 		chat, _ := NewChat("chatName", chiefID, nil)
 
 		// Создать и добавить приглашение
-		inv, _ := NewInvitation(chiefID, uuid.New())
+		inv, _ := NewInvitation(chiefID, uuid.New(), "", time.Time{})
 		_ = chat.AddInvitation(inv, nil)
 
 		// Удалить приглашение
@@ -174,7 +226,7 @@ func TestChat_RemoveInvitation(t *testing.T) {
 		chat, _ := NewChat("chatName", chiefID, nil)
 
 		// Создать и добавить приглашение
-		inv, _ := NewInvitation(chiefID, uuid.New())
+		inv, _ := NewInvitation(chiefID, uuid.New(), "", time.Time{})
 		_ = chat.AddInvitation(inv, nil)
 
 		// Инициализировать буфер событий
@@ -207,7 +259,7 @@ func TestChat_InvitationMethods(t *testing.T) {
 		chat, _ := NewChat("chatName", chiefID, nil)
 
 		// Создать и добавить приглашение
-		inv, _ := NewInvitation(chiefID, uuid.New())
+		inv, _ := NewInvitation(chiefID, uuid.New(), "", time.Time{})
 		_ = chat.AddInvitation(inv, nil)
 
 		// Проверка наличия приглашения по ID
@@ -221,7 +273,7 @@ func TestChat_InvitationMethods(t *testing.T) {
 		chat, _ := NewChat("chatName", chiefID, nil)
 
 		// Создать и добавить приглашение
-		inv, _ := NewInvitation(chiefID, uuid.New())
+		inv, _ := NewInvitation(chiefID, uuid.New(), "", time.Time{})
 		_ = chat.AddInvitation(inv, nil)
 
 		// Получение приглашения по ID
@@ -237,7 +289,7 @@ func TestChat_InvitationMethods(t *testing.T) {
 		chat, _ := NewChat("chatName", chiefID, nil)
 
 		// Создать и добавить приглашение
-		inv, _ := NewInvitation(chiefID, recipientID)
+		inv, _ := NewInvitation(chiefID, recipientID, "", time.Time{})
 		_ = chat.AddInvitation(inv, nil)
 
 		// Проверка наличия приглашения по получателю
@@ -245,3 +297,71 @@ func TestChat_InvitationMethods(t *testing.T) {
 		assert.False(t, chat.HasInvitationWithRecipient(uuid.New()))
 	})
 }
+
+// TestChat_DeclineInvitation тестирует отклонение приглашения.
+func TestChat_DeclineInvitation(t *testing.T) {
+	t.Run("приглашение должно существовать", func(t *testing.T) {
+		chat, err := NewChat("chatName", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.DeclineInvitation(uuid.New(), uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrInvitationNotExists)
+	})
+
+	t.Run("отклонить приглашение может только приглашенный", func(t *testing.T) {
+		chiefID := uuid.New()
+		chat, err := NewChat("chatName", chiefID, nil)
+		require.NoError(t, err)
+		inv, err := NewInvitation(chiefID, uuid.New(), "", time.Time{})
+		require.NoError(t, err)
+		require.NoError(t, chat.AddInvitation(inv, nil))
+
+		err = chat.DeclineInvitation(inv.ID, chiefID, nil)
+		assert.ErrorIs(t, err, ErrSubjectIsNotRecipient)
+		assert.Contains(t, chat.Invitations, inv)
+	})
+
+	t.Run("отклоненное приглашение удаляется и создается событие", func(t *testing.T) {
+		chiefID := uuid.New()
+		chat, err := NewChat("chatName", chiefID, nil)
+		require.NoError(t, err)
+		inv, err := NewInvitation(chiefID, uuid.New(), "", time.Time{})
+		require.NoError(t, err)
+		require.NoError(t, chat.AddInvitation(inv, nil))
+
+		eventsBuf := new(events.Buffer)
+		err = chat.DeclineInvitation(inv.ID, inv.RecipientID, eventsBuf)
+		require.NoError(t, err)
+		assert.Empty(t, chat.Invitations)
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventInvitationDeclined, event.Type)
+		assert.Contains(t, event.Recipients, chiefID)
+		assert.Contains(t, event.Recipients, inv.RecipientID)
+	})
+}
+
+// TestChat_RemoveExpiredInvitations тестирует удаление истекших приглашений.
+func TestChat_RemoveExpiredInvitations(t *testing.T) {
+	chiefID := uuid.New()
+	chat, err := NewChat("chatName", chiefID, nil)
+	require.NoError(t, err)
+
+	// Добавить бессрочное, действующее и истекшее приглашения
+	now := time.Now()
+	permanent, err := NewInvitation(chiefID, uuid.New(), "", time.Time{})
+	require.NoError(t, err)
+	active, err := NewInvitation(chiefID, uuid.New(), "", now.Add(time.Hour))
+	require.NoError(t, err)
+	expired, err := NewInvitation(chiefID, uuid.New(), "", now.Add(time.Hour))
+	require.NoError(t, err)
+	expired.ExpiresAt = now.Add(-time.Minute)
+	chat.Invitations = []Invitation{permanent, active, expired}
+
+	eventsBuf := new(events.Buffer)
+	removed := chat.RemoveExpiredInvitations(now, eventsBuf)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, []Invitation{permanent, active}, chat.Invitations)
+	require.Len(t, eventsBuf.Events(), 1)
+	assert.Equal(t, EventInvitationRemoved, eventsBuf.Events()[0].Type)
+	assert.Equal(t, expired, eventsBuf.Events()[0].Data["invitation"])
+}
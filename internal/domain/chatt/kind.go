@@ -0,0 +1,71 @@
+package chatt
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// Kind представляет собой вид чата.
+type Kind string
+
+const (
+	KindGroup  Kind = "group"  // Групповой чат с названием, приглашениями и ролями
+	KindDirect Kind = "direct" // Личная переписка двух пользователей
+)
+
+// directPermissions определяет набор прав участников личного чата.
+// В личном чате права одинаковы для обоих участников и не зависят от роли
+var directPermissions = []Permission{
+	PermissionSendMessages, PermissionPin,
+}
+
+// ValidateKind проверяет корректность вида чата.
+func ValidateKind(kind Kind) error {
+	if kind != KindGroup && kind != KindDirect {
+		return ErrInvalidKind
+	}
+
+	return nil
+}
+
+// NewDirectChat создает личный чат двух пользователей.
+// Личный чат не требует приглашения, у него нет названия, и состав его участников не меняется
+func NewDirectChat(subjectID, userID uuid.UUID, eventsBuf *events.Buffer) (Chat, error) {
+	if err := domain.ValidateID(subjectID); err != nil {
+		return Chat{}, errors.Join(err, ErrInvalidChiefID)
+	}
+	if err := domain.ValidateID(userID); err != nil {
+		return Chat{}, errors.Join(err, ErrInvalidUserID)
+	}
+	if subjectID == userID {
+		return Chat{}, ErrDirectChatWithSelf
+	}
+
+	chat := Chat{
+		ID:           uuid.New(),
+		Kind:         KindDirect,
+		ChiefID:      subjectID,
+		LastActiveAt: time.Now().UTC().Truncate(time.Microsecond),
+		Participants: []Participant{
+			{UserID: subjectID, Role: RoleOwner},
+			{UserID: userID, Role: RoleMember},
+		},
+		Invitations: []Invitation{},
+		Pins:        []Pin{},
+	}
+
+	// Добавить событие
+	eventsBuf.AddSafety(chat.NewEventChatCreated())
+
+	return chat, nil
+}
+
+// IsDirect проверяет, является ли чат личной перепиской.
+func (c *Chat) IsDirect() bool {
+	return c.Kind == KindDirect
+}
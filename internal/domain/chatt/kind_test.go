@@ -0,0 +1,84 @@
+package chatt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestNewDirectChat тестирует создание личного чата.
+func TestNewDirectChat(t *testing.T) {
+	t.Run("параметры должны быть валидными UUID", func(t *testing.T) {
+		_, err := NewDirectChat(uuid.Nil, uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrInvalidChiefID)
+		_, err = NewDirectChat(uuid.New(), uuid.Nil, nil)
+		assert.ErrorIs(t, err, ErrInvalidUserID)
+	})
+
+	t.Run("нельзя создать личный чат с самим собой", func(t *testing.T) {
+		userID := uuid.New()
+		_, err := NewDirectChat(userID, userID, nil)
+		assert.ErrorIs(t, err, ErrDirectChatWithSelf)
+	})
+
+	t.Run("оба пользователя сразу становятся участниками и получают событие", func(t *testing.T) {
+		subjectID, userID := uuid.New(), uuid.New()
+		eventsBuf := new(events.Buffer)
+		chat, err := NewDirectChat(subjectID, userID, eventsBuf)
+		require.NoError(t, err)
+		assert.True(t, chat.IsDirect())
+		assert.Empty(t, chat.Name)
+		assert.ElementsMatch(t, []uuid.UUID{subjectID, userID}, chat.ParticipantIDs())
+
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventChatCreated, event.Type)
+		assert.ElementsMatch(t, []uuid.UUID{subjectID, userID}, event.Recipients)
+	})
+}
+
+// TestChat_Direct тестирует ограничения личного чата.
+func TestChat_Direct(t *testing.T) {
+	newDirectChat := func(t *testing.T) (Chat, uuid.UUID) {
+		userID := uuid.New()
+		chat, err := NewDirectChat(uuid.New(), userID, nil)
+		require.NoError(t, err)
+		return chat, userID
+	}
+
+	t.Run("права участников одинаковы и не зависят от роли", func(t *testing.T) {
+		chat, userID := newDirectChat(t)
+		for _, subjectID := range []uuid.UUID{chat.ChiefID, userID} {
+			assert.True(t, chat.Can(subjectID, PermissionSendMessages))
+			assert.True(t, chat.Can(subjectID, PermissionPin))
+			assert.False(t, chat.Can(subjectID, PermissionRename))
+			assert.False(t, chat.Can(subjectID, PermissionInvite))
+			assert.False(t, chat.Can(subjectID, PermissionManageRoles))
+		}
+	})
+
+	t.Run("личный чат нельзя переименовать", func(t *testing.T) {
+		chat, _ := newDirectChat(t)
+		assert.ErrorIs(t, chat.UpdateName("new name", nil), ErrChatIsDirect)
+	})
+
+	t.Run("в личный чат нельзя добавить участника или пригласить", func(t *testing.T) {
+		chat, userID := newDirectChat(t)
+		err := chat.AddParticipant(Participant{UserID: uuid.New(), Role: RoleMember}, nil)
+		assert.ErrorIs(t, err, ErrChatIsDirect)
+		invitation, err := NewInvitation(userID, uuid.New(), "", time.Time{})
+		require.NoError(t, err)
+		assert.ErrorIs(t, chat.AddInvitation(invitation, nil), ErrChatIsDirect)
+	})
+
+	t.Run("из личного чата нельзя удалить участника или передать владение", func(t *testing.T) {
+		chat, userID := newDirectChat(t)
+		assert.ErrorIs(t, chat.RemoveParticipant(userID, nil), ErrChatIsDirect)
+		assert.ErrorIs(t, chat.TransferChief(chat.ChiefID, userID, nil), ErrChatIsDirect)
+	})
+}
@@ -5,6 +5,9 @@
 package mockChatt
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/nice-pea/npchat/internal/domain/chatt"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -36,6 +39,57 @@ func (_m *Repository) EXPECT() *Repository_Expecter {
 	return &Repository_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function for the type Repository
+func (_mock *Repository) Delete(chatID uuid.UUID) error {
+	ret := _mock.Called(chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = returnFunc(chatID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Repository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - chatID uuid.UUID
+func (_e *Repository_Expecter) Delete(chatID interface{}) *Repository_Delete_Call {
+	return &Repository_Delete_Call{Call: _e.mock.On("Delete", chatID)}
+}
+
+func (_c *Repository_Delete_Call) Run(run func(chatID uuid.UUID)) *Repository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uuid.UUID
+		if args[0] != nil {
+			arg0 = args[0].(uuid.UUID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_Delete_Call) Return(err error) *Repository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_Delete_Call) RunAndReturn(run func(chatID uuid.UUID) error) *Repository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InTransaction provides a mock function for the type Repository
 func (_mock *Repository) InTransaction(fn func(txRepo chatt.Repository) error) error {
 	ret := _mock.Called(fn)
@@ -149,6 +203,63 @@ func (_c *Repository_List_Call) RunAndReturn(run func(filter chatt.Filter) ([]ch
 	return _c
 }
 
+// UpdateLastActiveAt provides a mock function for the type Repository
+func (_mock *Repository) UpdateLastActiveAt(chatID uuid.UUID, lastActiveAt time.Time) error {
+	ret := _mock.Called(chatID, lastActiveAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastActiveAt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(chatID, lastActiveAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_UpdateLastActiveAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastActiveAt'
+type Repository_UpdateLastActiveAt_Call struct {
+	*mock.Call
+}
+
+// UpdateLastActiveAt is a helper method to define mock.On call
+//   - chatID uuid.UUID
+//   - lastActiveAt time.Time
+func (_e *Repository_Expecter) UpdateLastActiveAt(chatID interface{}, lastActiveAt interface{}) *Repository_UpdateLastActiveAt_Call {
+	return &Repository_UpdateLastActiveAt_Call{Call: _e.mock.On("UpdateLastActiveAt", chatID, lastActiveAt)}
+}
+
+func (_c *Repository_UpdateLastActiveAt_Call) Run(run func(chatID uuid.UUID, lastActiveAt time.Time)) *Repository_UpdateLastActiveAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uuid.UUID
+		if args[0] != nil {
+			arg0 = args[0].(uuid.UUID)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateLastActiveAt_Call) Return(err error) *Repository_UpdateLastActiveAt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_UpdateLastActiveAt_Call) RunAndReturn(run func(chatID uuid.UUID, lastActiveAt time.Time) error) *Repository_UpdateLastActiveAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Upsert provides a mock function for the type Repository
 func (_mock *Repository) Upsert(chat chatt.Chat) error {
 	ret := _mock.Called(chat)
@@ -2,6 +2,7 @@ package chatt
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/exp/slices"
@@ -13,6 +14,10 @@ import (
 // Participant представляет собой участника чата.
 type Participant struct {
 	UserID uuid.UUID // ID пользователя, который является участником чата
+	Role   Role      // Роль участника в чате
+
+	LastReadMessageID uuid.UUID // ID последнего прочитанного сообщения
+	LastReadAt        time.Time // Время создания последнего прочитанного сообщения
 }
 
 // NewParticipant создает новый участник чата.
@@ -23,6 +28,7 @@ func NewParticipant(userID uuid.UUID) (Participant, error) {
 
 	return Participant{
 		UserID: userID,
+		Role:   RoleMember,
 	}, nil
 }
 
@@ -37,8 +43,18 @@ func (c *Chat) HasParticipant(userID uuid.UUID) bool {
 	return false
 }
 
+// ParticipantIDs возвращает список ID пользователей, участвующих в чате.
+func (c *Chat) ParticipantIDs() []uuid.UUID {
+	return userIDs(c.Participants)
+}
+
 // RemoveParticipant удаляет участника из чата.
 func (c *Chat) RemoveParticipant(userID uuid.UUID, eventsBuf *events.Buffer) error {
+	// Состав участников личного чата не меняется
+	if c.IsDirect() {
+		return ErrChatIsDirect
+	}
+
 	// Убедиться, что участник не является главным администратором
 	if userID == c.ChiefID {
 		return ErrCannotRemoveChief
@@ -70,6 +86,16 @@ func (c *Chat) RemoveParticipant(userID uuid.UUID, eventsBuf *events.Buffer) err
 
 // AddParticipant добавляет участника в чат.
 func (c *Chat) AddParticipant(p Participant, eventsBuf *events.Buffer) error {
+	// В архивный чат нельзя добавлять участников
+	if c.IsArchived() {
+		return ErrChatIsArchived
+	}
+
+	// Состав участников личного чата не меняется
+	if c.IsDirect() {
+		return ErrChatIsDirect
+	}
+
 	// Проверить является ли subject участником чата
 	if c.HasParticipant(p.UserID) {
 		return ErrParticipantExists
@@ -88,3 +114,45 @@ func (c *Chat) AddParticipant(p Participant, eventsBuf *events.Buffer) error {
 
 	return nil
 }
+
+// Participant возвращает участника чата по ID пользователя.
+func (c *Chat) Participant(userID uuid.UUID) (Participant, error) {
+	i := slices.IndexFunc(c.Participants, func(p Participant) bool {
+		return p.UserID == userID
+	})
+	if i == -1 {
+		return Participant{}, ErrParticipantNotExists
+	}
+
+	return c.Participants[i], nil
+}
+
+// MarkRead перемещает отметку прочтения участника на сообщение messageID,
+// созданное в момент messageCreatedAt. Отметка может двигаться только вперед.
+func (c *Chat) MarkRead(userID, messageID uuid.UUID, messageCreatedAt time.Time, eventsBuf *events.Buffer) error {
+	if err := domain.ValidateID(messageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	// Найти индекс участника
+	i := slices.IndexFunc(c.Participants, func(p Participant) bool {
+		return p.UserID == userID
+	})
+	if i == -1 {
+		return ErrParticipantNotExists
+	}
+
+	// Убедиться, что отметка не сдвигается назад
+	readAt := messageCreatedAt.In(time.UTC).Truncate(time.Microsecond)
+	if readAt.Before(c.Participants[i].LastReadAt) {
+		return ErrReadMarkerMovesBackward
+	}
+
+	c.Participants[i].LastReadMessageID = messageID
+	c.Participants[i].LastReadAt = readAt
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventReadMarkerUpdated(c.Participants[i]))
+
+	return nil
+}
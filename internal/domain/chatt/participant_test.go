@@ -2,6 +2,7 @@ package chatt
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -73,7 +74,7 @@ func TestChat_AddParticipant(t *testing.T) {
 		userID := uuid.New()
 
 		// Создаем и добавляем приглашение
-		inv, err := NewInvitation(chat.ChiefID, userID)
+		inv, err := NewInvitation(chat.ChiefID, userID, "", time.Time{})
 		require.NoError(t, err)
 		err = chat.AddInvitation(inv, nil)
 		require.NoError(t, err)
@@ -190,3 +191,65 @@ func TestChat_RemoveParticipant(t *testing.T) {
 		assert.Equal(t, participant, event.Data["participant"].(Participant))
 	})
 }
+
+// TestChat_MarkRead тестирует перемещение отметки прочтения.
+func TestChat_MarkRead(t *testing.T) {
+	t.Run("параметр messageID должен быть валидным UUID", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.MarkRead(chat.ChiefID, uuid.Nil, time.Now(), nil)
+		assert.ErrorIs(t, err, ErrInvalidMessageID)
+	})
+
+	t.Run("отметку может ставить только участник", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.MarkRead(uuid.New(), uuid.New(), time.Now(), nil)
+		assert.ErrorIs(t, err, ErrParticipantNotExists)
+	})
+
+	t.Run("отметка сохраняется у участника", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		messageID := uuid.New()
+		readAt := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, chat.MarkRead(chat.ChiefID, messageID, readAt, nil))
+
+		p, err := chat.Participant(chat.ChiefID)
+		require.NoError(t, err)
+		assert.Equal(t, messageID, p.LastReadMessageID)
+		assert.Equal(t, readAt, p.LastReadAt)
+	})
+
+	t.Run("отметка не может сдвигаться назад", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		now := time.Now()
+		require.NoError(t, chat.MarkRead(chat.ChiefID, uuid.New(), now, nil))
+		err = chat.MarkRead(chat.ChiefID, uuid.New(), now.Add(-time.Minute), nil)
+		assert.ErrorIs(t, err, ErrReadMarkerMovesBackward)
+	})
+
+	t.Run("после завершения операции, будут созданы события", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		participant, err := NewParticipant(uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, chat.AddParticipant(participant, nil))
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, chat.MarkRead(participant.UserID, uuid.New(), time.Now(), eventsBuf))
+
+		// Событие перемещения отметки прочтения
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventReadMarkerUpdated, event.Type)
+		// Получат все участники чата, включая другие сессии самого пользователя
+		assert.ElementsMatch(t, chat.ParticipantIDs(), event.Recipients)
+		p := event.Data["participant"].(Participant)
+		assert.Equal(t, participant.UserID, p.UserID)
+		assert.NotZero(t, p.LastReadMessageID)
+	})
+}
@@ -0,0 +1,88 @@
+package chatt
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// DefaultMaxPins количество закрепленных сообщений в чате по умолчанию
+const DefaultMaxPins = 50
+
+// Pin представляет собой закрепленное в чате сообщение.
+type Pin struct {
+	MessageID uuid.UUID // ID закрепленного сообщения
+	PinnedBy  uuid.UUID // ID пользователя, закрепившего сообщение
+	PinnedAt  time.Time // Время закрепления
+}
+
+// HasPin проверяет, закреплено ли сообщение в чате.
+func (c *Chat) HasPin(messageID uuid.UUID) bool {
+	return slices.ContainsFunc(c.Pins, func(p Pin) bool {
+		return p.MessageID == messageID
+	})
+}
+
+// PinMessage закрепляет сообщение в чате.
+// Количество закрепленных сообщений не может превышать maxPins
+func (c *Chat) PinMessage(messageID, pinnedBy uuid.UUID, maxPins int, eventsBuf *events.Buffer) error {
+	if err := domain.ValidateID(messageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+	if err := domain.ValidateID(pinnedBy); err != nil {
+		return errors.Join(err, ErrInvalidUserID)
+	}
+
+	// Закреплять сообщения могут только участники чата
+	if !c.HasParticipant(pinnedBy) {
+		return ErrSubjectIsNotMember
+	}
+
+	// Убедиться, что сообщение еще не закреплено
+	if c.HasPin(messageID) {
+		return ErrPinExists
+	}
+
+	// Проверить лимит закрепленных сообщений
+	if len(c.Pins) >= maxPins {
+		return ErrTooManyPins
+	}
+
+	pin := Pin{
+		MessageID: messageID,
+		PinnedBy:  pinnedBy,
+		PinnedAt:  time.Now().UTC().Truncate(time.Microsecond),
+	}
+	c.Pins = append(c.Pins, pin)
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventMessagePinned(pin))
+
+	return nil
+}
+
+// UnpinMessage открепляет сообщение в чате.
+func (c *Chat) UnpinMessage(messageID uuid.UUID, eventsBuf *events.Buffer) error {
+	// Найти индекс закрепления
+	i := slices.IndexFunc(c.Pins, func(p Pin) bool {
+		return p.MessageID == messageID
+	})
+	if i == -1 {
+		return ErrPinNotExists
+	}
+
+	removedPin := c.Pins[i]
+
+	// Удалить закрепление
+	c.Pins = slices.Delete(c.Pins, i, i+1)
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventMessageUnpinned(removedPin))
+
+	return nil
+}
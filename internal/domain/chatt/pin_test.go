@@ -0,0 +1,90 @@
+package chatt
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestChat_PinMessage тестирует закрепление сообщения в чате.
+func TestChat_PinMessage(t *testing.T) {
+	t.Run("закреплять сообщения могут только участники", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.PinMessage(uuid.New(), uuid.New(), DefaultMaxPins, nil)
+		assert.ErrorIs(t, err, ErrSubjectIsNotMember)
+		assert.Empty(t, chat.Pins)
+	})
+
+	t.Run("сообщение нельзя закрепить дважды", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		messageID := uuid.New()
+		require.NoError(t, chat.PinMessage(messageID, chat.ChiefID, DefaultMaxPins, nil))
+		err = chat.PinMessage(messageID, chat.ChiefID, DefaultMaxPins, nil)
+		assert.ErrorIs(t, err, ErrPinExists)
+		assert.Len(t, chat.Pins, 1)
+	})
+
+	t.Run("количество закрепленных сообщений ограничено", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		const maxPins = 3
+		for range maxPins {
+			require.NoError(t, chat.PinMessage(uuid.New(), chat.ChiefID, maxPins, nil))
+		}
+		err = chat.PinMessage(uuid.New(), chat.ChiefID, maxPins, nil)
+		assert.ErrorIs(t, err, ErrTooManyPins)
+		assert.Len(t, chat.Pins, maxPins)
+	})
+
+	t.Run("закрепление сохраняется в чате и создает событие", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		messageID := uuid.New()
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, chat.PinMessage(messageID, chat.ChiefID, DefaultMaxPins, eventsBuf))
+		require.Len(t, chat.Pins, 1)
+		assert.True(t, chat.HasPin(messageID))
+		assert.Equal(t, chat.ChiefID, chat.Pins[0].PinnedBy)
+		assert.NotZero(t, chat.Pins[0].PinnedAt)
+
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventMessagePinned, eventsBuf.Events()[0].Type)
+		assert.Equal(t, chat.Pins[0], eventsBuf.Events()[0].Data["pin"])
+	})
+}
+
+// TestChat_UnpinMessage тестирует открепление сообщения в чате.
+func TestChat_UnpinMessage(t *testing.T) {
+	t.Run("открепить можно только закрепленное сообщение", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.UnpinMessage(uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrPinNotExists)
+	})
+
+	t.Run("закрепление удаляется из чата и создает событие", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		messageID := uuid.New()
+		require.NoError(t, chat.PinMessage(messageID, chat.ChiefID, DefaultMaxPins, nil))
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, chat.UnpinMessage(messageID, eventsBuf))
+		assert.False(t, chat.HasPin(messageID))
+		assert.Empty(t, chat.Pins)
+
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventMessageUnpinned, eventsBuf.Events()[0].Type)
+	})
+}
@@ -8,19 +8,31 @@ import (
 
 // Repository представляет собой интерфейс для работы с репозиторием чатов.
 type Repository interface {
+	// List возвращает чаты по фильтру.
+	// Внутри InTransaction выбранные чаты блокируются до ее завершения
 	List(Filter) ([]Chat, error)
 	Upsert(Chat) error
+	// UpdateLastActiveAt сохраняет только время последней активности чата, не трогая его состав.
+	// Время не уменьшается, если в репозитории уже сохранено более позднее
+	UpdateLastActiveAt(chatID uuid.UUID, lastActiveAt time.Time) error
+	Delete(chatID uuid.UUID) error
 	InTransaction(func(txRepo Repository) error) error
 }
 
 // Filter представляет собой фильтр для выборки чатов.
 type Filter struct {
-	ID                    uuid.UUID // Фильтрация по ID чата
-	InvitationID          uuid.UUID // Фильтрация по ID приглашений в чате
-	InvitationRecipientID uuid.UUID // Фильтрация по ID получателей приглашения в чат
-	ParticipantID         uuid.UUID // Фильтрация по ID участников в чате
-	ActiveBefore          time.Time // Брать записи где LastActiveAt меньше чем ActiveBefore
-	Limit                 int       // Ограничить количество элементов
+	ID                      uuid.UUID    // Фильтрация по ID чата
+	InvitationID            uuid.UUID    // Фильтрация по ID приглашений в чате
+	InvitationRecipientID   uuid.UUID    // Фильтрация по ID получателей приглашения в чат
+	InvitationExpiresBefore time.Time    // Брать чаты, в которых есть приглашения, истекшие к моменту InvitationExpiresBefore
+	ParticipantID           uuid.UUID    // Фильтрация по ID участников в чате
+	Kind                    Kind         // Фильтрация по виду чата
+	DirectPair              [2]uuid.UUID // Фильтрация личного чата по паре собеседников
+	ActiveBefore            time.Time    // Брать записи где LastActiveAt меньше чем ActiveBefore
+	WithRetentionMaxAge     bool         // Брать только чаты с ограниченным сроком хранения сообщений
+	ArchivedOnly            bool         // Брать только архивные чаты
+	ExcludeArchived         bool         // Не брать архивные чаты
+	Limit                   int          // Ограничить количество элементов
 }
 
 // Find возвращает чат либо ошибку ErrChatNotExists
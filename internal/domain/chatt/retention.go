@@ -0,0 +1,60 @@
+package chatt
+
+import (
+	"time"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+const (
+	// MaxRetentionAge максимальный срок хранения сообщений
+	MaxRetentionAge = 10 * 365 * 24 * time.Hour
+	// MinSelfDestruct минимальное время жизни самоуничтожающегося сообщения
+	MinSelfDestruct = 5 * time.Second
+	// MaxSelfDestruct максимальное время жизни самоуничтожающегося сообщения
+	MaxSelfDestruct = 7 * 24 * time.Hour
+)
+
+// Retention представляет собой политику хранения сообщений чата.
+// Нулевое значение означает бессрочное хранение
+type Retention struct {
+	MaxAge       time.Duration // Срок хранения сообщений, кратный суткам. 0 - хранить бессрочно
+	SelfDestruct time.Duration // Время жизни каждого нового сообщения. 0 - без самоуничтожения
+}
+
+// ValidateRetention проверяет политику хранения сообщений.
+func ValidateRetention(r Retention) error {
+	if r.MaxAge < 0 || r.MaxAge > MaxRetentionAge || r.MaxAge%(24*time.Hour) != 0 {
+		return ErrInvalidRetentionMaxAge
+	}
+	if r.SelfDestruct != 0 && (r.SelfDestruct < MinSelfDestruct || r.SelfDestruct > MaxSelfDestruct) {
+		return ErrInvalidSelfDestruct
+	}
+
+	return nil
+}
+
+// RetentionCutoff возвращает время, раньше которого созданные сообщения должны быть удалены.
+// Если срок хранения не ограничен, возвращается нулевое время
+func (c *Chat) RetentionCutoff(now time.Time) time.Time {
+	if c.Retention.MaxAge == 0 {
+		return time.Time{}
+	}
+
+	return now.Add(-c.Retention.MaxAge)
+}
+
+// SetRetention устанавливает политику хранения сообщений чата.
+// Политика самоуничтожения применяется только к новым сообщениям
+func (c *Chat) SetRetention(retention Retention, eventsBuf *events.Buffer) error {
+	if err := ValidateRetention(retention); err != nil {
+		return err
+	}
+
+	c.Retention = retention
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventChatUpdated())
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package chatt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestChat_SetRetention тестирует изменение политики хранения сообщений.
+func TestChat_SetRetention(t *testing.T) {
+	t.Run("срок хранения должен быть кратен суткам", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.SetRetention(Retention{MaxAge: 36 * time.Hour}, nil)
+		assert.ErrorIs(t, err, ErrInvalidRetentionMaxAge)
+		assert.Zero(t, chat.Retention)
+	})
+
+	t.Run("срок хранения ограничен", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.SetRetention(Retention{MaxAge: MaxRetentionAge + 24*time.Hour}, nil)
+		assert.ErrorIs(t, err, ErrInvalidRetentionMaxAge)
+		err = chat.SetRetention(Retention{MaxAge: -24 * time.Hour}, nil)
+		assert.ErrorIs(t, err, ErrInvalidRetentionMaxAge)
+	})
+
+	t.Run("время самоуничтожения ограничено", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.SetRetention(Retention{SelfDestruct: MinSelfDestruct - time.Second}, nil)
+		assert.ErrorIs(t, err, ErrInvalidSelfDestruct)
+		err = chat.SetRetention(Retention{SelfDestruct: MaxSelfDestruct + time.Second}, nil)
+		assert.ErrorIs(t, err, ErrInvalidSelfDestruct)
+	})
+
+	t.Run("политика будет установлена и будет создано событие", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		retention := Retention{
+			MaxAge:       30 * 24 * time.Hour,
+			SelfDestruct: time.Minute,
+		}
+		eventsBuf := new(events.Buffer)
+		err = chat.SetRetention(retention, eventsBuf)
+		require.NoError(t, err)
+		assert.Equal(t, retention, chat.Retention)
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventChatUpdated, eventsBuf.Events()[0].Type)
+	})
+
+	t.Run("нулевая политика отключает ограничения", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		require.NoError(t, chat.SetRetention(Retention{MaxAge: 24 * time.Hour}, nil))
+		err = chat.SetRetention(Retention{}, nil)
+		require.NoError(t, err)
+		assert.Zero(t, chat.RetentionCutoff(time.Now()))
+	})
+}
+
+// TestChat_RetentionCutoff тестирует вычисление границы хранения сообщений.
+func TestChat_RetentionCutoff(t *testing.T) {
+	chat, err := NewChat("test chat", uuid.New(), nil)
+	require.NoError(t, err)
+	require.NoError(t, chat.SetRetention(Retention{MaxAge: 24 * time.Hour}, nil))
+	now := time.Now()
+	assert.Equal(t, now.Add(-24*time.Hour), chat.RetentionCutoff(now))
+}
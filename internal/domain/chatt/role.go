@@ -0,0 +1,224 @@
+package chatt
+
+import (
+	"golang.org/x/exp/slices"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// Role представляет собой роль участника в чате.
+type Role string
+
+const (
+	RoleOwner     Role = "owner"     // Владелец чата, главный администратор
+	RoleAdmin     Role = "admin"     // Администратор
+	RoleModerator Role = "moderator" // Модератор
+	RoleMember    Role = "member"    // Обычный участник
+	RoleReadOnly  Role = "read_only" // Участник только для чтения
+)
+
+// Permission представляет собой право участника на действие в чате.
+type Permission string
+
+const (
+	PermissionSendMessages      Permission = "send_messages"      // Отправлять сообщения
+	PermissionInvite            Permission = "invite"             // Приглашать пользователей
+	PermissionManageInvitations Permission = "manage_invitations" // Просматривать и отменять чужие приглашения
+	PermissionRemoveMember      Permission = "remove_member"      // Удалять участников с ролью ниже своей
+	PermissionRename            Permission = "rename"             // Изменять название чата
+	PermissionPin               Permission = "pin"                // Закреплять и откреплять сообщения
+	PermissionDeleteMessages    Permission = "delete_messages"    // Удалять чужие сообщения
+	PermissionManageRoles       Permission = "manage_roles"       // Изменять роли участников с ролью ниже своей
+	PermissionManageChat        Permission = "manage_chat"        // Изменять настройки и интеграции чата
+)
+
+// rolePermissions определяет набор прав каждой роли
+var rolePermissions = map[Role][]Permission{
+	RoleOwner: {
+		PermissionSendMessages, PermissionInvite, PermissionManageInvitations, PermissionRemoveMember,
+		PermissionRename, PermissionPin, PermissionDeleteMessages, PermissionManageRoles, PermissionManageChat,
+	},
+	RoleAdmin: {
+		PermissionSendMessages, PermissionInvite, PermissionManageInvitations, PermissionRemoveMember,
+		PermissionRename, PermissionPin, PermissionDeleteMessages, PermissionManageRoles, PermissionManageChat,
+	},
+	RoleModerator: {
+		PermissionSendMessages, PermissionInvite, PermissionManageInvitations, PermissionRemoveMember,
+		PermissionPin, PermissionDeleteMessages,
+	},
+	RoleMember: {
+		PermissionSendMessages, PermissionInvite,
+	},
+	RoleReadOnly: {},
+}
+
+// roleRanks определяет старшинство ролей, чем больше значение, тем старше роль
+var roleRanks = map[Role]int{
+	RoleReadOnly:  1,
+	RoleMember:    2,
+	RoleModerator: 3,
+	RoleAdmin:     4,
+	RoleOwner:     5,
+}
+
+// ValidateRole проверяет корректность роли участника.
+func ValidateRole(role Role) error {
+	if _, ok := roleRanks[role]; !ok {
+		return ErrInvalidRole
+	}
+
+	return nil
+}
+
+// Can проверяет, есть ли у пользователя право на действие в чате.
+// Единая политика доступа: права определяются только ролью участника.
+// В архивном чате ни у кого нет прав на изменения
+func (c *Chat) Can(subjectID uuid.UUID, permission Permission) bool {
+	if c.IsArchived() {
+		return false
+	}
+
+	p, err := c.Participant(subjectID)
+	if err != nil {
+		return false
+	}
+
+	if c.IsDirect() {
+		return slices.Contains(directPermissions, permission)
+	}
+
+	return slices.Contains(rolePermissions[p.Role], permission)
+}
+
+// outranks проверяет, старше ли роль участника subjectID роли участника userID.
+func (c *Chat) outranks(subjectID, userID uuid.UUID) bool {
+	subject, err := c.Participant(subjectID)
+	if err != nil {
+		return false
+	}
+	user, err := c.Participant(userID)
+	if err != nil {
+		return false
+	}
+
+	return roleRanks[subject.Role] > roleRanks[user.Role]
+}
+
+// SetParticipantRole изменяет роль участника чата.
+// Изменять можно только роли младших участников и только на роль младше своей.
+// Роль владельца передается отдельно
+func (c *Chat) SetParticipantRole(subjectID, userID uuid.UUID, role Role, eventsBuf *events.Buffer) error {
+	if err := ValidateRole(role); err != nil {
+		return err
+	}
+	if role == RoleOwner {
+		return ErrCannotAssignOwner
+	}
+
+	// Найти индекс участника
+	i := slices.IndexFunc(c.Participants, func(p Participant) bool {
+		return p.UserID == userID
+	})
+	if i == -1 {
+		return ErrParticipantNotExists
+	}
+
+	// Проверить права и старшинство
+	subject, err := c.Participant(subjectID)
+	if err != nil {
+		return ErrSubjectIsNotMember
+	}
+	if !c.Can(subjectID, PermissionManageRoles) || !c.outranks(subjectID, userID) || roleRanks[role] >= roleRanks[subject.Role] {
+		return ErrPermissionDenied
+	}
+
+	// Выйти, если роль не изменилась
+	if c.Participants[i].Role == role {
+		return nil
+	}
+	c.Participants[i].Role = role
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventParticipantRoleChanged(c.Participants[i]))
+
+	return nil
+}
+
+// KickParticipant удаляет участника из чата по решению другого участника.
+// Удалять можно только участников с ролью младше своей
+func (c *Chat) KickParticipant(subjectID, userID uuid.UUID, eventsBuf *events.Buffer) error {
+	if !c.HasParticipant(userID) {
+		return ErrParticipantNotExists
+	}
+	if !c.Can(subjectID, PermissionRemoveMember) || !c.outranks(subjectID, userID) {
+		return ErrPermissionDenied
+	}
+
+	return c.RemoveParticipant(userID, eventsBuf)
+}
+
+// TransferChief передает владение чатом другому участнику.
+// Передать владение может только текущий главный администратор, после передачи он становится администратором
+func (c *Chat) TransferChief(subjectID, userID uuid.UUID, eventsBuf *events.Buffer) error {
+	if c.IsDirect() {
+		return ErrChatIsDirect
+	}
+	if subjectID != c.ChiefID {
+		return ErrSubjectIsNotChief
+	}
+	if userID == c.ChiefID {
+		return ErrUserIsAlreadyChief
+	}
+	if !c.HasParticipant(userID) {
+		return ErrParticipantNotExists
+	}
+
+	c.setChief(userID)
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventChatUpdated())
+
+	return nil
+}
+
+// ForceTransferChief принудительно передает владение чатом старшему по роли участнику.
+// Используется, когда главный администратор больше не может управлять чатом, например после удаления его аккаунта.
+// При равных ролях преемником становится участник, раньше вступивший в чат
+func (c *Chat) ForceTransferChief(eventsBuf *events.Buffer) (uuid.UUID, error) {
+	// Найти преемника среди остальных участников
+	successorID := uuid.Nil
+	for _, p := range c.Participants {
+		if p.UserID == c.ChiefID {
+			continue
+		}
+		if successorID == uuid.Nil || c.outranks(p.UserID, successorID) {
+			successorID = p.UserID
+		}
+	}
+	if successorID == uuid.Nil {
+		return uuid.Nil, ErrNoChiefSuccessor
+	}
+
+	c.setChief(successorID)
+
+	// Добавить событие
+	eventsBuf.AddSafety(c.NewEventChatUpdated())
+
+	return successorID, nil
+}
+
+// setChief назначает участника главным администратором чата.
+// Прежний главный администратор, если остается участником, становится администратором
+func (c *Chat) setChief(userID uuid.UUID) {
+	for i, p := range c.Participants {
+		switch p.UserID {
+		case c.ChiefID:
+			c.Participants[i].Role = RoleAdmin
+		case userID:
+			c.Participants[i].Role = RoleOwner
+		}
+	}
+	c.ChiefID = userID
+}
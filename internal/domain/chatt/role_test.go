@@ -0,0 +1,235 @@
+package chatt
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestChat_Can тестирует политику доступа участников чата.
+func TestChat_Can(t *testing.T) {
+	t.Run("создатель чата становится владельцем со всеми правами", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		chief, err := chat.Participant(chat.ChiefID)
+		require.NoError(t, err)
+		assert.Equal(t, RoleOwner, chief.Role)
+		for _, permission := range rolePermissions[RoleAdmin] {
+			assert.True(t, chat.Can(chat.ChiefID, permission))
+		}
+	})
+
+	t.Run("у посторонних нет прав", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		assert.False(t, chat.Can(uuid.New(), PermissionSendMessages))
+	})
+
+	t.Run("права определяются ролью участника", func(t *testing.T) {
+		tests := []struct {
+			role       Role
+			permission Permission
+			want       bool
+		}{
+			{role: RoleAdmin, permission: PermissionRename, want: true},
+			{role: RoleModerator, permission: PermissionRename, want: false},
+			{role: RoleModerator, permission: PermissionPin, want: true},
+			{role: RoleModerator, permission: PermissionDeleteMessages, want: true},
+			{role: RoleMember, permission: PermissionInvite, want: true},
+			{role: RoleMember, permission: PermissionRemoveMember, want: false},
+			{role: RoleReadOnly, permission: PermissionSendMessages, want: false},
+			{role: RoleReadOnly, permission: PermissionInvite, want: false},
+		}
+		for _, tt := range tests {
+			chat, err := NewChat("test chat", uuid.New(), nil)
+			require.NoError(t, err)
+			userID := uuid.New()
+			require.NoError(t, chat.AddParticipant(Participant{UserID: userID, Role: tt.role}, nil))
+			assert.Equal(t, tt.want, chat.Can(userID, tt.permission), "%s: %s", tt.role, tt.permission)
+		}
+	})
+}
+
+// TestChat_SetParticipantRole тестирует изменение роли участника.
+func TestChat_SetParticipantRole(t *testing.T) {
+	// newChatWith создает чат с участниками указанных ролей
+	newChatWith := func(t *testing.T, roles ...Role) (Chat, []uuid.UUID) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, len(roles))
+		for i, role := range roles {
+			ids[i] = uuid.New()
+			require.NoError(t, chat.AddParticipant(Participant{UserID: ids[i], Role: role}, nil))
+		}
+		return chat, ids
+	}
+
+	t.Run("роль должна быть корректной", func(t *testing.T) {
+		chat, ids := newChatWith(t, RoleMember)
+		err := chat.SetParticipantRole(chat.ChiefID, ids[0], "superuser", nil)
+		assert.ErrorIs(t, err, ErrInvalidRole)
+	})
+
+	t.Run("роль владельца нельзя назначить", func(t *testing.T) {
+		chat, ids := newChatWith(t, RoleMember)
+		err := chat.SetParticipantRole(chat.ChiefID, ids[0], RoleOwner, nil)
+		assert.ErrorIs(t, err, ErrCannotAssignOwner)
+	})
+
+	t.Run("участник должен существовать", func(t *testing.T) {
+		chat, _ := newChatWith(t)
+		err := chat.SetParticipantRole(chat.ChiefID, uuid.New(), RoleAdmin, nil)
+		assert.ErrorIs(t, err, ErrParticipantNotExists)
+	})
+
+	t.Run("модератор не может изменять роли", func(t *testing.T) {
+		chat, ids := newChatWith(t, RoleModerator, RoleMember)
+		err := chat.SetParticipantRole(ids[0], ids[1], RoleReadOnly, nil)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("администратор не может назначить администратора", func(t *testing.T) {
+		chat, ids := newChatWith(t, RoleAdmin, RoleMember)
+		err := chat.SetParticipantRole(ids[0], ids[1], RoleAdmin, nil)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("администратор не может изменить роль владельца или равного", func(t *testing.T) {
+		chat, ids := newChatWith(t, RoleAdmin, RoleAdmin)
+		err := chat.SetParticipantRole(ids[0], chat.ChiefID, RoleMember, nil)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		err = chat.SetParticipantRole(ids[0], ids[1], RoleMember, nil)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("владелец назначает роль и участники получают событие", func(t *testing.T) {
+		chat, ids := newChatWith(t, RoleMember)
+		eventsBuf := new(events.Buffer)
+		require.NoError(t, chat.SetParticipantRole(chat.ChiefID, ids[0], RoleAdmin, eventsBuf))
+		p, err := chat.Participant(ids[0])
+		require.NoError(t, err)
+		assert.Equal(t, RoleAdmin, p.Role)
+
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventParticipantRoleChanged, event.Type)
+		assert.ElementsMatch(t, chat.ParticipantIDs(), event.Recipients)
+		assert.Equal(t, p, event.Data["participant"])
+	})
+}
+
+// TestChat_KickParticipant тестирует удаление участника по решению другого участника.
+func TestChat_KickParticipant(t *testing.T) {
+	chat, err := NewChat("test chat", uuid.New(), nil)
+	require.NoError(t, err)
+	moderatorID, adminID, memberID := uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, chat.AddParticipant(Participant{UserID: moderatorID, Role: RoleModerator}, nil))
+	require.NoError(t, chat.AddParticipant(Participant{UserID: adminID, Role: RoleAdmin}, nil))
+	require.NoError(t, chat.AddParticipant(Participant{UserID: memberID, Role: RoleMember}, nil))
+
+	t.Run("участник должен существовать", func(t *testing.T) {
+		err := chat.KickParticipant(chat.ChiefID, uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrParticipantNotExists)
+	})
+
+	t.Run("нельзя удалить участника с ролью не младше своей", func(t *testing.T) {
+		err := chat.KickParticipant(moderatorID, adminID, nil)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		err = chat.KickParticipant(adminID, chat.ChiefID, nil)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("обычный участник не может удалять", func(t *testing.T) {
+		err := chat.KickParticipant(memberID, moderatorID, nil)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("модератор может удалить обычного участника", func(t *testing.T) {
+		require.NoError(t, chat.KickParticipant(moderatorID, memberID, nil))
+		assert.False(t, chat.HasParticipant(memberID))
+	})
+}
+
+// TestChat_TransferChief тестирует передачу владения чатом.
+func TestChat_TransferChief(t *testing.T) {
+	t.Run("передать владение может только главный администратор", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		adminID := uuid.New()
+		require.NoError(t, chat.AddParticipant(Participant{UserID: adminID, Role: RoleAdmin}, nil))
+		err = chat.TransferChief(adminID, adminID, nil)
+		assert.ErrorIs(t, err, ErrSubjectIsNotChief)
+	})
+
+	t.Run("нельзя передать владение самому себе", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.TransferChief(chat.ChiefID, chat.ChiefID, nil)
+		assert.ErrorIs(t, err, ErrUserIsAlreadyChief)
+	})
+
+	t.Run("новый главный администратор должен быть участником", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.TransferChief(chat.ChiefID, uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrParticipantNotExists)
+	})
+
+	t.Run("роли меняются местами и участники получают событие", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		formerChiefID, memberID := chat.ChiefID, uuid.New()
+		require.NoError(t, chat.AddParticipant(Participant{UserID: memberID, Role: RoleMember}, nil))
+
+		eventsBuf := new(events.Buffer)
+		require.NoError(t, chat.TransferChief(formerChiefID, memberID, eventsBuf))
+		assert.Equal(t, memberID, chat.ChiefID)
+		chief, err := chat.Participant(memberID)
+		require.NoError(t, err)
+		assert.Equal(t, RoleOwner, chief.Role)
+		former, err := chat.Participant(formerChiefID)
+		require.NoError(t, err)
+		assert.Equal(t, RoleAdmin, former.Role)
+
+		// Прежний главный администратор теперь может покинуть чат
+		assert.NoError(t, chat.RemoveParticipant(formerChiefID, nil))
+
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventChatUpdated, eventsBuf.Events()[0].Type)
+	})
+}
+
+// TestChat_ForceTransferChief тестирует принудительную передачу владения чатом.
+func TestChat_ForceTransferChief(t *testing.T) {
+	t.Run("без других участников преемника нет", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		_, err = chat.ForceTransferChief(nil)
+		assert.ErrorIs(t, err, ErrNoChiefSuccessor)
+	})
+
+	t.Run("преемником становится старший по роли участник", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		memberID, firstAdminID, secondAdminID := uuid.New(), uuid.New(), uuid.New()
+		require.NoError(t, chat.AddParticipant(Participant{UserID: memberID, Role: RoleMember}, nil))
+		require.NoError(t, chat.AddParticipant(Participant{UserID: firstAdminID, Role: RoleAdmin}, nil))
+		require.NoError(t, chat.AddParticipant(Participant{UserID: secondAdminID, Role: RoleAdmin}, nil))
+
+		eventsBuf := new(events.Buffer)
+		successorID, err := chat.ForceTransferChief(eventsBuf)
+		require.NoError(t, err)
+		assert.Equal(t, firstAdminID, successorID)
+		assert.Equal(t, firstAdminID, chat.ChiefID)
+		chief, err := chat.Participant(firstAdminID)
+		require.NoError(t, err)
+		assert.Equal(t, RoleOwner, chief.Role)
+
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventChatUpdated, eventsBuf.Events()[0].Type)
+	})
+}
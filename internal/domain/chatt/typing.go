@@ -0,0 +1,32 @@
+package chatt
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TypingTTL время, в течение которого индикатор набора текста считается актуальным
+const TypingTTL = 5 * time.Second
+
+// Typing сообщает остальным участникам чата, что пользователь набирает текст.
+// Состояние чата не изменяется, создается только эфемерное событие
+func (c *Chat) Typing(userID uuid.UUID, eventsBuf *events.Buffer) error {
+	// Убедиться, что пользователь является участником чата
+	if !c.HasParticipant(userID) {
+		return ErrParticipantNotExists
+	}
+
+	// В архивном чате нельзя печатать
+	if c.IsArchived() {
+		return ErrChatIsArchived
+	}
+
+	// Добавить событие
+	expiresAt := time.Now().Add(TypingTTL)
+	eventsBuf.AddSafety(c.NewEventTyping(userID, expiresAt))
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package chatt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestChat_Typing тестирует создание события набора текста.
+func TestChat_Typing(t *testing.T) {
+	t.Run("сообщать о наборе текста может только участник", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		err = chat.Typing(uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrParticipantNotExists)
+	})
+
+	t.Run("событие получат все участники, кроме набирающего текст", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		participant, err := NewParticipant(uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, chat.AddParticipant(participant, nil))
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, chat.Typing(participant.UserID, eventsBuf))
+
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventTyping, event.Type)
+		assert.Equal(t, []uuid.UUID{chat.ChiefID}, event.Recipients)
+		assert.Equal(t, participant.UserID, event.Data["user_id"])
+		assert.True(t, event.Ephemeral)
+	})
+
+	t.Run("событие содержит время окончания актуальности", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		before := time.Now()
+		require.NoError(t, chat.Typing(chat.ChiefID, eventsBuf))
+
+		require.Len(t, eventsBuf.Events(), 1)
+		expiresAt := eventsBuf.Events()[0].Data["expires_at"].(time.Time)
+		assert.False(t, expiresAt.Before(before.Add(TypingTTL)))
+		assert.False(t, expiresAt.After(time.Now().Add(TypingTTL)))
+	})
+
+	t.Run("состояние чата не изменяется", func(t *testing.T) {
+		chat, err := NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		before := chat
+
+		require.NoError(t, chat.Typing(chat.ChiefID, nil))
+		assert.Equal(t, before, chat)
+	})
+}
@@ -0,0 +1,51 @@
+package commandd
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Prefix символ, с которого начинается вызов команды в тексте сообщения
+const Prefix = "/"
+
+// NameMaxLen максимальная длина имени команды
+const NameMaxLen = 32
+
+// nameRe описывает допустимое имя команды
+var nameRe = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Command представляет собой вызов команды, разобранный из текста сообщения.
+type Command struct {
+	Name string // Имя команды без префикса в нижнем регистре
+	Args string // Аргументы команды без пробелов по краям
+}
+
+// Parse разбирает текст сообщения как вызов команды.
+// Текст является командой, если начинается с префикса, за которым сразу следует корректное имя.
+// Поэтому тексты вида "/ текст" и "//текст" командами не являются
+func Parse(text string) (Command, bool) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, Prefix) {
+		return Command{}, false
+	}
+
+	name, args, _ := strings.Cut(text[len(Prefix):], " ")
+	name = strings.ToLower(name)
+	if ValidateName(name) != nil {
+		return Command{}, false
+	}
+
+	return Command{
+		Name: name,
+		Args: strings.TrimSpace(args),
+	}, true
+}
+
+// ValidateName проверяет имя команды.
+func ValidateName(name string) error {
+	if len(name) > NameMaxLen || !nameRe.MatchString(name) {
+		return ErrInvalidCommandName
+	}
+
+	return nil
+}
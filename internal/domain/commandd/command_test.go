@@ -0,0 +1,46 @@
+package commandd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParse тестирует разбор команды из текста сообщения.
+func TestParse(t *testing.T) {
+	tests := []struct {
+		testname string
+		text     string
+		want     Command
+		wantOk   bool
+	}{
+		{testname: "команда без аргументов", text: "/leave", want: Command{Name: "leave"}, wantOk: true},
+		{testname: "команда с аргументами", text: "/rename  New  name ", want: Command{Name: "rename", Args: "New  name"}, wantOk: true},
+		{testname: "имя приводится к нижнему регистру", text: "/Invite @nick", want: Command{Name: "invite", Args: "@nick"}, wantOk: true},
+		{testname: "пробелы по краям", text: "  /kick @nick\n", want: Command{Name: "kick", Args: "@nick"}, wantOk: true},
+		{testname: "обычный текст", text: "hello /leave", wantOk: false},
+		{testname: "пробел после префикса", text: "/ leave", wantOk: false},
+		{testname: "двойной префикс", text: "//leave", wantOk: false},
+		{testname: "только префикс", text: "/", wantOk: false},
+		{testname: "путь", text: "/usr/bin", wantOk: false},
+		{testname: "слишком длинное имя", text: "/" + strings.Repeat("a", NameMaxLen+1), wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testname, func(t *testing.T) {
+			got, ok := Parse(tt.text)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestValidateName тестирует проверку имени команды.
+func TestValidateName(t *testing.T) {
+	assert.NoError(t, ValidateName("deploy_prod2"))
+	assert.Error(t, ValidateName(""))
+	assert.Error(t, ValidateName("2fa"))
+	assert.Error(t, ValidateName("Deploy"))
+	assert.Error(t, ValidateName("de-ploy"))
+	assert.Error(t, ValidateName(strings.Repeat("a", NameMaxLen+1)))
+}
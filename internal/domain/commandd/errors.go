@@ -0,0 +1,9 @@
+package commandd
+
+import "errors"
+
+var (
+	ErrInvalidCommandName = errors.New("некорректное имя команды")
+	ErrInvalidCommandArgs = errors.New("некорректные аргументы команды")
+	ErrUnknownCommand     = errors.New("неизвестная команда")
+)
@@ -0,0 +1,44 @@
+package commandd
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+const (
+	EventCommandInvoked = "command_invoked"
+	EventCommandReply   = "command_reply"
+)
+
+// NewEventCommandInvoked описывает событие вызова команды бота.
+// Событие получает только бот, зарегистрировавший команду
+func NewEventCommandInvoked(chatID, botID, callerID uuid.UUID, command Command) events.Event {
+	return events.Event{
+		Type:       EventCommandInvoked,
+		CreatedIn:  time.Now(),
+		Recipients: []uuid.UUID{botID},
+		Data: map[string]any{
+			"chat_id": chatID,
+			"user_id": callerID,
+			"command": command,
+		},
+	}
+}
+
+// NewEventCommandReply описывает событие ответа на команду.
+// Ответ видит только вызвавший команду пользователь
+func NewEventCommandReply(chatID, recipientID uuid.UUID, command, text string) events.Event {
+	return events.Event{
+		Type:       EventCommandReply,
+		CreatedIn:  time.Now(),
+		Recipients: []uuid.UUID{recipientID},
+		Data: map[string]any{
+			"chat_id": chatID,
+			"command": command,
+			"text":    text,
+		},
+	}
+}
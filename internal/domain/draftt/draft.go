@@ -0,0 +1,76 @@
+package draftt
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// Draft представляет собой черновик сообщения пользователя в чате.
+// У пользователя может быть не более одного черновика в каждом чате
+type Draft struct {
+	UserID    uuid.UUID // ID автора черновика
+	ChatID    uuid.UUID // ID чата
+	Text      string    // Текст черновика
+	UpdatedAt time.Time // Время последнего изменения
+}
+
+// NewDraft создает черновик сообщения пользователя в чате.
+// Разметка текста не проверяется, так как черновик может быть не дописан.
+// Событие об изменении черновика получат остальные сессии пользователя
+func NewDraft(chat chatt.Chat, userID, sessionID uuid.UUID, text string, eventsBuf *events.Buffer) (Draft, error) {
+	if err := domain.ValidateID(userID); err != nil {
+		return Draft{}, errors.Join(err, ErrInvalidUserID)
+	}
+	if err := ValidateDraftText(text); err != nil {
+		return Draft{}, err
+	}
+
+	// Черновики могут вести только участники чата
+	if !chat.HasParticipant(userID) {
+		return Draft{}, ErrUserIsNotMember
+	}
+
+	draft := Draft{
+		UserID:    userID,
+		ChatID:    chat.ID,
+		Text:      text,
+		UpdatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	eventsBuf.AddSafety(draft.NewEventDraftUpdated(sessionID))
+
+	return draft, nil
+}
+
+// Clear очищает текст черновика.
+// Событие об изменении черновика получат остальные сессии пользователя
+func (d *Draft) Clear(sessionID uuid.UUID, eventsBuf *events.Buffer) {
+	d.Text = ""
+	d.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	eventsBuf.AddSafety(d.NewEventDraftUpdated(sessionID))
+}
+
+// IsEmpty проверяет, очищен ли черновик.
+func (d *Draft) IsEmpty() bool {
+	return d.Text == ""
+}
+
+// ValidateDraftText проверяет корректность текста черновика.
+func ValidateDraftText(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return ErrDraftTextEmpty
+	}
+	if len([]rune(text)) > messagee.MessageTextMaxLen {
+		return ErrDraftTextTooLong
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package draftt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestNewDraft тестирует создание черновика.
+func TestNewDraft(t *testing.T) {
+	t.Run("черновик могут вести только участники чата", func(t *testing.T) {
+		chat := newChat(t)
+		d, err := NewDraft(chat, uuid.New(), uuid.New(), "text", nil)
+		assert.ErrorIs(t, err, ErrUserIsNotMember)
+		assert.Zero(t, d)
+	})
+
+	t.Run("текст не может быть пустым", func(t *testing.T) {
+		chat := newChat(t)
+		d, err := NewDraft(chat, chat.ChiefID, uuid.New(), " ", nil)
+		assert.ErrorIs(t, err, ErrDraftTextEmpty)
+		assert.Zero(t, d)
+	})
+
+	t.Run("текст ограничен по длине", func(t *testing.T) {
+		chat := newChat(t)
+		text := strings.Repeat("a", messagee.MessageTextMaxLen+1)
+		d, err := NewDraft(chat, chat.ChiefID, uuid.New(), text, nil)
+		assert.ErrorIs(t, err, ErrDraftTextTooLong)
+		assert.Zero(t, d)
+	})
+
+	t.Run("разметка недописанного текста не проверяется", func(t *testing.T) {
+		chat := newChat(t)
+		d, err := NewDraft(chat, chat.ChiefID, uuid.New(), "**недописан", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "**недописан", d.Text)
+	})
+
+	t.Run("событие получают остальные сессии автора", func(t *testing.T) {
+		chat := newChat(t)
+		sessionID := uuid.New()
+		eventsBuf := new(events.Buffer)
+		d, err := NewDraft(chat, chat.ChiefID, sessionID, "text", eventsBuf)
+		require.NoError(t, err)
+		assert.Equal(t, chat.ID, d.ChatID)
+		assert.Equal(t, chat.ChiefID, d.UserID)
+		assert.False(t, d.UpdatedAt.IsZero())
+
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventDraftUpdated, event.Type)
+		assert.Equal(t, []uuid.UUID{chat.ChiefID}, event.Recipients)
+		assert.Equal(t, []uuid.UUID{sessionID}, event.ExcludedSessions)
+	})
+}
+
+// TestDraft_Clear тестирует очистку черновика.
+func TestDraft_Clear(t *testing.T) {
+	chat := newChat(t)
+	d, err := NewDraft(chat, chat.ChiefID, uuid.New(), "text", nil)
+	require.NoError(t, err)
+
+	eventsBuf := new(events.Buffer)
+	d.Clear(uuid.New(), eventsBuf)
+	assert.True(t, d.IsEmpty())
+	require.Len(t, eventsBuf.Events(), 1)
+	assert.Equal(t, EventDraftUpdated, eventsBuf.Events()[0].Type)
+}
+
+func newChat(t *testing.T) chatt.Chat {
+	t.Helper()
+	chat, err := chatt.NewChat("chat", uuid.New(), nil)
+	require.NoError(t, err)
+	return chat
+}
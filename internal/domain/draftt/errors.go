@@ -0,0 +1,11 @@
+package draftt
+
+import "errors"
+
+var (
+	ErrInvalidUserID    = errors.New("некорректное значение UserID")
+	ErrDraftTextEmpty   = errors.New("текст черновика не может быть пустым")
+	ErrDraftTextTooLong = errors.New("текст черновика слишком длинный")
+	ErrUserIsNotMember  = errors.New("пользователь не является участником чата")
+	ErrDraftNotExists   = errors.New("черновика не существует")
+)
@@ -0,0 +1,27 @@
+package draftt
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+const (
+	EventDraftUpdated = "draft_updated"
+)
+
+// NewEventDraftUpdated описывает событие изменения черновика.
+// Событие получают только сессии автора черновика, кроме сессии, в которой он был изменен
+func (d *Draft) NewEventDraftUpdated(sessionID uuid.UUID) events.Event {
+	return events.Event{
+		Type:             EventDraftUpdated,
+		CreatedIn:        time.Now(),
+		Recipients:       []uuid.UUID{d.UserID},
+		ExcludedSessions: []uuid.UUID{sessionID},
+		Data: map[string]any{
+			"draft": *d,
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package draftt
+
+import (
+	"github.com/google/uuid"
+)
+
+// Repository представляет собой интерфейс для работы с репозиторием черновиков.
+type Repository interface {
+	List(Filter) ([]Draft, error)
+	Upsert(Draft) error
+	// Delete удаляет черновик пользователя в чате
+	Delete(userID, chatID uuid.UUID) error
+}
+
+// Filter представляет собой фильтр для выборки черновиков.
+type Filter struct {
+	UserID  uuid.UUID   // Фильтрация по ID автора
+	ChatID  uuid.UUID   // Фильтрация по ID чата
+	ChatIDs []uuid.UUID // Фильтрация по списку ID чатов
+}
+
+// Find возвращает черновик либо ошибку ErrDraftNotExists
+func Find(repo Repository, filter Filter) (Draft, error) {
+	drafts, err := repo.List(filter)
+	if err != nil {
+		return Draft{}, err
+	}
+	if len(drafts) != 1 {
+		return Draft{}, ErrDraftNotExists
+	}
+
+	return drafts[0], nil
+}
@@ -0,0 +1,13 @@
+package integrationn
+
+import "errors"
+
+var (
+	ErrInvalidChatID        = errors.New("некорректное значение ChatID")
+	ErrInvalidCreatorID     = errors.New("некорректное значение CreatorID")
+	ErrInvalidWebhookName   = errors.New("некорректное имя вебхука")
+	ErrInvalidWebhookToken  = errors.New("некорректный токен вебхука")
+	ErrWebhookIsRevoked     = errors.New("вебхук отозван")
+	ErrWebhookInAnotherChat = errors.New("вебхук относится к другому чату")
+	ErrWebhookNotExists     = errors.New("вебхука не существует")
+)
@@ -0,0 +1,33 @@
+package integrationn
+
+import (
+	"github.com/google/uuid"
+)
+
+// Repository представляет собой интерфейс для работы с репозиторием входящих вебхуков.
+type Repository interface {
+	List(Filter) ([]Webhook, error)
+	Upsert(Webhook) error
+	InTransaction(func(txRepo Repository) error) error
+}
+
+// Filter представляет собой фильтр для выборки входящих вебхуков.
+type Filter struct {
+	ID         uuid.UUID // Фильтрация по ID вебхука
+	ChatID     uuid.UUID // Фильтрация по ID чата
+	TokenHash  string    // Фильтрация по хэшу токена
+	ActiveOnly bool      // Брать только не отозванные вебхуки
+}
+
+// Find возвращает вебхук либо ошибку ErrWebhookNotExists
+func Find(repo Repository, filter Filter) (Webhook, error) {
+	webhooks, err := repo.List(filter)
+	if err != nil {
+		return Webhook{}, err
+	}
+	if len(webhooks) != 1 {
+		return Webhook{}, ErrWebhookNotExists
+	}
+
+	return webhooks[0], nil
+}
@@ -0,0 +1,118 @@
+package integrationn
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+)
+
+// WebhookTokenPrefix префикс токена входящего вебхука
+const WebhookTokenPrefix = "whk_"
+
+// WebhookNameMaxLen максимальная длина имени вебхука
+const WebhookNameMaxLen = 50
+
+// Webhook представляет собой агрегат входящего вебхука.
+// Вебхук привязан к чату и позволяет внешней системе отправлять в него сообщения
+// от имени интеграции без аутентификации пользователя
+type Webhook struct {
+	ID        uuid.UUID // Уникальный ID вебхука
+	ChatID    uuid.UUID // ID чата, в который отправляются сообщения
+	Name      string    // Имя интеграции, от которого отправляются сообщения
+	TokenHash string    // SHA-256 хэш секретного токена, сам токен не хранится
+	CreatorID uuid.UUID // ID администратора, создавшего вебхук
+	CreatedAt time.Time // Время создания
+	RevokedAt time.Time // Время отзыва
+}
+
+// NewWebhook создает входящий вебхук в чате.
+// Возвращает вебхук и его токен, который больше нигде не сохраняется
+func NewWebhook(chatID, creatorID uuid.UUID, name string) (Webhook, string, error) {
+	if err := domain.ValidateID(chatID); err != nil {
+		return Webhook{}, "", errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(creatorID); err != nil {
+		return Webhook{}, "", errors.Join(err, ErrInvalidCreatorID)
+	}
+	name = strings.TrimSpace(name)
+	if err := ValidateWebhookName(name); err != nil {
+		return Webhook{}, "", err
+	}
+
+	token := newWebhookToken()
+	return Webhook{
+		ID:        uuid.New(),
+		ChatID:    chatID,
+		Name:      name,
+		TokenHash: HashWebhookToken(token),
+		CreatorID: creatorID,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}, token, nil
+}
+
+// IsRevoked проверяет, отозван ли вебхук.
+func (w *Webhook) IsRevoked() bool {
+	return !w.RevokedAt.IsZero()
+}
+
+// RotateToken выпускает вебхуку новый токен, старый токен перестает действовать.
+func (w *Webhook) RotateToken() (string, error) {
+	if w.IsRevoked() {
+		return "", ErrWebhookIsRevoked
+	}
+
+	token := newWebhookToken()
+	w.TokenHash = HashWebhookToken(token)
+
+	return token, nil
+}
+
+// Revoke отзывает вебхук, после чего его токен перестает действовать.
+func (w *Webhook) Revoke() error {
+	if w.IsRevoked() {
+		return ErrWebhookIsRevoked
+	}
+
+	w.TokenHash = ""
+	w.RevokedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	return nil
+}
+
+// ValidateWebhookName проверяет имя вебхука.
+func ValidateWebhookName(name string) error {
+	if name == "" || len([]rune(name)) > WebhookNameMaxLen || strings.ContainsAny(name, "\n\t") {
+		return ErrInvalidWebhookName
+	}
+
+	return nil
+}
+
+// ValidateWebhookToken проверяет формат токена вебхука.
+func ValidateWebhookToken(token string) error {
+	if !strings.HasPrefix(token, WebhookTokenPrefix) || len(token) != len(WebhookTokenPrefix)+64 {
+		return ErrInvalidWebhookToken
+	}
+
+	return nil
+}
+
+// HashWebhookToken возвращает хэш токена, по которому вебхук ищется в репозитории.
+func HashWebhookToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// newWebhookToken создает случайный токен вебхука
+func newWebhookToken() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return WebhookTokenPrefix + hex.EncodeToString(b)
+}
@@ -0,0 +1,76 @@
+package integrationn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewWebhook тестирует создание входящего вебхука.
+func TestNewWebhook(t *testing.T) {
+	t.Run("хранится только хэш токена", func(t *testing.T) {
+		webhook, token, err := NewWebhook(uuid.New(), uuid.New(), " Alerts ")
+		require.NoError(t, err)
+		assert.Equal(t, "Alerts", webhook.Name)
+		assert.NoError(t, ValidateWebhookToken(token))
+		assert.Equal(t, HashWebhookToken(token), webhook.TokenHash)
+		assert.False(t, webhook.IsRevoked())
+	})
+
+	testCases := []struct {
+		name      string
+		chatID    uuid.UUID
+		creatorID uuid.UUID
+		hookName  string
+		err       error
+	}{
+		{name: "без чата", creatorID: uuid.New(), hookName: "a", err: ErrInvalidChatID},
+		{name: "без создателя", chatID: uuid.New(), hookName: "a", err: ErrInvalidCreatorID},
+		{name: "пустое имя", chatID: uuid.New(), creatorID: uuid.New(), hookName: " ", err: ErrInvalidWebhookName},
+		{name: "слишком длинное имя", chatID: uuid.New(), creatorID: uuid.New(), hookName: strings.Repeat("я", WebhookNameMaxLen+1), err: ErrInvalidWebhookName},
+		{name: "перенос строки в имени", chatID: uuid.New(), creatorID: uuid.New(), hookName: "a\nb", err: ErrInvalidWebhookName},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			webhook, token, err := NewWebhook(tc.chatID, tc.creatorID, tc.hookName)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Zero(t, webhook)
+			assert.Empty(t, token)
+		})
+	}
+}
+
+// TestWebhook_RotateToken тестирует выпуск нового токена.
+func TestWebhook_RotateToken(t *testing.T) {
+	webhook, token, err := NewWebhook(uuid.New(), uuid.New(), "Alerts")
+	require.NoError(t, err)
+
+	newToken, err := webhook.RotateToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, newToken)
+	assert.Equal(t, HashWebhookToken(newToken), webhook.TokenHash)
+}
+
+// TestWebhook_Revoke тестирует отзыв вебхука.
+func TestWebhook_Revoke(t *testing.T) {
+	webhook, _, err := NewWebhook(uuid.New(), uuid.New(), "Alerts")
+	require.NoError(t, err)
+
+	require.NoError(t, webhook.Revoke())
+	assert.True(t, webhook.IsRevoked())
+	assert.Empty(t, webhook.TokenHash)
+	assert.ErrorIs(t, webhook.Revoke(), ErrWebhookIsRevoked)
+	_, err = webhook.RotateToken()
+	assert.ErrorIs(t, err, ErrWebhookIsRevoked)
+}
+
+// TestValidateWebhookToken тестирует проверку формата токена.
+func TestValidateWebhookToken(t *testing.T) {
+	assert.Error(t, ValidateWebhookToken(""))
+	assert.Error(t, ValidateWebhookToken("whk_short"))
+	assert.Error(t, ValidateWebhookToken("bot_"+strings.Repeat("a", 64)))
+	assert.NoError(t, ValidateWebhookToken(WebhookTokenPrefix+strings.Repeat("a", 64)))
+}
@@ -0,0 +1,19 @@
+package invitelinkk
+
+import "errors"
+
+var (
+	ErrInvalidChatID        = errors.New("некорректное значение ChatID")
+	ErrInvalidCreatorID     = errors.New("некорректное значение CreatorID")
+	ErrInvalidUserID        = errors.New("некорректное значение UserID")
+	ErrInvalidExpiresAt     = errors.New("время окончания действия ссылки должно быть в будущем")
+	ErrInvalidMaxUses       = errors.New("максимальное количество использований не может быть отрицательным")
+	ErrInvalidLinkToken     = errors.New("некорректный токен пригласительной ссылки")
+	ErrLinkIsRevoked        = errors.New("пригласительная ссылка отозвана")
+	ErrLinkIsExpired        = errors.New("срок действия пригласительной ссылки истек")
+	ErrLinkUsesExhausted    = errors.New("пригласительная ссылка использована максимальное количество раз")
+	ErrLinkAlreadyUsed      = errors.New("пользователь уже воспользовался пригласительной ссылкой")
+	ErrLinkInAnotherChat    = errors.New("пригласительная ссылка относится к другому чату")
+	ErrLinkNotExists        = errors.New("пригласительной ссылки не существует")
+	ErrJoinRequestNotExists = errors.New("заявки на вступление не существует")
+)
@@ -0,0 +1,51 @@
+package invitelinkk
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+const (
+	EventJoinRequested       = "join_requested"
+	EventJoinRequestRejected = "join_request_rejected"
+)
+
+// NewEventJoinRequested описывает событие подачи заявки на вступление по ссылке.
+// Событие получают участники, которые могут управлять приглашениями
+func (l *Link) NewEventJoinRequested(chat chatt.Chat, use Use) events.Event {
+	var recipients []uuid.UUID
+	for _, p := range chat.Participants {
+		if chat.Can(p.UserID, chatt.PermissionManageInvitations) {
+			recipients = append(recipients, p.UserID)
+		}
+	}
+
+	return events.Event{
+		Type:       EventJoinRequested,
+		CreatedIn:  time.Now(),
+		Recipients: recipients,
+		Data: map[string]any{
+			"link_id": l.ID,
+			"chat_id": l.ChatID,
+			"use":     use,
+		},
+	}
+}
+
+// NewEventJoinRequestRejected описывает событие отклонения заявки на вступление
+func (l *Link) NewEventJoinRequestRejected(use Use) events.Event {
+	return events.Event{
+		Type:       EventJoinRequestRejected,
+		CreatedIn:  time.Now(),
+		Recipients: []uuid.UUID{use.UserID},
+		Data: map[string]any{
+			"link_id": l.ID,
+			"chat_id": l.ChatID,
+			"use":     use,
+		},
+	}
+}
@@ -0,0 +1,239 @@
+package invitelinkk
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// LinkTokenPrefix префикс токена пригласительной ссылки
+const LinkTokenPrefix = "inv_"
+
+// UseStatus представляет собой состояние использования ссылки.
+type UseStatus string
+
+const (
+	UseStatusJoined   UseStatus = "joined"   // Пользователь вступил в чат
+	UseStatusPending  UseStatus = "pending"  // Заявка ожидает одобрения администратором
+	UseStatusRejected UseStatus = "rejected" // Заявка отклонена администратором
+)
+
+// Link представляет собой агрегат пригласительной ссылки.
+// По ссылке в чат может вступить любой пользователь, знающий ее токен
+type Link struct {
+	ID               uuid.UUID // Уникальный ID ссылки
+	ChatID           uuid.UUID // ID чата, в который ведет ссылка
+	TokenHash        string    // SHA-256 хэш секретного токена, сам токен не хранится
+	CreatorID        uuid.UUID // ID администратора, создавшего ссылку
+	ExpiresAt        time.Time // Время окончания действия. Нулевое значение - ссылка бессрочная
+	MaxUses          int       // Максимальное количество использований. 0 - без ограничений
+	RequiresApproval bool      // Вступление по ссылке требует одобрения администратором
+	CreatedAt        time.Time // Время создания
+	RevokedAt        time.Time // Время отзыва
+
+	Uses []Use // Использования ссылки
+}
+
+// Use представляет собой использование пригласительной ссылки пользователем.
+type Use struct {
+	UserID     uuid.UUID // ID пользователя, воспользовавшегося ссылкой
+	Status     UseStatus // Состояние использования
+	UsedAt     time.Time // Время использования
+	ReviewedBy uuid.UUID // ID администратора, рассмотревшего заявку
+}
+
+// NewLink создает пригласительную ссылку в чат.
+// Возвращает ссылку и ее токен, который больше нигде не сохраняется
+func NewLink(chatID, creatorID uuid.UUID, expiresAt time.Time, maxUses int, requiresApproval bool) (Link, string, error) {
+	if err := domain.ValidateID(chatID); err != nil {
+		return Link{}, "", errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(creatorID); err != nil {
+		return Link{}, "", errors.Join(err, ErrInvalidCreatorID)
+	}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return Link{}, "", ErrInvalidExpiresAt
+	}
+	if maxUses < 0 {
+		return Link{}, "", ErrInvalidMaxUses
+	}
+
+	token := newLinkToken()
+	return Link{
+		ID:               uuid.New(),
+		ChatID:           chatID,
+		TokenHash:        HashLinkToken(token),
+		CreatorID:        creatorID,
+		ExpiresAt:        expiresAt.UTC().Truncate(time.Microsecond),
+		MaxUses:          maxUses,
+		RequiresApproval: requiresApproval,
+		CreatedAt:        now,
+		Uses:             []Use{},
+	}, token, nil
+}
+
+// IsRevoked проверяет, отозвана ли ссылка.
+func (l *Link) IsRevoked() bool {
+	return !l.RevokedAt.IsZero()
+}
+
+// IsExpired проверяет, истек ли срок действия ссылки на момент now.
+func (l *Link) IsExpired(now time.Time) bool {
+	return !l.ExpiresAt.IsZero() && !now.Before(l.ExpiresAt)
+}
+
+// UsesCount возвращает количество использований ссылки, не считая отклоненных заявок.
+func (l *Link) UsesCount() int {
+	count := 0
+	for _, u := range l.Uses {
+		if u.Status != UseStatusRejected {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Revoke отзывает ссылку, после чего по ней нельзя вступить в чат.
+// Уже поданные заявки можно рассмотреть и после отзыва
+func (l *Link) Revoke() error {
+	if l.IsRevoked() {
+		return ErrLinkIsRevoked
+	}
+
+	l.TokenHash = ""
+	l.RevokedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	return nil
+}
+
+// Use регистрирует использование ссылки пользователем.
+// Если ссылка требует одобрения, использование становится заявкой на вступление,
+// о которой уведомляются администраторы чата
+func (l *Link) Use(chat chatt.Chat, userID uuid.UUID, now time.Time, eventsBuf *events.Buffer) (Use, error) {
+	if err := domain.ValidateID(userID); err != nil {
+		return Use{}, errors.Join(err, ErrInvalidUserID)
+	}
+	if chat.ID != l.ChatID {
+		return Use{}, ErrLinkInAnotherChat
+	}
+	if chat.HasParticipant(userID) {
+		return Use{}, chatt.ErrParticipantExists
+	}
+	if l.IsRevoked() {
+		return Use{}, ErrLinkIsRevoked
+	}
+	if l.IsExpired(now) {
+		return Use{}, ErrLinkIsExpired
+	}
+	if l.MaxUses > 0 && l.UsesCount() >= l.MaxUses {
+		return Use{}, ErrLinkUsesExhausted
+	}
+
+	// Повторно воспользоваться ссылкой можно только после отклонения заявки
+	i := slices.IndexFunc(l.Uses, func(u Use) bool {
+		return u.UserID == userID
+	})
+	if i != -1 && l.Uses[i].Status != UseStatusRejected {
+		return Use{}, ErrLinkAlreadyUsed
+	}
+
+	use := Use{
+		UserID: userID,
+		Status: UseStatusJoined,
+		UsedAt: now.UTC().Truncate(time.Microsecond),
+	}
+	if l.RequiresApproval {
+		use.Status = UseStatusPending
+	}
+
+	if i != -1 {
+		l.Uses[i] = use
+	} else {
+		l.Uses = append(l.Uses, use)
+	}
+
+	if use.Status == UseStatusPending {
+		eventsBuf.AddSafety(l.NewEventJoinRequested(chat, use))
+	}
+
+	return use, nil
+}
+
+// Approve одобряет заявку пользователя на вступление.
+// Добавить пользователя в чат должен вызывающий код
+func (l *Link) Approve(reviewerID, userID uuid.UUID) (Use, error) {
+	return l.review(reviewerID, userID, UseStatusJoined)
+}
+
+// Reject отклоняет заявку пользователя на вступление.
+func (l *Link) Reject(reviewerID, userID uuid.UUID, eventsBuf *events.Buffer) (Use, error) {
+	use, err := l.review(reviewerID, userID, UseStatusRejected)
+	if err != nil {
+		return Use{}, err
+	}
+
+	eventsBuf.AddSafety(l.NewEventJoinRequestRejected(use))
+
+	return use, nil
+}
+
+// PendingUses возвращает заявки, ожидающие одобрения.
+func (l *Link) PendingUses() []Use {
+	var pending []Use
+	for _, u := range l.Uses {
+		if u.Status == UseStatusPending {
+			pending = append(pending, u)
+		}
+	}
+
+	return pending
+}
+
+// review переводит заявку пользователя в состояние status
+func (l *Link) review(reviewerID, userID uuid.UUID, status UseStatus) (Use, error) {
+	i := slices.IndexFunc(l.Uses, func(u Use) bool {
+		return u.UserID == userID && u.Status == UseStatusPending
+	})
+	if i == -1 {
+		return Use{}, ErrJoinRequestNotExists
+	}
+
+	l.Uses[i].Status = status
+	l.Uses[i].ReviewedBy = reviewerID
+
+	return l.Uses[i], nil
+}
+
+// ValidateLinkToken проверяет формат токена пригласительной ссылки.
+func ValidateLinkToken(token string) error {
+	if !strings.HasPrefix(token, LinkTokenPrefix) || len(token) != len(LinkTokenPrefix)+64 {
+		return ErrInvalidLinkToken
+	}
+
+	return nil
+}
+
+// HashLinkToken возвращает хэш токена, по которому ссылка ищется в репозитории.
+func HashLinkToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// newLinkToken создает случайный токен пригласительной ссылки
+func newLinkToken() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return LinkTokenPrefix + hex.EncodeToString(b)
+}
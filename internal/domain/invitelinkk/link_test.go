@@ -0,0 +1,226 @@
+package invitelinkk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// newChat создает чат для тестов
+func newChat(t *testing.T) chatt.Chat {
+	chat, err := chatt.NewChat("name", uuid.New(), nil)
+	require.NoError(t, err)
+	return chat
+}
+
+// TestNewLink тестирует создание пригласительной ссылки.
+func TestNewLink(t *testing.T) {
+	t.Run("хранится только хэш токена", func(t *testing.T) {
+		link, token, err := NewLink(uuid.New(), uuid.New(), time.Time{}, 0, false)
+		require.NoError(t, err)
+		assert.NoError(t, ValidateLinkToken(token))
+		assert.Equal(t, HashLinkToken(token), link.TokenHash)
+		assert.False(t, link.IsRevoked())
+		assert.Empty(t, link.Uses)
+	})
+
+	testCases := []struct {
+		name      string
+		chatID    uuid.UUID
+		creatorID uuid.UUID
+		expiresAt time.Time
+		maxUses   int
+		err       error
+	}{
+		{name: "без чата", creatorID: uuid.New(), err: ErrInvalidChatID},
+		{name: "без создателя", chatID: uuid.New(), err: ErrInvalidCreatorID},
+		{name: "срок действия в прошлом", chatID: uuid.New(), creatorID: uuid.New(), expiresAt: time.Now().Add(-time.Minute), err: ErrInvalidExpiresAt},
+		{name: "отрицательное количество использований", chatID: uuid.New(), creatorID: uuid.New(), maxUses: -1, err: ErrInvalidMaxUses},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			link, token, err := NewLink(tc.chatID, tc.creatorID, tc.expiresAt, tc.maxUses, false)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Zero(t, link)
+			assert.Empty(t, token)
+		})
+	}
+}
+
+// TestLink_Revoke тестирует отзыв ссылки.
+func TestLink_Revoke(t *testing.T) {
+	link, _, err := NewLink(uuid.New(), uuid.New(), time.Time{}, 0, false)
+	require.NoError(t, err)
+
+	require.NoError(t, link.Revoke())
+	assert.True(t, link.IsRevoked())
+	assert.Empty(t, link.TokenHash)
+	assert.ErrorIs(t, link.Revoke(), ErrLinkIsRevoked)
+}
+
+// TestLink_Use тестирует использование ссылки.
+func TestLink_Use(t *testing.T) {
+	t.Run("без одобрения пользователь сразу вступает", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, false)
+		require.NoError(t, err)
+		eventsBuf := new(events.Buffer)
+
+		userID := uuid.New()
+		use, err := link.Use(chat, userID, time.Now(), eventsBuf)
+		require.NoError(t, err)
+		assert.Equal(t, userID, use.UserID)
+		assert.Equal(t, UseStatusJoined, use.Status)
+		assert.Equal(t, 1, link.UsesCount())
+		assert.Empty(t, eventsBuf.Events())
+	})
+
+	t.Run("с одобрением создается заявка и уведомляются администраторы", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, true)
+		require.NoError(t, err)
+		eventsBuf := new(events.Buffer)
+
+		use, err := link.Use(chat, uuid.New(), time.Now(), eventsBuf)
+		require.NoError(t, err)
+		assert.Equal(t, UseStatusPending, use.Status)
+		assert.Len(t, link.PendingUses(), 1)
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventJoinRequested, eventsBuf.Events()[0].Type)
+		assert.Equal(t, []uuid.UUID{chat.ChiefID}, eventsBuf.Events()[0].Recipients)
+	})
+
+	t.Run("участник чата не может воспользоваться ссылкой", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, false)
+		require.NoError(t, err)
+
+		_, err = link.Use(chat, chat.ChiefID, time.Now(), nil)
+		assert.ErrorIs(t, err, chatt.ErrParticipantExists)
+	})
+
+	t.Run("ссылка другого чата", func(t *testing.T) {
+		link, _, err := NewLink(uuid.New(), uuid.New(), time.Time{}, 0, false)
+		require.NoError(t, err)
+
+		_, err = link.Use(newChat(t), uuid.New(), time.Now(), nil)
+		assert.ErrorIs(t, err, ErrLinkInAnotherChat)
+	})
+
+	t.Run("отозванная ссылка", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, false)
+		require.NoError(t, err)
+		require.NoError(t, link.Revoke())
+
+		_, err = link.Use(chat, uuid.New(), time.Now(), nil)
+		assert.ErrorIs(t, err, ErrLinkIsRevoked)
+	})
+
+	t.Run("истекшая ссылка", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Now().Add(time.Hour), 0, false)
+		require.NoError(t, err)
+
+		_, err = link.Use(chat, uuid.New(), time.Now().Add(2*time.Hour), nil)
+		assert.ErrorIs(t, err, ErrLinkIsExpired)
+	})
+
+	t.Run("исчерпан лимит использований", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 1, false)
+		require.NoError(t, err)
+
+		_, err = link.Use(chat, uuid.New(), time.Now(), nil)
+		require.NoError(t, err)
+		_, err = link.Use(chat, uuid.New(), time.Now(), nil)
+		assert.ErrorIs(t, err, ErrLinkUsesExhausted)
+	})
+
+	t.Run("повторная заявка возможна только после отклонения", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 1, true)
+		require.NoError(t, err)
+		userID := uuid.New()
+
+		_, err = link.Use(chat, userID, time.Now(), nil)
+		require.NoError(t, err)
+		_, err = link.Use(chat, userID, time.Now(), nil)
+		assert.ErrorIs(t, err, ErrLinkUsesExhausted)
+
+		_, err = link.Reject(chat.ChiefID, userID, nil)
+		require.NoError(t, err)
+		assert.Zero(t, link.UsesCount())
+
+		use, err := link.Use(chat, userID, time.Now(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, UseStatusPending, use.Status)
+		assert.Len(t, link.Uses, 1)
+	})
+}
+
+// TestLink_Review тестирует рассмотрение заявок на вступление.
+func TestLink_Review(t *testing.T) {
+	t.Run("одобрение заявки", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, true)
+		require.NoError(t, err)
+		userID := uuid.New()
+		_, err = link.Use(chat, userID, time.Now(), nil)
+		require.NoError(t, err)
+
+		use, err := link.Approve(chat.ChiefID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, UseStatusJoined, use.Status)
+		assert.Equal(t, chat.ChiefID, use.ReviewedBy)
+		assert.Empty(t, link.PendingUses())
+	})
+
+	t.Run("отклонение заявки уведомляет пользователя", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, true)
+		require.NoError(t, err)
+		userID := uuid.New()
+		_, err = link.Use(chat, userID, time.Now(), nil)
+		require.NoError(t, err)
+		eventsBuf := new(events.Buffer)
+
+		use, err := link.Reject(chat.ChiefID, userID, eventsBuf)
+		require.NoError(t, err)
+		assert.Equal(t, UseStatusRejected, use.Status)
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventJoinRequestRejected, eventsBuf.Events()[0].Type)
+		assert.Equal(t, []uuid.UUID{userID}, eventsBuf.Events()[0].Recipients)
+	})
+
+	t.Run("нельзя рассмотреть несуществующую заявку", func(t *testing.T) {
+		chat := newChat(t)
+		link, _, err := NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, false)
+		require.NoError(t, err)
+		userID := uuid.New()
+		_, err = link.Use(chat, userID, time.Now(), nil)
+		require.NoError(t, err)
+
+		_, err = link.Approve(chat.ChiefID, userID)
+		assert.ErrorIs(t, err, ErrJoinRequestNotExists)
+		_, err = link.Reject(chat.ChiefID, uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrJoinRequestNotExists)
+	})
+}
+
+// TestValidateLinkToken тестирует проверку формата токена.
+func TestValidateLinkToken(t *testing.T) {
+	_, token, err := NewLink(uuid.New(), uuid.New(), time.Time{}, 0, false)
+	require.NoError(t, err)
+
+	assert.NoError(t, ValidateLinkToken(token))
+	assert.ErrorIs(t, ValidateLinkToken(""), ErrInvalidLinkToken)
+	assert.ErrorIs(t, ValidateLinkToken("whk_"+token[len(LinkTokenPrefix):]), ErrInvalidLinkToken)
+	assert.ErrorIs(t, ValidateLinkToken(token[:len(token)-1]), ErrInvalidLinkToken)
+}
@@ -0,0 +1,40 @@
+package invitelinkk
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+// Repository представляет собой интерфейс для работы с репозиторием пригласительных ссылок.
+type Repository interface {
+	// List возвращает ссылки по фильтру.
+	// Внутри InTransaction выбранные ссылки блокируются до ее завершения
+	List(Filter) ([]Link, error)
+	Upsert(Link) error
+	InTransaction(func(txRepo Repository) error) error
+	// ChatsRepo возвращает репозиторий чатов, работающий в той же транзакции.
+	// Позволяет сохранить использование ссылки и состав чата атомарно
+	ChatsRepo() chatt.Repository
+}
+
+// Filter представляет собой фильтр для выборки пригласительных ссылок.
+type Filter struct {
+	ID         uuid.UUID // Фильтрация по ID ссылки
+	ChatID     uuid.UUID // Фильтрация по ID чата
+	TokenHash  string    // Фильтрация по хэшу токена
+	ActiveOnly bool      // Брать только не отозванные ссылки
+}
+
+// Find возвращает ссылку либо ошибку ErrLinkNotExists
+func Find(repo Repository, filter Filter) (Link, error) {
+	links, err := repo.List(filter)
+	if err != nil {
+		return Link{}, err
+	}
+	if len(links) != 1 {
+		return Link{}, ErrLinkNotExists
+	}
+
+	return links[0], nil
+}
@@ -0,0 +1,52 @@
+package messagee
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+// MaxAttachments максимальное количество вложений в сообщении.
+const MaxAttachments = 10
+
+// parseContent проверяет содержимое сообщения и разбирает разметку текста.
+// Сообщение без вложений должно содержать текст
+func parseContent(text string, attachments []attachmentt.Attachment) (Markup, error) {
+	if len(attachments) == 0 {
+		if err := ValidateMessageText(text); err != nil {
+			return Markup{}, err
+		}
+	}
+	if len([]rune(text)) > MessageTextMaxLen {
+		return Markup{}, ErrTextTooLong
+	}
+
+	return ParseMarkup(text)
+}
+
+// attachmentIDsOf проверяет, что вложения можно прикрепить к сообщению автора в чате,
+// и возвращает их ID в исходном порядке
+func attachmentIDsOf(chat chatt.Chat, authorID uuid.UUID, attachments []attachmentt.Attachment) ([]uuid.UUID, error) {
+	if len(attachments) > MaxAttachments {
+		return nil, ErrTooManyAttachments
+	}
+
+	ids := make([]uuid.UUID, 0, len(attachments))
+	for _, a := range attachments {
+		if a.ChatID != chat.ID {
+			return nil, ErrAttachmentInAnotherChat
+		}
+		if a.UploaderID != authorID {
+			return nil, ErrAttachmentNotOwned
+		}
+		if slices.Contains(ids, a.ID) {
+			return nil, ErrDuplicateAttachment
+		}
+		ids = append(ids, a.ID)
+	}
+
+	return ids, nil
+}
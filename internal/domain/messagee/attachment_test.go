@@ -0,0 +1,97 @@
+package messagee
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+// TestNewMessageWithAttachments тестирует создание сообщения с вложениями.
+func TestNewMessageWithAttachments(t *testing.T) {
+	t.Run("сообщение с вложениями может не содержать текста", func(t *testing.T) {
+		chat := newChat(t)
+		attachment := newAttachment(chat, chat.ChiefID)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "", []attachmentt.Attachment{attachment}, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{attachment.ID}, message.AttachmentIDs)
+	})
+
+	t.Run("сообщение без вложений должно содержать текст", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "", nil, nil, nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrTextEmpty)
+	})
+
+	t.Run("количество вложений ограничено", func(t *testing.T) {
+		chat := newChat(t)
+		attachments := make([]attachmentt.Attachment, MaxAttachments+1)
+		for i := range attachments {
+			attachments[i] = newAttachment(chat, chat.ChiefID)
+		}
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", attachments, nil, nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrTooManyAttachments)
+	})
+
+	t.Run("вложение не может быть указано дважды", func(t *testing.T) {
+		chat := newChat(t)
+		attachment := newAttachment(chat, chat.ChiefID)
+		attachments := []attachmentt.Attachment{attachment, attachment}
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", attachments, nil, nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrDuplicateAttachment)
+	})
+
+	t.Run("вложение должно быть загружено в этот же чат", func(t *testing.T) {
+		chat := newChat(t)
+		otherChat := newChat(t)
+		attachment := newAttachment(otherChat, otherChat.ChiefID)
+		attachment.UploaderID = chat.ChiefID
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", []attachmentt.Attachment{attachment}, nil, nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrAttachmentInAnotherChat)
+	})
+
+	t.Run("вложение должно быть загружено автором сообщения", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		attachment := newAttachment(chat, participant.UserID)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", []attachmentt.Attachment{attachment}, nil, nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrAttachmentNotOwned)
+	})
+
+	t.Run("ответ в треде может содержать вложения", func(t *testing.T) {
+		chat := newChat(t)
+		parent := newMessage(t, chat, chat.ChiefID)
+		attachment := newAttachment(chat, chat.ChiefID)
+		reply, err := NewReplyWithAttachments(chat, &parent, chat.ChiefID, "", []attachmentt.Attachment{attachment}, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, parent.ID, reply.ParentID)
+		assert.Equal(t, []uuid.UUID{attachment.ID}, reply.AttachmentIDs)
+	})
+
+	t.Run("при удалении сообщения вложения открепляются", func(t *testing.T) {
+		chat := newChat(t)
+		attachment := newAttachment(chat, chat.ChiefID)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "", []attachmentt.Attachment{attachment}, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+		assert.Empty(t, message.AttachmentIDs)
+	})
+}
+
+// newAttachment создает вложение для тестов
+func newAttachment(chat chatt.Chat, uploaderID uuid.UUID) attachmentt.Attachment {
+	return attachmentt.Attachment{
+		ID:         uuid.New(),
+		ChatID:     chat.ID,
+		UploaderID: uploaderID,
+	}
+}
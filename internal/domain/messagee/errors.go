@@ -0,0 +1,52 @@
+package messagee
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidAuthorID          = errors.New("некорректное значение AuthorID")
+	ErrInvalidUserID            = errors.New("некорректное значение UserID")
+	ErrInvalidEmoji             = errors.New("некорректное значение эмодзи")
+	ErrTextEmpty                = errors.New("текст сообщения не может быть пустым")
+	ErrTextTooLong              = fmt.Errorf("текст сообщения не может быть длиннее %d символов", MessageTextMaxLen)
+	ErrAuthorIsNotMember        = errors.New("автор сообщения не является участником чата")
+	ErrAuthorCannotSend         = errors.New("у автора нет права отправлять сообщения в чат")
+	ErrMessageNotExists         = errors.New("сообщения не существует")
+	ErrMessageIsDeleted         = errors.New("сообщение удалено")
+	ErrSubjectIsNotAuthor       = errors.New("пользователь не является автором сообщения")
+	ErrSubjectCannotDelete      = errors.New("удалить сообщение может только автор или участник с правом удаления сообщений")
+	ErrTextNotChanged           = errors.New("текст сообщения не изменился")
+	ErrCannotReplyToReply       = errors.New("ответить можно только на корневое сообщение треда")
+	ErrParentInAnotherChat      = errors.New("корневое сообщение треда находится в другом чате")
+	ErrUserIsNotMember          = errors.New("пользователь не является участником чата")
+	ErrReactionExists           = errors.New("пользователь уже поставил эту реакцию")
+	ErrReactionNotExists        = errors.New("реакции не существует")
+	ErrTooManyAttachments       = fmt.Errorf("сообщение не может содержать больше %d вложений", MaxAttachments)
+	ErrDuplicateAttachment      = errors.New("вложение указано несколько раз")
+	ErrAttachmentInAnotherChat  = errors.New("вложение загружено в другой чат")
+	ErrAttachmentNotOwned       = errors.New("вложение загружено другим пользователем")
+	ErrCannotForwardAttachments = errors.New("сообщение с вложениями нельзя переслать")
+	ErrTooManyMentions          = fmt.Errorf("сообщение не может содержать больше %d упоминаний", MaxMentions)
+	ErrMentionedIsNotMember     = errors.New("упомянутый пользователь не является участником чата")
+	ErrMarkupUnclosed           = errors.New("разметка текста содержит незакрытый маркер")
+	ErrMarkupOverlap            = errors.New("форматирование текста не может пересекаться")
+	ErrMarkupEmptyEntity        = errors.New("форматирование не может применяться к пустому тексту")
+	ErrMarkupNestedLink         = errors.New("ссылка не может содержать другую ссылку")
+	ErrMarkupInvalidLink        = errors.New("некорректная ссылка в разметке текста")
+	ErrTooManyEntities          = fmt.Errorf("текст не может содержать больше %d элементов форматирования", MaxEntities)
+	ErrInvalidPollQuestion      = fmt.Errorf("вопрос опроса не может быть пустым или длиннее %d символов", PollQuestionMaxLen)
+	ErrInvalidPollOptionsCount  = fmt.Errorf("опрос должен содержать от %d до %d вариантов ответа", PollMinOptions, PollMaxOptions)
+	ErrInvalidPollOption        = errors.New("некорректный вариант ответа")
+	ErrDuplicatePollOption      = errors.New("вариант ответа указан несколько раз")
+	ErrInvalidPollClosesAt      = errors.New("время завершения опроса должно быть в будущем")
+	ErrMessageIsNotPoll         = errors.New("сообщение не является опросом")
+	ErrPollClosed               = errors.New("опрос завершен")
+	ErrNoPollOptionsSelected    = errors.New("не выбран ни один вариант ответа")
+	ErrPollSingleChoice         = errors.New("в опросе можно выбрать только один вариант ответа")
+	ErrVoteNotExists            = errors.New("пользователь не голосовал в опросе")
+	ErrCannotEditPoll           = errors.New("опрос нельзя редактировать")
+	ErrCannotForwardPoll        = errors.New("опрос нельзя переслать")
+	ErrInvalidIntegrationName   = errors.New("некорректное имя интеграции")
+)
@@ -0,0 +1,138 @@
+package messagee
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+const (
+	EventMessageCreated  = "message_created"
+	EventMessageUpdated  = "message_updated"
+	EventMessageDeleted  = "message_deleted"
+	EventThreadUpdated   = "thread_updated"
+	EventReactionAdded   = "reaction_added"
+	EventReactionRemoved = "reaction_removed"
+	EventMessagesExpired = "messages_expired"
+	EventMentioned       = "mentioned"
+	EventPollUpdated     = "poll_updated"
+)
+
+// NewEventMessageCreated описывает событие создания сообщения
+func (m *Message) NewEventMessageCreated(chat chatt.Chat) events.Event {
+	return events.Event{
+		Type:       EventMessageCreated,
+		CreatedIn:  time.Now(),
+		Recipients: chat.ParticipantIDs(),
+		Data: map[string]any{
+			"message": *m,
+		},
+	}
+}
+
+// NewEventMessageUpdated описывает событие изменения сообщения
+func (m *Message) NewEventMessageUpdated(chat chatt.Chat) events.Event {
+	return events.Event{
+		Type:       EventMessageUpdated,
+		CreatedIn:  time.Now(),
+		Recipients: chat.ParticipantIDs(),
+		Data: map[string]any{
+			"message": *m,
+		},
+	}
+}
+
+// NewEventMessageDeleted описывает событие удаления сообщения
+func (m *Message) NewEventMessageDeleted(chat chatt.Chat) events.Event {
+	return events.Event{
+		Type:       EventMessageDeleted,
+		CreatedIn:  time.Now(),
+		Recipients: chat.ParticipantIDs(),
+		Data: map[string]any{
+			"message": *m,
+		},
+	}
+}
+
+// NewEventThreadUpdated описывает событие обновления треда
+func (m *Message) NewEventThreadUpdated(chat chatt.Chat) events.Event {
+	return events.Event{
+		Type:       EventThreadUpdated,
+		CreatedIn:  time.Now(),
+		Recipients: chat.ParticipantIDs(),
+		Data: map[string]any{
+			"message": *m,
+		},
+	}
+}
+
+// NewEventReactionAdded описывает событие добавления реакции
+func (m *Message) NewEventReactionAdded(chat chatt.Chat, reaction Reaction) events.Event {
+	return events.Event{
+		Type:       EventReactionAdded,
+		CreatedIn:  time.Now(),
+		Recipients: chat.ParticipantIDs(),
+		Data: map[string]any{
+			"message":  *m,
+			"reaction": reaction,
+		},
+	}
+}
+
+// NewEventReactionRemoved описывает событие удаления реакции
+func (m *Message) NewEventReactionRemoved(chat chatt.Chat, reaction Reaction) events.Event {
+	return events.Event{
+		Type:       EventReactionRemoved,
+		CreatedIn:  time.Now(),
+		Recipients: chat.ParticipantIDs(),
+		Data: map[string]any{
+			"message":  *m,
+			"reaction": reaction,
+		},
+	}
+}
+
+// NewEventMentioned описывает событие упоминания пользователя в сообщении.
+// Событие получает только упомянутый пользователь
+func (m *Message) NewEventMentioned(userID uuid.UUID) events.Event {
+	return events.Event{
+		Type:       EventMentioned,
+		CreatedIn:  time.Now(),
+		Recipients: []uuid.UUID{userID},
+		Data: map[string]any{
+			"message": *m,
+			"preview": m.Preview(),
+		},
+	}
+}
+
+// NewEventPollUpdated описывает событие изменения итогов опроса.
+// Итоги не отмечают голоса конкретного получателя
+func (m *Message) NewEventPollUpdated(chat chatt.Chat) events.Event {
+	return events.Event{
+		Type:       EventPollUpdated,
+		CreatedIn:  time.Now(),
+		Recipients: chat.ParticipantIDs(),
+		Data: map[string]any{
+			"chat_id":    m.ChatID,
+			"message_id": m.ID,
+			"results":    m.Poll.Results(uuid.Nil),
+		},
+	}
+}
+
+// NewEventMessagesExpired описывает событие удаления сообщений чата по истечении срока хранения
+func NewEventMessagesExpired(chat chatt.Chat, messageIDs []uuid.UUID) events.Event {
+	return events.Event{
+		Type:       EventMessagesExpired,
+		CreatedIn:  time.Now(),
+		Recipients: chat.ParticipantIDs(),
+		Data: map[string]any{
+			"chat_id":     chat.ID,
+			"message_ids": messageIDs,
+		},
+	}
+}
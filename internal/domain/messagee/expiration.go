@@ -0,0 +1,17 @@
+package messagee
+
+import (
+	"time"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+// expiresAtOf возвращает время самоуничтожения сообщения, созданного в чате в момент createdAt.
+// Если в чате не включено самоуничтожение, возвращается нулевое время
+func expiresAtOf(chat chatt.Chat, createdAt time.Time) time.Time {
+	if chat.Retention.SelfDestruct == 0 {
+		return time.Time{}
+	}
+
+	return createdAt.Add(chat.Retention.SelfDestruct)
+}
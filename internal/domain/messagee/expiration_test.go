@@ -0,0 +1,45 @@
+package messagee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+// TestNewMessage_SelfDestruct тестирует время самоуничтожения новых сообщений.
+func TestNewMessage_SelfDestruct(t *testing.T) {
+	t.Run("без самоуничтожения в чате сообщение хранится бессрочно", func(t *testing.T) {
+		chat, err := chatt.NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		message, err := NewMessage(chat, chat.ChiefID, "text", nil)
+		require.NoError(t, err)
+		assert.Zero(t, message.ExpiresAt)
+	})
+
+	t.Run("в чате с самоуничтожением у сообщения будет время самоуничтожения", func(t *testing.T) {
+		chat, err := chatt.NewChat("test chat", uuid.New(), nil)
+		require.NoError(t, err)
+		require.NoError(t, chat.SetRetention(chatt.Retention{SelfDestruct: time.Minute}, nil))
+		message, err := NewMessage(chat, chat.ChiefID, "text", nil)
+		require.NoError(t, err)
+		assert.Equal(t, message.CreatedAt.Add(time.Minute), message.ExpiresAt)
+	})
+
+	t.Run("пересланная копия получает время самоуничтожения целевого чата", func(t *testing.T) {
+		source, err := chatt.NewChat("source", uuid.New(), nil)
+		require.NoError(t, err)
+		target, err := chatt.NewChat("target", source.ChiefID, nil)
+		require.NoError(t, err)
+		require.NoError(t, target.SetRetention(chatt.Retention{SelfDestruct: time.Hour}, nil))
+		original, err := NewMessage(source, source.ChiefID, "text", nil)
+		require.NoError(t, err)
+		copied, err := NewForwardedMessage(target, source.ChiefID, original, nil)
+		require.NoError(t, err)
+		assert.Equal(t, copied.CreatedAt.Add(time.Hour), copied.ExpiresAt)
+	})
+}
@@ -0,0 +1,93 @@
+package messagee
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// Forward представляет собой ссылку на исходное сообщение пересланной копии.
+type Forward struct {
+	MessageID uuid.UUID // ID исходного сообщения
+	ChatID    uuid.UUID // ID чата исходного сообщения
+	AuthorID  uuid.UUID // ID автора исходного сообщения
+	CreatedAt time.Time // Время создания исходного сообщения
+}
+
+// NewForwardedMessage создает в чате target копию сообщения original от имени пользователя subjectID.
+// Копия ссылается на исходное сообщение, а при повторной пересылке - на самый первый источник.
+// Ответы в тредах пересылаются как обычные сообщения
+func NewForwardedMessage(target chatt.Chat, subjectID uuid.UUID, original Message, eventsBuf *events.Buffer) (Message, error) {
+	if err := domain.ValidateID(subjectID); err != nil {
+		return Message{}, errors.Join(err, ErrInvalidAuthorID)
+	}
+
+	// Удаленное сообщение переслать нельзя
+	if original.IsDeleted() {
+		return Message{}, ErrMessageIsDeleted
+	}
+
+	// Вложения принадлежат чату, в который загружены
+	if len(original.AttachmentIDs) > 0 {
+		return Message{}, ErrCannotForwardAttachments
+	}
+
+	// Голоса опроса относятся к участникам исходного чата
+	if original.IsPoll() {
+		return Message{}, ErrCannotForwardPoll
+	}
+
+	// Пересылать сообщения могут только участники чата
+	if !target.HasParticipant(subjectID) {
+		return Message{}, ErrAuthorIsNotMember
+	}
+	if target.IsArchived() {
+		return Message{}, chatt.ErrChatIsArchived
+	}
+	if !target.Can(subjectID, chatt.PermissionSendMessages) {
+		return Message{}, ErrAuthorCannotSend
+	}
+
+	// Сохранить ссылку на первоисточник
+	forwardedFrom := original.ForwardedFrom
+	if !original.IsForwarded() {
+		forwardedFrom = Forward{
+			MessageID: original.ID,
+			ChatID:    original.ChatID,
+			AuthorID:  original.AuthorID,
+			CreatedAt: original.CreatedAt,
+		}
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	message := Message{
+		ID:            uuid.New(),
+		ChatID:        target.ID,
+		AuthorID:      subjectID,
+		Text:          original.Text,
+		Entities:      slices.Clone(original.Entities),
+		CreatedAt:     createdAt,
+		ExpiresAt:     expiresAtOf(target, createdAt),
+		ForwardedFrom: forwardedFrom,
+		Revisions:     []Revision{},
+		Reactions:     []Reaction{},
+		AttachmentIDs: []uuid.UUID{},
+		Mentions:      []uuid.UUID{},
+	}
+
+	// Добавить событие
+	eventsBuf.AddSafety(message.NewEventMessageCreated(target))
+
+	return message, nil
+}
+
+// IsForwarded проверяет, является ли сообщение пересланной копией.
+func (m *Message) IsForwarded() bool {
+	return m.ForwardedFrom.MessageID != uuid.Nil
+}
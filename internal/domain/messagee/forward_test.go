@@ -0,0 +1,114 @@
+package messagee
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestNewForwardedMessage тестирует пересылку сообщения.
+func TestNewForwardedMessage(t *testing.T) {
+	t.Run("пересылать сообщения могут только участники целевого чата", func(t *testing.T) {
+		source := newChat(t)
+		target := newChat(t)
+		original := newMessage(t, source, source.ChiefID)
+		message, err := NewForwardedMessage(target, source.ChiefID, original, nil)
+		assert.ErrorIs(t, err, ErrAuthorIsNotMember)
+		assert.Zero(t, message)
+	})
+
+	t.Run("удаленное сообщение переслать нельзя", func(t *testing.T) {
+		source := newChat(t)
+		target := newChat(t)
+		original := newMessage(t, source, source.ChiefID)
+		require.NoError(t, original.Delete(source, source.ChiefID, nil))
+		message, err := NewForwardedMessage(target, target.ChiefID, original, nil)
+		assert.ErrorIs(t, err, ErrMessageIsDeleted)
+		assert.Zero(t, message)
+	})
+
+	t.Run("сообщение с вложениями переслать нельзя", func(t *testing.T) {
+		source := newChat(t)
+		target := newChat(t)
+		original, err := NewMessageWithAttachments(source, source.ChiefID, "", []attachmentt.Attachment{
+			newAttachment(source, source.ChiefID),
+		}, nil, nil)
+		require.NoError(t, err)
+		message, err := NewForwardedMessage(target, target.ChiefID, original, nil)
+		assert.ErrorIs(t, err, ErrCannotForwardAttachments)
+		assert.Zero(t, message)
+	})
+
+	t.Run("копия ссылается на исходное сообщение, его автора и чат", func(t *testing.T) {
+		source := newChat(t)
+		target := newChat(t)
+		original := newMessage(t, source, source.ChiefID)
+		message, err := NewForwardedMessage(target, target.ChiefID, original, nil)
+		require.NoError(t, err)
+		assert.NotEqual(t, original.ID, message.ID)
+		assert.Equal(t, target.ID, message.ChatID)
+		assert.Equal(t, target.ChiefID, message.AuthorID)
+		assert.Equal(t, original.Text, message.Text)
+		assert.True(t, message.IsForwarded())
+		assert.Equal(t, Forward{
+			MessageID: original.ID,
+			ChatID:    source.ID,
+			AuthorID:  source.ChiefID,
+			CreatedAt: original.CreatedAt,
+		}, message.ForwardedFrom)
+	})
+
+	t.Run("повторная пересылка ссылается на первоисточник", func(t *testing.T) {
+		source := newChat(t)
+		middle := newChat(t)
+		target := newChat(t)
+		original := newMessage(t, source, source.ChiefID)
+		forwarded, err := NewForwardedMessage(middle, middle.ChiefID, original, nil)
+		require.NoError(t, err)
+		message, err := NewForwardedMessage(target, target.ChiefID, forwarded, nil)
+		require.NoError(t, err)
+		assert.Equal(t, forwarded.ForwardedFrom, message.ForwardedFrom)
+		assert.Equal(t, original.ID, message.ForwardedFrom.MessageID)
+	})
+
+	t.Run("ответ в треде пересылается как обычное сообщение", func(t *testing.T) {
+		source := newChat(t)
+		target := newChat(t)
+		parent := newMessage(t, source, source.ChiefID)
+		reply, err := NewReply(source, &parent, source.ChiefID, "reply", nil)
+		require.NoError(t, err)
+		message, err := NewForwardedMessage(target, target.ChiefID, reply, nil)
+		require.NoError(t, err)
+		assert.False(t, message.IsReply())
+	})
+
+	t.Run("событие получат только участники целевого чата", func(t *testing.T) {
+		source := newChat(t)
+		addParticipant(t, &source)
+		target := newChat(t)
+		addParticipant(t, &target)
+		original := newMessage(t, source, source.ChiefID)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		_, err := NewForwardedMessage(target, target.ChiefID, original, eventsBuf)
+		require.NoError(t, err)
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventMessageCreated, eventsBuf.Events()[0].Type)
+		assert.ElementsMatch(t, target.ParticipantIDs(), eventsBuf.Events()[0].Recipients)
+	})
+
+	t.Run("пересылающий должен иметь корректный ID", func(t *testing.T) {
+		source := newChat(t)
+		original := newMessage(t, source, source.ChiefID)
+		message, err := NewForwardedMessage(source, uuid.Nil, original, nil)
+		assert.ErrorIs(t, err, ErrInvalidAuthorID)
+		assert.Zero(t, message)
+	})
+}
@@ -0,0 +1,57 @@
+package messagee
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// NewIntegrationMessage создает в чате сообщение от имени интеграции входящего вебхука.
+// Автором сообщения считается вебхук, поэтому проверка участия в чате не выполняется
+func NewIntegrationMessage(chat chatt.Chat, webhookID uuid.UUID, integrationName, text string, eventsBuf *events.Buffer) (Message, error) {
+	if err := domain.ValidateID(webhookID); err != nil {
+		return Message{}, errors.Join(err, ErrInvalidAuthorID)
+	}
+	if integrationName == "" {
+		return Message{}, ErrInvalidIntegrationName
+	}
+	// В архивный чат сообщения не отправляются
+	if chat.IsArchived() {
+		return Message{}, chatt.ErrChatIsArchived
+	}
+	markup, err := parseContent(text, nil)
+	if err != nil {
+		return Message{}, err
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	message := Message{
+		ID:              uuid.New(),
+		ChatID:          chat.ID,
+		AuthorID:        webhookID,
+		Text:            markup.Text,
+		Entities:        markup.Entities,
+		CreatedAt:       createdAt,
+		ExpiresAt:       expiresAtOf(chat, createdAt),
+		IntegrationName: integrationName,
+		Revisions:       []Revision{},
+		Reactions:       []Reaction{},
+		AttachmentIDs:   []uuid.UUID{},
+		Mentions:        []uuid.UUID{},
+	}
+
+	// Добавить событие
+	eventsBuf.AddSafety(message.NewEventMessageCreated(chat))
+
+	return message, nil
+}
+
+// IsFromIntegration проверяет, отправлено ли сообщение через входящий вебхук.
+func (m *Message) IsFromIntegration() bool {
+	return m.IntegrationName != ""
+}
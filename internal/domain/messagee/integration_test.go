@@ -0,0 +1,58 @@
+package messagee
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestNewIntegrationMessage тестирует создание сообщения от имени интеграции.
+func TestNewIntegrationMessage(t *testing.T) {
+	t.Run("автором является вебхук, не участник чата", func(t *testing.T) {
+		chat := newChat(t)
+		webhookID := uuid.New()
+		eventsBuf := new(events.Buffer)
+		message, err := NewIntegrationMessage(chat, webhookID, "CI", "**build** passed", eventsBuf)
+		require.NoError(t, err)
+		assert.Equal(t, chat.ID, message.ChatID)
+		assert.Equal(t, webhookID, message.AuthorID)
+		assert.Equal(t, "build passed", message.Text)
+		assert.NotEmpty(t, message.Entities)
+		assert.True(t, message.IsFromIntegration())
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventMessageCreated, eventsBuf.Events()[0].Type)
+	})
+
+	t.Run("имя интеграции обязательно", func(t *testing.T) {
+		message, err := NewIntegrationMessage(newChat(t), uuid.New(), "", "text", nil)
+		assert.ErrorIs(t, err, ErrInvalidIntegrationName)
+		assert.Zero(t, message)
+	})
+
+	t.Run("текст проверяется как у обычного сообщения", func(t *testing.T) {
+		message, err := NewIntegrationMessage(newChat(t), uuid.New(), "CI", "", nil)
+		assert.ErrorIs(t, err, ErrTextEmpty)
+		assert.Zero(t, message)
+	})
+
+	t.Run("в архивный чат сообщение не отправляется", func(t *testing.T) {
+		chat := newChat(t)
+		require.NoError(t, chat.Archive(chat.ChiefID, nil))
+		eventsBuf := new(events.Buffer)
+		message, err := NewIntegrationMessage(chat, uuid.New(), "CI", "text", eventsBuf)
+		assert.ErrorIs(t, err, chatt.ErrChatIsArchived)
+		assert.Zero(t, message)
+		assert.Empty(t, eventsBuf.Events())
+	})
+
+	t.Run("без ID вебхука", func(t *testing.T) {
+		message, err := NewIntegrationMessage(newChat(t), uuid.Nil, "CI", "text", nil)
+		assert.ErrorIs(t, err, ErrInvalidAuthorID)
+		assert.Zero(t, message)
+	})
+}
@@ -0,0 +1,362 @@
+package messagee
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Типы сущностей форматирования текста
+const (
+	EntityBold    = "bold"
+	EntityItalic  = "italic"
+	EntityCode    = "code"
+	EntityPre     = "pre"
+	EntityLink    = "link"
+	EntityMention = "mention"
+)
+
+// MaxEntities максимальное количество сущностей форматирования в сообщении.
+const MaxEntities = 100
+
+// LinkURLMaxLen максимальная длина адреса ссылки.
+const LinkURLMaxLen = 2048
+
+// PreviewMaxLen максимальная длина превью сообщения в символах.
+const PreviewMaxLen = 100
+
+// Entity представляет собой фрагмент текста с форматированием.
+// Смещение и длина указываются в символах текста без разметки
+type Entity struct {
+	Type   string // Тип сущности
+	Offset int    // Смещение начала фрагмента
+	Length int    // Длина фрагмента
+	URL    string // Адрес ссылки, только для EntityLink
+}
+
+// Markup представляет собой разобранный текст: текст без разметки и сущности форматирования.
+type Markup struct {
+	Text     string   // Текст без разметки
+	Entities []Entity // Сущности, упорядоченные по смещению, внешние раньше вложенных
+}
+
+// Маркеры разметки
+const (
+	markerBold   = "**"
+	markerItalic = "__"
+	markerCode   = "`"
+	markerPre    = "```"
+)
+
+// escapableRunes символы, которые можно экранировать обратной косой чертой
+const escapableRunes = "\\*_`[]"
+
+// ParseMarkup разбирает текст сообщения в подмножестве markdown:
+//
+//	**жирный**, __курсив__, `код`, ```блок кода```, [текст](https://адрес), @nick
+//
+// Жирный текст, курсив и ссылки могут быть вложены друг в друга, но не могут пересекаться.
+// Внутри кода разметка не разбирается, а упоминания не ищутся.
+// Символы разметки экранируются обратной косой чертой, одиночные * и _ остаются текстом
+func ParseMarkup(text string) (Markup, error) {
+	p := markupParser{src: []rune(text)}
+	if err := p.parse(); err != nil {
+		return Markup{}, err
+	}
+	p.addMentions()
+
+	if len(p.entities) > MaxEntities {
+		return Markup{}, ErrTooManyEntities
+	}
+
+	// Упорядочить сущности: по смещению, внешние раньше вложенных.
+	// При совпадении границ раньше идет сущность, открытая первой
+	slices.SortFunc(p.entities, func(a, b parsedEntity) int {
+		if a.Offset != b.Offset {
+			return a.Offset - b.Offset
+		}
+		if a.Length != b.Length {
+			return b.Length - a.Length
+		}
+		return a.seq - b.seq
+	})
+	entities := make([]Entity, len(p.entities))
+	for i, e := range p.entities {
+		entities[i] = e.Entity
+	}
+
+	return Markup{
+		Text:     string(p.out),
+		Entities: entities,
+	}, nil
+}
+
+// MentionedNicks возвращает ники из сущностей упоминаний в порядке появления без повторов.
+func (m Markup) MentionedNicks() []string {
+	var nicks []string
+	runes := []rune(m.Text)
+	for _, e := range m.Entities {
+		if e.Type != EntityMention {
+			continue
+		}
+		nick := string(runes[e.Offset+1 : e.Offset+e.Length])
+		if !slices.ContainsFunc(nicks, func(n string) bool { return strings.EqualFold(n, nick) }) {
+			nicks = append(nicks, nick)
+		}
+	}
+
+	return nicks
+}
+
+// openEntity незакрытая сущность форматирования
+type openEntity struct {
+	typ    string
+	offset int
+	seq    int // Порядковый номер открытия
+}
+
+// parsedEntity сущность форматирования с порядковым номером открытия
+type parsedEntity struct {
+	Entity
+	seq int
+}
+
+// markupParser разбирает разметку текста
+type markupParser struct {
+	src      []rune
+	pos      int
+	out      []rune
+	open     []openEntity
+	entities []parsedEntity
+	seq      int
+}
+
+// parse переносит текст без разметки в out и собирает сущности
+func (p *markupParser) parse() error {
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		switch {
+		case r == '\\' && p.pos+1 < len(p.src) && strings.ContainsRune(escapableRunes, p.src[p.pos+1]):
+			p.out = append(p.out, p.src[p.pos+1])
+			p.pos += 2
+		case p.hasPrefix(markerPre):
+			if err := p.parseLiteral(markerPre, EntityPre); err != nil {
+				return err
+			}
+		case p.hasPrefix(markerCode):
+			if err := p.parseLiteral(markerCode, EntityCode); err != nil {
+				return err
+			}
+		case p.hasPrefix(markerBold):
+			if err := p.toggle(EntityBold); err != nil {
+				return err
+			}
+			p.pos += len(markerBold)
+		case p.hasPrefix(markerItalic):
+			if err := p.toggle(EntityItalic); err != nil {
+				return err
+			}
+			p.pos += len(markerItalic)
+		case r == '[' && p.isLinkStart():
+			if p.isOpen(EntityLink) {
+				return ErrMarkupNestedLink
+			}
+			p.openEntity(EntityLink)
+			p.pos++
+		case r == ']' && p.isOpen(EntityLink):
+			if err := p.closeLink(); err != nil {
+				return err
+			}
+		default:
+			p.out = append(p.out, r)
+			p.pos++
+		}
+	}
+
+	if len(p.open) > 0 {
+		return ErrMarkupUnclosed
+	}
+
+	return nil
+}
+
+// hasPrefix проверяет, начинается ли остаток текста с маркера
+func (p *markupParser) hasPrefix(marker string) bool {
+	return indexRunes(p.src[p.pos:min(p.pos+len(marker), len(p.src))], []rune(marker)) == 0
+}
+
+// isLinkStart проверяет, открывает ли текущая скобка ссылку вида [текст](адрес).
+// Иначе квадратная скобка остается текстом
+func (p *markupParser) isLinkStart() bool {
+	end := slices.Index(p.src[p.pos:], ']')
+	next := p.pos + end + 1
+
+	return end > 0 && next < len(p.src) && p.src[next] == '('
+}
+
+// isOpen проверяет, открыта ли сущность указанного типа
+func (p *markupParser) isOpen(typ string) bool {
+	return slices.ContainsFunc(p.open, func(e openEntity) bool { return e.typ == typ })
+}
+
+// toggle открывает сущность либо закрывает последнюю открытую сущность того же типа
+func (p *markupParser) toggle(typ string) error {
+	if !p.isOpen(typ) {
+		p.openEntity(typ)
+		return nil
+	}
+
+	// Закрывать можно только последнюю открытую сущность
+	if p.open[len(p.open)-1].typ != typ {
+		return ErrMarkupOverlap
+	}
+
+	return p.closeLast("")
+}
+
+// openEntity открывает сущность в текущей позиции текста без разметки
+func (p *markupParser) openEntity(typ string) {
+	p.open = append(p.open, openEntity{typ: typ, offset: len(p.out), seq: p.nextSeq()})
+}
+
+// closeLast закрывает последнюю открытую сущность
+func (p *markupParser) closeLast(url string) error {
+	last := p.open[len(p.open)-1]
+	p.open = p.open[:len(p.open)-1]
+
+	return p.addEntity(Entity{
+		Type:   last.typ,
+		Offset: last.offset,
+		Length: len(p.out) - last.offset,
+		URL:    url,
+	}, last.seq)
+}
+
+// addEntity добавляет сущность, если она не пустая
+func (p *markupParser) addEntity(e Entity, seq int) error {
+	if strings.TrimSpace(string(p.out[e.Offset:e.Offset+e.Length])) == "" {
+		return ErrMarkupEmptyEntity
+	}
+	p.entities = append(p.entities, parsedEntity{Entity: e, seq: seq})
+
+	return nil
+}
+
+// nextSeq возвращает следующий порядковый номер открытия сущности
+func (p *markupParser) nextSeq() int {
+	p.seq++
+	return p.seq
+}
+
+// parseLiteral переносит содержимое кода без разбора разметки
+func (p *markupParser) parseLiteral(marker, typ string) error {
+	start := p.pos + len(marker)
+	end := indexRunes(p.src[start:], []rune(marker))
+	if end < 0 {
+		return ErrMarkupUnclosed
+	}
+	content := string(p.src[start : start+end])
+	p.pos = start + end + len(marker)
+
+	// Перевод строки сразу после открывающего и перед закрывающим маркером блока не входит в код
+	if typ == EntityPre {
+		content = strings.TrimSuffix(strings.TrimPrefix(content, "\n"), "\n")
+	}
+
+	offset := len(p.out)
+	p.out = append(p.out, []rune(content)...)
+
+	return p.addEntity(Entity{
+		Type:   typ,
+		Offset: offset,
+		Length: len(p.out) - offset,
+	}, p.nextSeq())
+}
+
+// closeLink закрывает ссылку, читая адрес в круглых скобках после текста ссылки
+func (p *markupParser) closeLink() error {
+	if p.open[len(p.open)-1].typ != EntityLink {
+		return ErrMarkupOverlap
+	}
+	if p.pos+1 >= len(p.src) || p.src[p.pos+1] != '(' {
+		return ErrMarkupInvalidLink
+	}
+
+	start := p.pos + 2
+	end := slices.Index(p.src[start:], ')')
+	if end < 0 {
+		return ErrMarkupInvalidLink
+	}
+	link := string(p.src[start : start+end])
+	if err := ValidateLinkURL(link); err != nil {
+		return err
+	}
+	p.pos = start + end + 1
+
+	return p.closeLast(link)
+}
+
+// addMentions добавляет сущности упоминаний вне кода.
+// Упоминания, пересекающие границу форматирования, не учитываются
+func (p *markupParser) addMentions() {
+	for _, m := range findMentions(p.out) {
+		conflicts := slices.ContainsFunc(p.entities, func(e parsedEntity) bool {
+			start, end := e.Offset, e.Offset+e.Length
+			overlaps := m.offset < end && start < m.offset+m.length
+			contains := start <= m.offset && m.offset+m.length <= end
+			return overlaps && (!contains || e.Type == EntityCode || e.Type == EntityPre)
+		})
+		if conflicts {
+			continue
+		}
+		p.entities = append(p.entities, parsedEntity{
+			Entity: Entity{
+				Type:   EntityMention,
+				Offset: m.offset,
+				Length: m.length,
+			},
+			seq: p.nextSeq(),
+		})
+	}
+}
+
+// Preview возвращает однострочное превью текста сообщения без разметки для списков чатов и уведомлений.
+// Длинный текст обрезается до PreviewMaxLen символов
+func (m *Message) Preview() string {
+	if m.IsDeleted() {
+		return ""
+	}
+
+	preview := []rune(strings.Join(strings.Fields(m.Text), " "))
+	if len(preview) > PreviewMaxLen {
+		return strings.TrimSpace(string(preview[:PreviewMaxLen-1])) + "…"
+	}
+
+	return string(preview)
+}
+
+// ValidateLinkURL проверяет адрес ссылки.
+// Допускаются только абсолютные адреса http и https
+func ValidateLinkURL(link string) error {
+	if link == "" || len(link) > LinkURLMaxLen || strings.ContainsAny(link, " \t\n") {
+		return ErrMarkupInvalidLink
+	}
+
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrMarkupInvalidLink
+	}
+
+	return nil
+}
+
+// indexRunes возвращает индекс первого вхождения sub в s либо -1
+func indexRunes(s, sub []rune) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if slices.Equal(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+
+	return -1
+}
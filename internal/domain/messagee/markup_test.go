@@ -0,0 +1,167 @@
+package messagee
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseMarkup тестирует разбор разметки текста.
+func TestParseMarkup(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		plain    string
+		entities []Entity
+	}{
+		{
+			name:     "текст без разметки не изменяется",
+			text:     "2*3 = 6, snake_case и [1]",
+			plain:    "2*3 = 6, snake_case и [1]",
+			entities: []Entity{},
+		},
+		{
+			name:     "жирный текст и курсив",
+			text:     "**жирный** и __курсив__",
+			plain:    "жирный и курсив",
+			entities: []Entity{{Type: EntityBold, Offset: 0, Length: 6}, {Type: EntityItalic, Offset: 9, Length: 6}},
+		},
+		{
+			name:     "вложенное форматирование",
+			text:     "**a __b__ c**",
+			plain:    "a b c",
+			entities: []Entity{{Type: EntityBold, Offset: 0, Length: 5}, {Type: EntityItalic, Offset: 2, Length: 1}},
+		},
+		{
+			name:     "внутри кода разметка не разбирается",
+			text:     "`**x** @alice`",
+			plain:    "**x** @alice",
+			entities: []Entity{{Type: EntityCode, Offset: 0, Length: 12}},
+		},
+		{
+			name:     "переводы строк по краям блока кода отбрасываются",
+			text:     "```\nfmt.Println()\n```",
+			plain:    "fmt.Println()",
+			entities: []Entity{{Type: EntityPre, Offset: 0, Length: 13}},
+		},
+		{
+			name:     "ссылка с форматированием текста",
+			text:     "[**сайт**](https://example.com/a_b)",
+			plain:    "сайт",
+			entities: []Entity{{Type: EntityLink, Offset: 0, Length: 4, URL: "https://example.com/a_b"}, {Type: EntityBold, Offset: 0, Length: 4}},
+		},
+		{
+			name:     "упоминания вне кода",
+			text:     "привет, **@alice**",
+			plain:    "привет, @alice",
+			entities: []Entity{{Type: EntityBold, Offset: 8, Length: 6}, {Type: EntityMention, Offset: 8, Length: 6}},
+		},
+		{
+			name:     "экранированные маркеры остаются текстом",
+			text:     `\*\*не жирный\*\* и \[x\]\(y\) \\`,
+			plain:    `**не жирный** и [x]\(y\) \`,
+			entities: []Entity{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			markup, err := ParseMarkup(tc.text)
+			require.NoError(t, err)
+			assert.Equal(t, tc.plain, markup.Text)
+			assert.Equal(t, tc.entities, markup.Entities)
+		})
+	}
+}
+
+// TestParseMarkup_Errors тестирует отклонение некорректной разметки.
+func TestParseMarkup_Errors(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		err  error
+	}{
+		{name: "незакрытый жирный текст", text: "**текст", err: ErrMarkupUnclosed},
+		{name: "незакрытый код", text: "`код", err: ErrMarkupUnclosed},
+		{name: "незакрытый блок кода", text: "```код`", err: ErrMarkupUnclosed},
+		{name: "пересекающееся форматирование", text: "**a __b** c__", err: ErrMarkupOverlap},
+		{name: "пустое форматирование", text: "** **", err: ErrMarkupEmptyEntity},
+		{name: "пустой код", text: "``", err: ErrMarkupEmptyEntity},
+		{name: "ссылка без протокола", text: "[сайт](example.com)", err: ErrMarkupInvalidLink},
+		{name: "ссылка с недопустимым протоколом", text: "[сайт](javascript:alert(1))", err: ErrMarkupInvalidLink},
+		{name: "вложенная ссылка", text: "[a [b](https://b.com)](https://a.com)", err: ErrMarkupNestedLink},
+		{name: "слишком много элементов форматирования", text: strings.Repeat("**a** ", MaxEntities+1), err: ErrTooManyEntities},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			markup, err := ParseMarkup(tc.text)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Zero(t, markup)
+		})
+	}
+
+	t.Run("ValidateMessageText отклоняет некорректную разметку", func(t *testing.T) {
+		assert.ErrorIs(t, ValidateMessageText("**текст"), ErrMarkupUnclosed)
+	})
+}
+
+// TestMarkup_MentionedNicks тестирует получение упомянутых ников.
+func TestMarkup_MentionedNicks(t *testing.T) {
+	markup, err := ParseMarkup("@bob, `@carl` и @Alice @alice")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob", "Alice"}, markup.MentionedNicks())
+}
+
+// TestNewMessage_Markup тестирует сохранение форматирования в сообщении.
+func TestNewMessage_Markup(t *testing.T) {
+	t.Run("сообщение хранит текст без разметки и форматирование", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessage(chat, chat.ChiefID, "**важно**", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "важно", message.Text)
+		assert.Equal(t, []Entity{{Type: EntityBold, Offset: 0, Length: 5}}, message.Entities)
+	})
+
+	t.Run("сообщение с некорректной разметкой не создается", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessage(chat, chat.ChiefID, "[сайт](ftp://example.com)", nil)
+		assert.ErrorIs(t, err, ErrMarkupInvalidLink)
+		assert.Zero(t, message)
+	})
+
+	t.Run("изменение только форматирования считается изменением текста", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.Edit(chat, chat.ChiefID, "**text**", nil, nil))
+		assert.Equal(t, "text", message.Text)
+		assert.Len(t, message.Entities, 1)
+		assert.ErrorIs(t, message.Edit(chat, chat.ChiefID, "**text**", nil, nil), ErrTextNotChanged)
+	})
+}
+
+// TestMessage_Preview тестирует построение превью сообщения.
+func TestMessage_Preview(t *testing.T) {
+	t.Run("превью не содержит разметки и переводов строк", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessage(chat, chat.ChiefID, "**Заголовок**\n\n```\ncode\n```", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Заголовок code", message.Preview())
+	})
+
+	t.Run("длинный текст обрезается", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessage(chat, chat.ChiefID, strings.Repeat("я", PreviewMaxLen*2), nil)
+		require.NoError(t, err)
+		preview := []rune(message.Preview())
+		assert.Len(t, preview, PreviewMaxLen)
+		assert.Equal(t, '…', preview[len(preview)-1])
+	})
+
+	t.Run("у удаленного сообщения нет превью", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+		assert.Empty(t, message.Preview())
+	})
+}
@@ -0,0 +1,138 @@
+package messagee
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// MaxMentions максимальное количество упоминаний в сообщении.
+const MaxMentions = 50
+
+// mentionSpan упоминание в тексте
+type mentionSpan struct {
+	nick   string
+	offset int // Смещение символа @
+	length int // Длина упоминания вместе с символом @
+}
+
+// findMentions находит все упоминания в тексте, включая повторы
+func findMentions(runes []rune) []mentionSpan {
+	var mentions []mentionSpan
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' || (i > 0 && (isMentionRune(runes[i-1]) || runes[i-1] == '@')) {
+			continue
+		}
+
+		// Прочитать ник после @
+		end := i + 1
+		for end < len(runes) && isMentionRune(runes[end]) {
+			end++
+		}
+		nick := strings.TrimRight(string(runes[i+1:end]), "_")
+		start := i
+		i = end - 1
+
+		if nick == "" || userr.ValidateUserNick(nick) != nil {
+			continue
+		}
+		mentions = append(mentions, mentionSpan{
+			nick:   nick,
+			offset: start,
+			length: len([]rune(nick)) + 1,
+		})
+	}
+
+	return mentions
+}
+
+// ResolveMentions находит в репозитории пользователей, упомянутых в тексте вне кода,
+// и возвращает ID тех из них, кто участвует в чате
+func ResolveMentions(usersRepo userr.Repository, chat chatt.Chat, text string) ([]uuid.UUID, error) {
+	markup, err := ParseMarkup(text)
+	if err != nil {
+		return nil, err
+	}
+	nicks := markup.MentionedNicks()
+	if len(nicks) > MaxMentions {
+		nicks = nicks[:MaxMentions]
+	}
+	if len(nicks) == 0 {
+		return nil, nil
+	}
+
+	users, err := usersRepo.List(userr.Filter{Nicks: nicks})
+	if err != nil {
+		return nil, err
+	}
+
+	return MentionsOf(chat, nicks, users), nil
+}
+
+// MentionsOf возвращает ID участников чата с упомянутыми никами.
+// Ники сопоставляются с пользователями users без учета регистра
+func MentionsOf(chat chatt.Chat, nicks []string, users []userr.User) []uuid.UUID {
+	var mentions []uuid.UUID
+	for _, nick := range nicks {
+		for _, u := range users {
+			if !strings.EqualFold(u.Nick, nick) || !chat.HasParticipant(u.ID) {
+				continue
+			}
+			if !slices.Contains(mentions, u.ID) {
+				mentions = append(mentions, u.ID)
+			}
+		}
+	}
+	if len(mentions) > MaxMentions {
+		mentions = mentions[:MaxMentions]
+	}
+
+	return mentions
+}
+
+// validateMentions проверяет, что упомянуть можно каждого пользователя,
+// и возвращает их ID без повторов
+func validateMentions(chat chatt.Chat, mentions []uuid.UUID) ([]uuid.UUID, error) {
+	if len(mentions) > MaxMentions {
+		return nil, ErrTooManyMentions
+	}
+
+	ids := make([]uuid.UUID, 0, len(mentions))
+	for _, id := range mentions {
+		if !chat.HasParticipant(id) {
+			return nil, ErrMentionedIsNotMember
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// IsMentioned проверяет, упомянут ли пользователь в сообщении.
+func (m *Message) IsMentioned(userID uuid.UUID) bool {
+	return slices.Contains(m.Mentions, userID)
+}
+
+// addMentionedEvents добавляет события упоминания для пользователей,
+// которые не были упомянуты в сообщении ранее. Автор о своих упоминаниях не уведомляется
+func (m *Message) addMentionedEvents(previous []uuid.UUID, eventsBuf *events.Buffer) {
+	for _, userID := range m.Mentions {
+		if userID == m.AuthorID || slices.Contains(previous, userID) {
+			continue
+		}
+		eventsBuf.AddSafety(m.NewEventMentioned(userID))
+	}
+}
+
+// isMentionRune проверяет, может ли символ входить в ник упоминания
+func isMentionRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
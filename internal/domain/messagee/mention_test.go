@@ -0,0 +1,147 @@
+package messagee
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestMentionsOf тестирует сопоставление упомянутых ников с участниками чата.
+func TestMentionsOf(t *testing.T) {
+	t.Run("вернутся только участники чата с упомянутыми никами", func(t *testing.T) {
+		chat := newChat(t)
+		alice := addParticipant(t, &chat)
+		users := []userr.User{
+			{ID: alice.UserID, Nick: "Alice"},
+			{ID: uuid.New(), Nick: "bob"},
+			{ID: chat.ChiefID, Nick: "carl"},
+		}
+		mentions := MentionsOf(chat, []string{"alice", "bob"}, users)
+		assert.Equal(t, []uuid.UUID{alice.UserID}, mentions)
+	})
+
+	t.Run("участники с одинаковым ником упоминаются все", func(t *testing.T) {
+		chat := newChat(t)
+		p1 := addParticipant(t, &chat)
+		p2 := addParticipant(t, &chat)
+		users := []userr.User{
+			{ID: p1.UserID, Nick: "alice"},
+			{ID: p2.UserID, Nick: "alice"},
+		}
+		mentions := MentionsOf(chat, []string{"alice"}, users)
+		assert.Equal(t, []uuid.UUID{p1.UserID, p2.UserID}, mentions)
+	})
+}
+
+// TestNewMessage_Mentions тестирует создание сообщения с упоминаниями.
+func TestNewMessage_Mentions(t *testing.T) {
+	t.Run("упоминания сохраняются в сообщении без повторов", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", nil, []uuid.UUID{participant.UserID, participant.UserID}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{participant.UserID}, message.Mentions)
+		assert.True(t, message.IsMentioned(participant.UserID))
+	})
+
+	t.Run("упомянуть можно только участника чата", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", nil, []uuid.UUID{uuid.New()}, nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrMentionedIsNotMember)
+	})
+
+	t.Run("количество упоминаний ограничено", func(t *testing.T) {
+		chat := newChat(t)
+		mentions := make([]uuid.UUID, MaxMentions+1)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", nil, mentions, nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrTooManyMentions)
+	})
+
+	t.Run("каждый упомянутый участник, кроме автора, получит событие упоминания", func(t *testing.T) {
+		chat := newChat(t)
+		p1 := addParticipant(t, &chat)
+		p2 := addParticipant(t, &chat)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", nil, []uuid.UUID{p1.UserID, chat.ChiefID, p2.UserID}, eventsBuf)
+		require.NoError(t, err)
+
+		// Событие создания сообщения и два события упоминания
+		require.Len(t, eventsBuf.Events(), 3)
+		assert.Equal(t, EventMessageCreated, eventsBuf.Events()[0].Type)
+		for i, userID := range []uuid.UUID{p1.UserID, p2.UserID} {
+			event := eventsBuf.Events()[i+1]
+			assert.Equal(t, EventMentioned, event.Type)
+			assert.Equal(t, []uuid.UUID{userID}, event.Recipients)
+			assert.Equal(t, message, event.Data["message"].(Message))
+		}
+	})
+
+	t.Run("упомянутый в ответе участник получит событие упоминания", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		parent := newMessage(t, chat, chat.ChiefID)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		_, err := NewReplyWithAttachments(chat, &parent, chat.ChiefID, "text", nil, []uuid.UUID{participant.UserID}, eventsBuf)
+		require.NoError(t, err)
+
+		// События создания ответа, обновления треда и упоминания
+		require.Len(t, eventsBuf.Events(), 3)
+		event := eventsBuf.Events()[2]
+		assert.Equal(t, EventMentioned, event.Type)
+		assert.Equal(t, []uuid.UUID{participant.UserID}, event.Recipients)
+	})
+}
+
+// TestMessage_Edit_Mentions тестирует изменение упоминаний при редактировании сообщения.
+func TestMessage_Edit_Mentions(t *testing.T) {
+	t.Run("событие упоминания получат только впервые упомянутые участники", func(t *testing.T) {
+		chat := newChat(t)
+		p1 := addParticipant(t, &chat)
+		p2 := addParticipant(t, &chat)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", nil, []uuid.UUID{p1.UserID}, nil)
+		require.NoError(t, err)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, message.Edit(chat, chat.ChiefID, "new text", []uuid.UUID{p1.UserID, p2.UserID}, eventsBuf))
+		assert.Equal(t, []uuid.UUID{p1.UserID, p2.UserID}, message.Mentions)
+
+		// Событие изменения сообщения и упоминания нового участника
+		require.Len(t, eventsBuf.Events(), 2)
+		assert.Equal(t, EventMessageUpdated, eventsBuf.Events()[0].Type)
+		event := eventsBuf.Events()[1]
+		assert.Equal(t, EventMentioned, event.Type)
+		assert.Equal(t, []uuid.UUID{p2.UserID}, event.Recipients)
+	})
+
+	t.Run("упомянуть можно только участника чата", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		err := message.Edit(chat, chat.ChiefID, "new text", []uuid.UUID{uuid.New()}, nil)
+		assert.ErrorIs(t, err, ErrMentionedIsNotMember)
+		assert.Equal(t, "text", message.Text)
+	})
+
+	t.Run("после удаления сообщения упоминания стираются", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message, err := NewMessageWithAttachments(chat, chat.ChiefID, "text", nil, []uuid.UUID{participant.UserID}, nil)
+		require.NoError(t, err)
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+		assert.Empty(t, message.Mentions)
+	})
+}
@@ -0,0 +1,271 @@
+package messagee
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// Message представляет собой агрегат сообщения.
+type Message struct {
+	ID        uuid.UUID // Уникальный ID сообщения
+	ChatID    uuid.UUID // ID чата, к которому относится сообщение
+	AuthorID  uuid.UUID // ID пользователя, отправившего сообщение
+	Text      string    // Текст сообщения без разметки
+	Entities  []Entity  // Форматирование текста сообщения
+	CreatedAt time.Time // Время создания сообщения
+	EditedAt  time.Time // Время последнего редактирования сообщения
+	DeletedAt time.Time // Время удаления сообщения
+	ExpiresAt time.Time // Время самоуничтожения сообщения
+
+	ParentID    uuid.UUID // ID корневого сообщения треда, если сообщение является ответом
+	ReplyCount  int       // Количество ответов в треде
+	LastReplyAt time.Time // Время последнего ответа в треде
+
+	ForwardedFrom Forward // Исходное сообщение, если сообщение является пересланной копией
+
+	IntegrationName string // Имя интеграции, если сообщение отправлено через входящий вебхук
+
+	Revisions     []Revision  // Предыдущие версии текста сообщения
+	Reactions     []Reaction  // Реакции пользователей на сообщение
+	AttachmentIDs []uuid.UUID // ID вложений сообщения
+	Mentions      []uuid.UUID // ID упомянутых в сообщении пользователей
+
+	Poll *Poll // Опрос, если сообщение является опросом
+}
+
+// NewMessage создает новое сообщение в чате.
+func NewMessage(chat chatt.Chat, authorID uuid.UUID, text string, eventsBuf *events.Buffer) (Message, error) {
+	return NewMessageWithAttachments(chat, authorID, text, nil, nil, eventsBuf)
+}
+
+// NewMessageWithAttachments создает новое сообщение в чате с вложениями и упоминаниями участников.
+// Текст разбирается на текст без разметки и сущности форматирования.
+// Сообщение с вложениями может не содержать текста.
+// Каждый упомянутый участник, кроме автора, получает отдельное событие
+func NewMessageWithAttachments(chat chatt.Chat, authorID uuid.UUID, text string, attachments []attachmentt.Attachment, mentions []uuid.UUID, eventsBuf *events.Buffer) (Message, error) {
+	if err := domain.ValidateID(authorID); err != nil {
+		return Message{}, errors.Join(err, ErrInvalidAuthorID)
+	}
+	markup, err := parseContent(text, attachments)
+	if err != nil {
+		return Message{}, err
+	}
+
+	// Отправлять сообщения могут только участники чата
+	if !chat.HasParticipant(authorID) {
+		return Message{}, ErrAuthorIsNotMember
+	}
+
+	// В архивный чат нельзя отправлять сообщения
+	if chat.IsArchived() {
+		return Message{}, chatt.ErrChatIsArchived
+	}
+
+	// Отправлять сообщения могут только участники с соответствующим правом
+	if !chat.Can(authorID, chatt.PermissionSendMessages) {
+		return Message{}, ErrAuthorCannotSend
+	}
+
+	// Проверить вложения
+	attachmentIDs, err := attachmentIDsOf(chat, authorID, attachments)
+	if err != nil {
+		return Message{}, err
+	}
+
+	// Проверить упоминания
+	if mentions, err = validateMentions(chat, mentions); err != nil {
+		return Message{}, err
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	message := Message{
+		ID:            uuid.New(),
+		ChatID:        chat.ID,
+		AuthorID:      authorID,
+		Text:          markup.Text,
+		Entities:      markup.Entities,
+		CreatedAt:     createdAt,
+		ExpiresAt:     expiresAtOf(chat, createdAt),
+		Revisions:     []Revision{},
+		Reactions:     []Reaction{},
+		AttachmentIDs: attachmentIDs,
+		Mentions:      mentions,
+	}
+
+	// Добавить события
+	eventsBuf.AddSafety(message.NewEventMessageCreated(chat))
+	message.addMentionedEvents(nil, eventsBuf)
+
+	return message, nil
+}
+
+// NewReply создает ответ на сообщение в треде.
+// Корневое сообщение обновляет счетчик ответов и время последнего ответа
+func NewReply(chat chatt.Chat, parent *Message, authorID uuid.UUID, text string, eventsBuf *events.Buffer) (Message, error) {
+	return NewReplyWithAttachments(chat, parent, authorID, text, nil, nil, eventsBuf)
+}
+
+// NewReplyWithAttachments создает ответ на сообщение в треде с вложениями и упоминаниями участников.
+func NewReplyWithAttachments(chat chatt.Chat, parent *Message, authorID uuid.UUID, text string, attachments []attachmentt.Attachment, mentions []uuid.UUID, eventsBuf *events.Buffer) (Message, error) {
+	// Тред можно начать только от существующего корневого сообщения этого чата
+	if parent.ChatID != chat.ID {
+		return Message{}, ErrParentInAnotherChat
+	}
+	if parent.IsReply() {
+		return Message{}, ErrCannotReplyToReply
+	}
+	if parent.IsDeleted() {
+		return Message{}, ErrMessageIsDeleted
+	}
+
+	reply, err := NewMessageWithAttachments(chat, authorID, text, attachments, mentions, nil)
+	if err != nil {
+		return Message{}, err
+	}
+	reply.ParentID = parent.ID
+
+	// Обновить сведения о треде
+	parent.ReplyCount++
+	parent.LastReplyAt = reply.CreatedAt
+
+	// Добавить события
+	eventsBuf.AddSafety(reply.NewEventMessageCreated(chat))
+	eventsBuf.AddSafety(parent.NewEventThreadUpdated(chat))
+	reply.addMentionedEvents(nil, eventsBuf)
+
+	return reply, nil
+}
+
+// RemoveReplies уменьшает счетчик ответов в треде на count безвозвратно удаленных ответов.
+// Время последнего ответа не пересчитывается
+func (m *Message) RemoveReplies(chat chatt.Chat, count int, eventsBuf *events.Buffer) {
+	m.ReplyCount = max(m.ReplyCount-count, 0)
+
+	// Добавить событие
+	eventsBuf.AddSafety(m.NewEventThreadUpdated(chat))
+}
+
+// IsReply проверяет, является ли сообщение ответом в треде.
+func (m *Message) IsReply() bool {
+	return m.ParentID != uuid.Nil
+}
+
+// IsDeleted проверяет, удалено ли сообщение.
+func (m *Message) IsDeleted() bool {
+	return !m.DeletedAt.IsZero()
+}
+
+// Edit изменяет текст и упоминания сообщения, сохраняя предыдущую версию текста в истории.
+// Редактировать сообщение может только его автор.
+// Событие упоминания получают только впервые упомянутые участники
+func (m *Message) Edit(chat chatt.Chat, subjectID uuid.UUID, text string, mentions []uuid.UUID, eventsBuf *events.Buffer) error {
+	if err := ValidateMessageText(text); err != nil {
+		return err
+	}
+	markup, err := ParseMarkup(text)
+	if err != nil {
+		return err
+	}
+
+	// Удаленное сообщение нельзя редактировать
+	if m.IsDeleted() {
+		return ErrMessageIsDeleted
+	}
+
+	// Вопрос опроса не редактируется, чтобы не менять смысл уже отданных голосов
+	if m.IsPoll() {
+		return ErrCannotEditPoll
+	}
+
+	// Проверить, что пользователь является автором и все еще участвует в чате
+	if subjectID != m.AuthorID {
+		return ErrSubjectIsNotAuthor
+	}
+	if !chat.HasParticipant(subjectID) {
+		return ErrAuthorIsNotMember
+	}
+
+	// Сообщения архивного чата не редактируются
+	if chat.IsArchived() {
+		return chatt.ErrChatIsArchived
+	}
+
+	if markup.Text == m.Text && slices.Equal(markup.Entities, m.Entities) {
+		return ErrTextNotChanged
+	}
+
+	// Проверить упоминания
+	mentions, err = validateMentions(chat, mentions)
+	if err != nil {
+		return err
+	}
+
+	// Сохранить текущую версию в историю
+	m.Revisions = append(m.Revisions, Revision{
+		Text:      m.Text,
+		CreatedAt: m.textCreatedAt(),
+	})
+
+	previousMentions := m.Mentions
+	m.Text = markup.Text
+	m.Entities = markup.Entities
+	m.Mentions = mentions
+	m.EditedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	// Добавить события
+	eventsBuf.AddSafety(m.NewEventMessageUpdated(chat))
+	m.addMentionedEvents(previousMentions, eventsBuf)
+
+	return nil
+}
+
+// Delete удаляет сообщение, оставляя вместо него метку удаления.
+// Удалить сообщение может его автор или участник с правом удаления чужих сообщений
+func (m *Message) Delete(chat chatt.Chat, subjectID uuid.UUID, eventsBuf *events.Buffer) error {
+	if m.IsDeleted() {
+		return ErrMessageIsDeleted
+	}
+
+	// Проверить права пользователя на удаление
+	isAuthor := subjectID == m.AuthorID && chat.HasParticipant(subjectID)
+	if !isAuthor && !chat.Can(subjectID, chatt.PermissionDeleteMessages) {
+		return ErrSubjectCannotDelete
+	}
+
+	// Сообщения архивного чата не удаляются, в том числе автором
+	if chat.IsArchived() {
+		return chatt.ErrChatIsArchived
+	}
+
+	// Стереть содержимое сообщения вместе с форматированием, историей, реакциями, вложениями, упоминаниями и опросом
+	m.Text = ""
+	m.Entities = []Entity{}
+	m.Revisions = []Revision{}
+	m.Reactions = []Reaction{}
+	m.AttachmentIDs = []uuid.UUID{}
+	m.Mentions = []uuid.UUID{}
+	m.Poll = nil
+	m.DeletedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	// Добавить событие
+	eventsBuf.AddSafety(m.NewEventMessageDeleted(chat))
+
+	return nil
+}
+
+// textCreatedAt возвращает время появления текущей версии текста
+func (m *Message) textCreatedAt() time.Time {
+	if m.EditedAt.IsZero() {
+		return m.CreatedAt
+	}
+
+	return m.EditedAt
+}
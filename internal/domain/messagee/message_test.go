@@ -0,0 +1,401 @@
+package messagee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestNewMessage тестирует создание сообщения.
+func TestNewMessage(t *testing.T) {
+	t.Run("параметр authorID должен быть валидным UUID", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessage(chat, uuid.Nil, "text", nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrInvalidAuthorID)
+	})
+
+	t.Run("текст должен быть валидным", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessage(chat, chat.ChiefID, " \n\t", nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrTextEmpty)
+	})
+
+	t.Run("автор должен быть участником чата", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewMessage(chat, uuid.New(), "text", nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrAuthorIsNotMember)
+	})
+
+	t.Run("участник только для чтения не может отправлять сообщения", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		require.NoError(t, chat.SetParticipantRole(chat.ChiefID, participant.UserID, chatt.RoleReadOnly, nil))
+		message, err := NewMessage(chat, participant.UserID, "text", nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, ErrAuthorCannotSend)
+	})
+
+	t.Run("в архивный чат нельзя отправлять сообщения", func(t *testing.T) {
+		chat := newChat(t)
+		require.NoError(t, chat.Archive(chat.ChiefID, nil))
+		message, err := NewMessage(chat, chat.ChiefID, "text", nil)
+		assert.Zero(t, message)
+		assert.ErrorIs(t, err, chatt.ErrChatIsArchived)
+	})
+
+	t.Run("новому сообщению присваивается id, другие свойства равны переданным", func(t *testing.T) {
+		chat := newChat(t)
+		now1 := time.Now().UTC().Truncate(time.Microsecond)
+		message, err := NewMessage(chat, chat.ChiefID, "text", nil)
+		now2 := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, err)
+
+		assert.NotZero(t, message.ID)
+		assert.Equal(t, chat.ID, message.ChatID)
+		assert.Equal(t, chat.ChiefID, message.AuthorID)
+		assert.Equal(t, "text", message.Text)
+		// Время создания примерно равно текущему
+		assert.GreaterOrEqual(t, message.CreatedAt, now1)
+		assert.LessOrEqual(t, message.CreatedAt, now2)
+	})
+
+	t.Run("после завершения операции, будут созданы события", func(t *testing.T) {
+		chat := newChat(t)
+		participant, err := chatt.NewParticipant(uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, chat.AddParticipant(participant, nil))
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		message, err := NewMessage(chat, chat.ChiefID, "text", eventsBuf)
+		require.NoError(t, err)
+
+		// Событие Созданного сообщения
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventMessageCreated, event.Type)
+		// Содержит всех участников чата
+		assert.ElementsMatch(t, chat.ParticipantIDs(), event.Recipients)
+		// Содержит данные
+		assert.Equal(t, message, event.Data["message"].(Message))
+	})
+}
+
+// TestNewReply тестирует создание ответа в треде.
+func TestNewReply(t *testing.T) {
+	t.Run("корневое сообщение должно быть в том же чате", func(t *testing.T) {
+		chat := newChat(t)
+		anotherChat := newChat(t)
+		parent := newMessage(t, anotherChat, anotherChat.ChiefID)
+		reply, err := NewReply(chat, &parent, chat.ChiefID, "text", nil)
+		assert.Zero(t, reply)
+		assert.ErrorIs(t, err, ErrParentInAnotherChat)
+	})
+
+	t.Run("нельзя ответить на ответ", func(t *testing.T) {
+		chat := newChat(t)
+		parent := newMessage(t, chat, chat.ChiefID)
+		reply, err := NewReply(chat, &parent, chat.ChiefID, "text", nil)
+		require.NoError(t, err)
+		replyToReply, err := NewReply(chat, &reply, chat.ChiefID, "text", nil)
+		assert.Zero(t, replyToReply)
+		assert.ErrorIs(t, err, ErrCannotReplyToReply)
+	})
+
+	t.Run("нельзя ответить на удаленное сообщение", func(t *testing.T) {
+		chat := newChat(t)
+		parent := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, parent.Delete(chat, chat.ChiefID, nil))
+		reply, err := NewReply(chat, &parent, chat.ChiefID, "text", nil)
+		assert.Zero(t, reply)
+		assert.ErrorIs(t, err, ErrMessageIsDeleted)
+	})
+
+	t.Run("автор должен быть участником чата", func(t *testing.T) {
+		chat := newChat(t)
+		parent := newMessage(t, chat, chat.ChiefID)
+		reply, err := NewReply(chat, &parent, uuid.New(), "text", nil)
+		assert.Zero(t, reply)
+		assert.ErrorIs(t, err, ErrAuthorIsNotMember)
+		assert.Zero(t, parent.ReplyCount)
+	})
+
+	t.Run("корневое сообщение хранит количество и время последнего ответа", func(t *testing.T) {
+		chat := newChat(t)
+		parent := newMessage(t, chat, chat.ChiefID)
+		var lastReply Message
+		for range 3 {
+			reply, err := NewReply(chat, &parent, chat.ChiefID, "text", nil)
+			require.NoError(t, err)
+			assert.Equal(t, parent.ID, reply.ParentID)
+			assert.True(t, reply.IsReply())
+			lastReply = reply
+		}
+
+		assert.False(t, parent.IsReply())
+		assert.Equal(t, 3, parent.ReplyCount)
+		assert.Equal(t, lastReply.CreatedAt, parent.LastReplyAt)
+	})
+
+	t.Run("после завершения операции, будут созданы события", func(t *testing.T) {
+		chat := newChat(t)
+		addParticipant(t, &chat)
+		parent := newMessage(t, chat, chat.ChiefID)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		reply, err := NewReply(chat, &parent, chat.ChiefID, "text", eventsBuf)
+		require.NoError(t, err)
+
+		// События создания ответа и обновления треда
+		require.Len(t, eventsBuf.Events(), 2)
+		assert.Equal(t, EventMessageCreated, eventsBuf.Events()[0].Type)
+		assert.Equal(t, reply, eventsBuf.Events()[0].Data["message"].(Message))
+		assert.Equal(t, EventThreadUpdated, eventsBuf.Events()[1].Type)
+		assert.Equal(t, parent, eventsBuf.Events()[1].Data["message"].(Message))
+		// Получатели только участники чата
+		for _, event := range eventsBuf.Events() {
+			assert.ElementsMatch(t, chat.ParticipantIDs(), event.Recipients)
+		}
+	})
+}
+
+// TestMessage_RemoveReplies тестирует уменьшение счетчика ответов в треде.
+func TestMessage_RemoveReplies(t *testing.T) {
+	t.Run("счетчик ответов уменьшается, но не становится отрицательным", func(t *testing.T) {
+		chat := newChat(t)
+		parent := newMessage(t, chat, chat.ChiefID)
+		for range 3 {
+			_, err := NewReply(chat, &parent, chat.ChiefID, "text", nil)
+			require.NoError(t, err)
+		}
+		parent.RemoveReplies(chat, 2, nil)
+		assert.Equal(t, 1, parent.ReplyCount)
+		parent.RemoveReplies(chat, 2, nil)
+		assert.Zero(t, parent.ReplyCount)
+	})
+
+	t.Run("после завершения операции, будет создано событие обновления треда", func(t *testing.T) {
+		chat := newChat(t)
+		parent := newMessage(t, chat, chat.ChiefID)
+		_, err := NewReply(chat, &parent, chat.ChiefID, "text", nil)
+		require.NoError(t, err)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		parent.RemoveReplies(chat, 1, eventsBuf)
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventThreadUpdated, eventsBuf.Events()[0].Type)
+		assert.Equal(t, parent, eventsBuf.Events()[0].Data["message"].(Message))
+	})
+}
+
+// TestMessage_Edit тестирует редактирование сообщения.
+func TestMessage_Edit(t *testing.T) {
+	t.Run("новый текст должен быть валидным", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		err := message.Edit(chat, chat.ChiefID, "", nil, nil)
+		assert.ErrorIs(t, err, ErrTextEmpty)
+	})
+
+	t.Run("редактировать может только автор", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message := newMessage(t, chat, chat.ChiefID)
+		err := message.Edit(chat, participant.UserID, "new text", nil, nil)
+		assert.ErrorIs(t, err, ErrSubjectIsNotAuthor)
+	})
+
+	t.Run("автор, покинувший чат, не может редактировать", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message := newMessage(t, chat, participant.UserID)
+		require.NoError(t, chat.RemoveParticipant(participant.UserID, nil))
+		err := message.Edit(chat, participant.UserID, "new text", nil, nil)
+		assert.ErrorIs(t, err, ErrAuthorIsNotMember)
+	})
+
+	t.Run("сообщения архивного чата нельзя редактировать", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, chat.Archive(chat.ChiefID, nil))
+		err := message.Edit(chat, chat.ChiefID, "new text", nil, nil)
+		assert.ErrorIs(t, err, chatt.ErrChatIsArchived)
+	})
+
+	t.Run("текст должен отличаться от текущего", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		err := message.Edit(chat, chat.ChiefID, message.Text, nil, nil)
+		assert.ErrorIs(t, err, ErrTextNotChanged)
+	})
+
+	t.Run("удаленное сообщение нельзя редактировать", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+		err := message.Edit(chat, chat.ChiefID, "new text", nil, nil)
+		assert.ErrorIs(t, err, ErrMessageIsDeleted)
+	})
+
+	t.Run("предыдущие версии текста сохраняются в истории", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		originalText := message.Text
+
+		require.NoError(t, message.Edit(chat, chat.ChiefID, "second", nil, nil))
+		firstEditedAt := message.EditedAt
+		require.NoError(t, message.Edit(chat, chat.ChiefID, "third", nil, nil))
+
+		assert.Equal(t, "third", message.Text)
+		assert.NotZero(t, message.EditedAt)
+		require.Len(t, message.Revisions, 2)
+		assert.Equal(t, Revision{Text: originalText, CreatedAt: message.CreatedAt}, message.Revisions[0])
+		assert.Equal(t, Revision{Text: "second", CreatedAt: firstEditedAt}, message.Revisions[1])
+	})
+
+	t.Run("после завершения операции, будут созданы события", func(t *testing.T) {
+		chat := newChat(t)
+		addParticipant(t, &chat)
+		message := newMessage(t, chat, chat.ChiefID)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, message.Edit(chat, chat.ChiefID, "new text", nil, eventsBuf))
+
+		// Событие изменения сообщения
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventMessageUpdated, event.Type)
+		assert.ElementsMatch(t, chat.ParticipantIDs(), event.Recipients)
+		assert.Equal(t, message, event.Data["message"].(Message))
+	})
+}
+
+// TestMessage_Delete тестирует удаление сообщения.
+func TestMessage_Delete(t *testing.T) {
+	t.Run("участник не может удалить чужое сообщение", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message := newMessage(t, chat, chat.ChiefID)
+		err := message.Delete(chat, participant.UserID, nil)
+		assert.ErrorIs(t, err, ErrSubjectCannotDelete)
+		assert.False(t, message.IsDeleted())
+	})
+
+	t.Run("автор может удалить свое сообщение", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message := newMessage(t, chat, participant.UserID)
+		require.NoError(t, message.Delete(chat, participant.UserID, nil))
+		assert.True(t, message.IsDeleted())
+	})
+
+	t.Run("модератор может удалить чужое сообщение", func(t *testing.T) {
+		chat := newChat(t)
+		moderator := addParticipant(t, &chat)
+		require.NoError(t, chat.SetParticipantRole(chat.ChiefID, moderator.UserID, chatt.RoleModerator, nil))
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.Delete(chat, moderator.UserID, nil))
+		assert.True(t, message.IsDeleted())
+	})
+
+	t.Run("главный администратор может удалить любое сообщение", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message := newMessage(t, chat, participant.UserID)
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+		assert.True(t, message.IsDeleted())
+	})
+
+	t.Run("в архивном чате автор не может удалить свое сообщение", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message := newMessage(t, chat, participant.UserID)
+		require.NoError(t, chat.Archive(chat.ChiefID, nil))
+		err := message.Delete(chat, participant.UserID, nil)
+		assert.ErrorIs(t, err, chatt.ErrChatIsArchived)
+		assert.False(t, message.IsDeleted())
+	})
+
+	t.Run("нельзя удалить сообщение повторно", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+		err := message.Delete(chat, chat.ChiefID, nil)
+		assert.ErrorIs(t, err, ErrMessageIsDeleted)
+	})
+
+	t.Run("после удаления текст, история и реакции стираются", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.Edit(chat, chat.ChiefID, "new text", nil, nil))
+		require.NoError(t, message.AddReaction(chat, newReaction(t, chat.ChiefID, "👍"), nil))
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+
+		assert.Empty(t, message.Text)
+		assert.Empty(t, message.Revisions)
+		assert.Empty(t, message.Reactions)
+		assert.NotZero(t, message.DeletedAt)
+	})
+
+	t.Run("после завершения операции, будут созданы события", func(t *testing.T) {
+		chat := newChat(t)
+		addParticipant(t, &chat)
+		message := newMessage(t, chat, chat.ChiefID)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, message.Delete(chat, chat.ChiefID, eventsBuf))
+
+		// Событие удаления сообщения
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventMessageDeleted, event.Type)
+		assert.ElementsMatch(t, chat.ParticipantIDs(), event.Recipients)
+		assert.Equal(t, message, event.Data["message"].(Message))
+	})
+}
+
+// newChat создает чат для тестов
+func newChat(t *testing.T) chatt.Chat {
+	t.Helper()
+	chat, err := chatt.NewChat("test chat", uuid.New(), nil)
+	require.NoError(t, err)
+
+	return chat
+}
+
+// newMessage создает сообщение для тестов
+func newMessage(t *testing.T, chat chatt.Chat, authorID uuid.UUID) Message {
+	t.Helper()
+	message, err := NewMessage(chat, authorID, "text", nil)
+	require.NoError(t, err)
+
+	return message
+}
+
+// addParticipant добавляет случайного участника в чат
+func addParticipant(t *testing.T, chat *chatt.Chat) chatt.Participant {
+	t.Helper()
+	participant, err := chatt.NewParticipant(uuid.New())
+	require.NoError(t, err)
+	require.NoError(t, chat.AddParticipant(participant, nil))
+
+	return participant
+}
@@ -0,0 +1,298 @@
+package messagee
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// Ограничения опроса
+const (
+	PollQuestionMaxLen = 300 // Максимальная длина вопроса
+	PollOptionMaxLen   = 100 // Максимальная длина варианта ответа
+	PollMinOptions     = 2   // Минимальное количество вариантов ответа
+	PollMaxOptions     = 10  // Максимальное количество вариантов ответа
+)
+
+// Poll представляет собой опрос, отправленный в чат сообщением.
+type Poll struct {
+	Question       string    // Вопрос
+	Options        []string  // Варианты ответа
+	MultipleChoice bool      // Можно ли выбрать несколько вариантов
+	Anonymous      bool      // Скрывать ли, кто за что проголосовал
+	ClosesAt       time.Time // Время завершения опроса, нулевое если опрос бессрочный
+	Votes          []Vote    // Голоса участников
+}
+
+// Vote представляет собой голос пользователя за один из вариантов ответа.
+type Vote struct {
+	UserID    uuid.UUID // Проголосовавший пользователь
+	Option    int       // Индекс выбранного варианта
+	CreatedAt time.Time // Время голосования
+}
+
+// PollResults представляет собой итоги опроса.
+type PollResults struct {
+	Options     []PollOptionResult // Итоги по каждому варианту в исходном порядке
+	TotalVoters int                // Количество проголосовавших пользователей
+	Closed      bool               // Завершен ли опрос
+}
+
+// PollOptionResult представляет собой итоги по варианту ответа.
+type PollOptionResult struct {
+	Text     string      // Текст варианта
+	Count    int         // Количество голосов
+	Voted    bool        // Голосовал ли за вариант запрашивающий пользователь
+	VoterIDs []uuid.UUID // Проголосовавшие пользователи, не заполняется в анонимном опросе
+}
+
+// NewPoll создает опрос.
+// Вопрос и варианты ответа обрезаются от пробелов по краям
+func NewPoll(question string, options []string, multipleChoice, anonymous bool, closesAt time.Time) (Poll, error) {
+	question = strings.TrimSpace(question)
+	if question == "" || len([]rune(question)) > PollQuestionMaxLen {
+		return Poll{}, ErrInvalidPollQuestion
+	}
+
+	if len(options) < PollMinOptions || len(options) > PollMaxOptions {
+		return Poll{}, ErrInvalidPollOptionsCount
+	}
+	trimmed := make([]string, len(options))
+	for i, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || len([]rune(option)) > PollOptionMaxLen {
+			return Poll{}, ErrInvalidPollOption
+		}
+		if slices.ContainsFunc(trimmed[:i], func(o string) bool { return strings.EqualFold(o, option) }) {
+			return Poll{}, ErrDuplicatePollOption
+		}
+		trimmed[i] = option
+	}
+
+	if !closesAt.IsZero() && !closesAt.After(time.Now()) {
+		return Poll{}, ErrInvalidPollClosesAt
+	}
+
+	return Poll{
+		Question:       question,
+		Options:        trimmed,
+		MultipleChoice: multipleChoice,
+		Anonymous:      anonymous,
+		ClosesAt:       closesAt.UTC().Truncate(time.Microsecond),
+		Votes:          []Vote{},
+	}, nil
+}
+
+// NewPollMessage создает в чате сообщение с опросом.
+// Текстом сообщения становится вопрос опроса
+func NewPollMessage(chat chatt.Chat, authorID uuid.UUID, poll Poll, eventsBuf *events.Buffer) (Message, error) {
+	if err := domain.ValidateID(authorID); err != nil {
+		return Message{}, errors.Join(err, ErrInvalidAuthorID)
+	}
+
+	// Отправлять опросы могут только участники чата
+	if !chat.HasParticipant(authorID) {
+		return Message{}, ErrAuthorIsNotMember
+	}
+
+	// В архивный чат нельзя отправлять сообщения
+	if chat.IsArchived() {
+		return Message{}, chatt.ErrChatIsArchived
+	}
+
+	// Отправлять сообщения могут только участники с соответствующим правом
+	if !chat.Can(authorID, chatt.PermissionSendMessages) {
+		return Message{}, ErrAuthorCannotSend
+	}
+
+	poll.Votes = []Vote{}
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	message := Message{
+		ID:            uuid.New(),
+		ChatID:        chat.ID,
+		AuthorID:      authorID,
+		Text:          poll.Question,
+		Entities:      []Entity{},
+		CreatedAt:     createdAt,
+		ExpiresAt:     expiresAtOf(chat, createdAt),
+		Poll:          &poll,
+		Revisions:     []Revision{},
+		Reactions:     []Reaction{},
+		AttachmentIDs: []uuid.UUID{},
+		Mentions:      []uuid.UUID{},
+	}
+
+	// Добавить событие
+	eventsBuf.AddSafety(message.NewEventMessageCreated(chat))
+
+	return message, nil
+}
+
+// IsPoll проверяет, является ли сообщение опросом.
+func (m *Message) IsPoll() bool {
+	return m.Poll != nil
+}
+
+// IsClosed проверяет, завершен ли опрос к моменту now.
+func (p *Poll) IsClosed(now time.Time) bool {
+	return !p.ClosesAt.IsZero() && !now.Before(p.ClosesAt)
+}
+
+// Vote заменяет голоса пользователя в опросе выбранными вариантами.
+// Голосовать могут только участники чата до завершения опроса
+func (m *Message) Vote(chat chatt.Chat, userID uuid.UUID, options []int, eventsBuf *events.Buffer) error {
+	if err := m.checkPollIsOpen(chat, userID); err != nil {
+		return err
+	}
+
+	// Проверить выбранные варианты
+	if len(options) == 0 {
+		return ErrNoPollOptionsSelected
+	}
+	if len(options) > 1 && !m.Poll.MultipleChoice {
+		return ErrPollSingleChoice
+	}
+	for i, option := range options {
+		if option < 0 || option >= len(m.Poll.Options) {
+			return ErrInvalidPollOption
+		}
+		if slices.Contains(options[:i], option) {
+			return ErrDuplicatePollOption
+		}
+	}
+
+	// Заменить прежние голоса пользователя
+	m.Poll.Votes = slices.DeleteFunc(m.Poll.Votes, func(v Vote) bool {
+		return v.UserID == userID
+	})
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	for _, option := range options {
+		m.Poll.Votes = append(m.Poll.Votes, Vote{
+			UserID:    userID,
+			Option:    option,
+			CreatedAt: createdAt,
+		})
+	}
+
+	// Добавить событие
+	eventsBuf.AddSafety(m.NewEventPollUpdated(chat))
+
+	return nil
+}
+
+// RetractVote отзывает все голоса пользователя в опросе.
+// Отозвать голос можно только до завершения опроса
+func (m *Message) RetractVote(chat chatt.Chat, userID uuid.UUID, eventsBuf *events.Buffer) error {
+	if err := m.checkPollIsOpen(chat, userID); err != nil {
+		return err
+	}
+
+	// Удалить голоса пользователя
+	votesCount := len(m.Poll.Votes)
+	m.Poll.Votes = slices.DeleteFunc(m.Poll.Votes, func(v Vote) bool {
+		return v.UserID == userID
+	})
+	if len(m.Poll.Votes) == votesCount {
+		return ErrVoteNotExists
+	}
+
+	// Добавить событие
+	eventsBuf.AddSafety(m.NewEventPollUpdated(chat))
+
+	return nil
+}
+
+// checkPollIsOpen проверяет, что пользователь может изменить свой голос в опросе
+func (m *Message) checkPollIsOpen(chat chatt.Chat, userID uuid.UUID) error {
+	if !m.IsPoll() {
+		return ErrMessageIsNotPoll
+	}
+	if m.IsDeleted() {
+		return ErrMessageIsDeleted
+	}
+	if !chat.HasParticipant(userID) {
+		return ErrUserIsNotMember
+	}
+	if chat.IsArchived() {
+		return chatt.ErrChatIsArchived
+	}
+	if m.Poll.IsClosed(time.Now()) {
+		return ErrPollClosed
+	}
+
+	return nil
+}
+
+// Results возвращает итоги опроса.
+// Voted отмечает варианты, выбранные пользователем viewerID
+func (p *Poll) Results(viewerID uuid.UUID) PollResults {
+	results := PollResults{
+		Options: make([]PollOptionResult, len(p.Options)),
+		Closed:  p.IsClosed(time.Now()),
+	}
+	for i, option := range p.Options {
+		results.Options[i] = PollOptionResult{Text: option}
+		if !p.Anonymous {
+			results.Options[i].VoterIDs = []uuid.UUID{}
+		}
+	}
+
+	var voters []uuid.UUID
+	for _, v := range p.Votes {
+		result := &results.Options[v.Option]
+		result.Count++
+		if v.UserID == viewerID {
+			result.Voted = true
+		}
+		if !p.Anonymous {
+			result.VoterIDs = append(result.VoterIDs, v.UserID)
+		}
+		if !slices.Contains(voters, v.UserID) {
+			voters = append(voters, v.UserID)
+		}
+	}
+	results.TotalVoters = len(voters)
+
+	return results
+}
+
+// PollResultsOf возвращает итоги опросов из списка сообщений.
+// Ключ карты это ID сообщения с опросом
+func PollResultsOf(messages []Message, viewerID uuid.UUID) map[uuid.UUID]PollResults {
+	results := make(map[uuid.UUID]PollResults)
+	for _, m := range messages {
+		if m.IsPoll() {
+			results[m.ID] = m.Poll.Results(viewerID)
+		}
+	}
+
+	return results
+}
+
+// MarshalJSON сериализует опрос вместе с итогами вместо списка голосов,
+// чтобы не раскрывать голоса анонимного опроса
+func (p Poll) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Question       string
+		Options        []string
+		MultipleChoice bool
+		Anonymous      bool
+		ClosesAt       time.Time
+		Results        PollResults
+	}{
+		Question:       p.Question,
+		Options:        p.Options,
+		MultipleChoice: p.MultipleChoice,
+		Anonymous:      p.Anonymous,
+		ClosesAt:       p.ClosesAt,
+		Results:        p.Results(uuid.Nil),
+	})
+}
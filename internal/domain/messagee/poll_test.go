@@ -0,0 +1,256 @@
+package messagee
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestNewPoll тестирует создание опроса.
+func TestNewPoll(t *testing.T) {
+	t.Run("вопрос и варианты обрезаются от пробелов", func(t *testing.T) {
+		poll, err := NewPoll(" Куда идем? ", []string{" кино", "театр "}, true, true, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, "Куда идем?", poll.Question)
+		assert.Equal(t, []string{"кино", "театр"}, poll.Options)
+		assert.True(t, poll.MultipleChoice)
+		assert.True(t, poll.Anonymous)
+		assert.Zero(t, poll.ClosesAt)
+		assert.Empty(t, poll.Votes)
+	})
+
+	testCases := []struct {
+		name     string
+		question string
+		options  []string
+		closesAt time.Time
+		err      error
+	}{
+		{name: "пустой вопрос", question: " ", options: []string{"a", "b"}, err: ErrInvalidPollQuestion},
+		{name: "слишком длинный вопрос", question: strings.Repeat("я", PollQuestionMaxLen+1), options: []string{"a", "b"}, err: ErrInvalidPollQuestion},
+		{name: "один вариант", question: "q", options: []string{"a"}, err: ErrInvalidPollOptionsCount},
+		{name: "слишком много вариантов", question: "q", options: make([]string, PollMaxOptions+1), err: ErrInvalidPollOptionsCount},
+		{name: "пустой вариант", question: "q", options: []string{"a", " "}, err: ErrInvalidPollOption},
+		{name: "слишком длинный вариант", question: "q", options: []string{"a", strings.Repeat("я", PollOptionMaxLen+1)}, err: ErrInvalidPollOption},
+		{name: "повтор варианта без учета регистра", question: "q", options: []string{"Да", "да"}, err: ErrDuplicatePollOption},
+		{name: "время завершения в прошлом", question: "q", options: []string{"a", "b"}, closesAt: time.Now().Add(-time.Minute), err: ErrInvalidPollClosesAt},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poll, err := NewPoll(tc.question, tc.options, false, false, tc.closesAt)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Zero(t, poll)
+		})
+	}
+}
+
+// TestNewPollMessage тестирует создание сообщения с опросом.
+func TestNewPollMessage(t *testing.T) {
+	t.Run("текстом сообщения становится вопрос", func(t *testing.T) {
+		chat := newChat(t)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		message, err := NewPollMessage(chat, chat.ChiefID, newPoll(t, false, false), eventsBuf)
+		require.NoError(t, err)
+		assert.True(t, message.IsPoll())
+		assert.Equal(t, "question", message.Text)
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventMessageCreated, eventsBuf.Events()[0].Type)
+	})
+
+	t.Run("создать опрос может только участник чата", func(t *testing.T) {
+		chat := newChat(t)
+		message, err := NewPollMessage(chat, uuid.New(), newPoll(t, false, false), nil)
+		assert.ErrorIs(t, err, ErrAuthorIsNotMember)
+		assert.Zero(t, message)
+	})
+
+	t.Run("опрос нельзя редактировать и пересылать", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, false, false)
+		assert.ErrorIs(t, message.Edit(chat, chat.ChiefID, "new", nil, nil), ErrCannotEditPoll)
+		_, err := NewForwardedMessage(chat, chat.ChiefID, message, nil)
+		assert.ErrorIs(t, err, ErrCannotForwardPoll)
+	})
+
+	t.Run("после удаления опрос стирается", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, false, false)
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+		assert.False(t, message.IsPoll())
+	})
+}
+
+// TestMessage_Vote тестирует голосование в опросе.
+func TestMessage_Vote(t *testing.T) {
+	t.Run("повторный голос заменяет прежний", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, false, false)
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, message.Vote(chat, chat.ChiefID, []int{0}, eventsBuf))
+		require.NoError(t, message.Vote(chat, chat.ChiefID, []int{1}, eventsBuf))
+		require.Len(t, message.Poll.Votes, 1)
+		assert.Equal(t, 1, message.Poll.Votes[0].Option)
+
+		require.Len(t, eventsBuf.Events(), 2)
+		event := eventsBuf.Events()[1]
+		assert.Equal(t, EventPollUpdated, event.Type)
+		assert.Equal(t, chat.ParticipantIDs(), event.Recipients)
+		assert.Equal(t, 1, event.Data["results"].(PollResults).Options[1].Count)
+	})
+
+	t.Run("в опросе с несколькими вариантами можно выбрать несколько", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, true, false)
+		require.NoError(t, message.Vote(chat, chat.ChiefID, []int{0, 2}, nil))
+		assert.Len(t, message.Poll.Votes, 2)
+	})
+
+	testCases := []struct {
+		name    string
+		options []int
+		err     error
+	}{
+		{name: "не выбран ни один вариант", options: nil, err: ErrNoPollOptionsSelected},
+		{name: "несколько вариантов в опросе с одним ответом", options: []int{0, 1}, err: ErrPollSingleChoice},
+		{name: "несуществующий вариант", options: []int{3}, err: ErrInvalidPollOption},
+		{name: "отрицательный вариант", options: []int{-1}, err: ErrInvalidPollOption},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chat := newChat(t)
+			message := newPollMessage(t, chat, false, false)
+			assert.ErrorIs(t, message.Vote(chat, chat.ChiefID, tc.options, nil), tc.err)
+			assert.Empty(t, message.Poll.Votes)
+		})
+	}
+
+	t.Run("повтор варианта", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, true, false)
+		assert.ErrorIs(t, message.Vote(chat, chat.ChiefID, []int{1, 1}, nil), ErrDuplicatePollOption)
+	})
+
+	t.Run("голосовать может только участник чата", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, false, false)
+		assert.ErrorIs(t, message.Vote(chat, uuid.New(), []int{0}, nil), ErrUserIsNotMember)
+	})
+
+	t.Run("в завершенном опросе голосовать нельзя", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, false, false)
+		message.Poll.ClosesAt = time.Now().Add(-time.Minute)
+		assert.ErrorIs(t, message.Vote(chat, chat.ChiefID, []int{0}, nil), ErrPollClosed)
+	})
+
+	t.Run("обычное сообщение не является опросом", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		assert.ErrorIs(t, message.Vote(chat, chat.ChiefID, []int{0}, nil), ErrMessageIsNotPoll)
+	})
+}
+
+// TestMessage_RetractVote тестирует отзыв голоса в опросе.
+func TestMessage_RetractVote(t *testing.T) {
+	t.Run("отзываются все голоса пользователя", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message := newPollMessage(t, chat, true, false)
+		require.NoError(t, message.Vote(chat, chat.ChiefID, []int{0, 1}, nil))
+		require.NoError(t, message.Vote(chat, participant.UserID, []int{2}, nil))
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, message.RetractVote(chat, chat.ChiefID, eventsBuf))
+		require.Len(t, message.Poll.Votes, 1)
+		assert.Equal(t, participant.UserID, message.Poll.Votes[0].UserID)
+		require.Len(t, eventsBuf.Events(), 1)
+		assert.Equal(t, EventPollUpdated, eventsBuf.Events()[0].Type)
+	})
+
+	t.Run("нельзя отозвать несуществующий голос", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, false, false)
+		assert.ErrorIs(t, message.RetractVote(chat, chat.ChiefID, nil), ErrVoteNotExists)
+	})
+
+	t.Run("в завершенном опросе голос отозвать нельзя", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, false, false)
+		require.NoError(t, message.Vote(chat, chat.ChiefID, []int{0}, nil))
+		message.Poll.ClosesAt = time.Now().Add(-time.Minute)
+		assert.ErrorIs(t, message.RetractVote(chat, chat.ChiefID, nil), ErrPollClosed)
+	})
+}
+
+// TestPoll_Results тестирует подсчет итогов опроса.
+func TestPoll_Results(t *testing.T) {
+	t.Run("итоги содержат голоса и проголосовавших", func(t *testing.T) {
+		chat := newChat(t)
+		participant := addParticipant(t, &chat)
+		message := newPollMessage(t, chat, true, false)
+		require.NoError(t, message.Vote(chat, chat.ChiefID, []int{0, 1}, nil))
+		require.NoError(t, message.Vote(chat, participant.UserID, []int{1}, nil))
+
+		results := message.Poll.Results(participant.UserID)
+		assert.Equal(t, 2, results.TotalVoters)
+		assert.False(t, results.Closed)
+		assert.Equal(t, []PollOptionResult{
+			{Text: "a", Count: 1, VoterIDs: []uuid.UUID{chat.ChiefID}},
+			{Text: "b", Count: 2, Voted: true, VoterIDs: []uuid.UUID{chat.ChiefID, participant.UserID}},
+			{Text: "c", VoterIDs: []uuid.UUID{}},
+		}, results.Options)
+	})
+
+	t.Run("анонимный опрос не раскрывает проголосовавших", func(t *testing.T) {
+		chat := newChat(t)
+		message := newPollMessage(t, chat, false, true)
+		require.NoError(t, message.Vote(chat, chat.ChiefID, []int{0}, nil))
+
+		results := message.Poll.Results(chat.ChiefID)
+		assert.Equal(t, PollOptionResult{Text: "a", Count: 1, Voted: true}, results.Options[0])
+
+		data, err := json.Marshal(message.Poll)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), chat.ChiefID.String())
+	})
+
+	t.Run("итоги собираются только для опросов", func(t *testing.T) {
+		chat := newChat(t)
+		poll := newPollMessage(t, chat, false, false)
+		results := PollResultsOf([]Message{newMessage(t, chat, chat.ChiefID), poll}, chat.ChiefID)
+		assert.Len(t, results, 1)
+		assert.Contains(t, results, poll.ID)
+	})
+}
+
+// newPoll создает опрос с вариантами a, b и c
+func newPoll(t *testing.T, multipleChoice, anonymous bool) Poll {
+	poll, err := NewPoll("question", []string{"a", "b", "c"}, multipleChoice, anonymous, time.Time{})
+	require.NoError(t, err)
+
+	return poll
+}
+
+// newPollMessage создает сообщение с опросом от главного администратора чата
+func newPollMessage(t *testing.T, chat chatt.Chat, multipleChoice, anonymous bool) Message {
+	message, err := NewPollMessage(chat, chat.ChiefID, newPoll(t, multipleChoice, anonymous), nil)
+	require.NoError(t, err)
+
+	return message
+}
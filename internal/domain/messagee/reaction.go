@@ -0,0 +1,138 @@
+package messagee
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// Reaction представляет собой реакцию пользователя на сообщение.
+type Reaction struct {
+	UserID    uuid.UUID // Пользователь, поставивший реакцию
+	Emoji     string    // Эмодзи реакции
+	CreatedAt time.Time // Время добавления реакции
+}
+
+// NewReaction создает новую реакцию.
+func NewReaction(userID uuid.UUID, emoji string) (Reaction, error) {
+	if err := domain.ValidateID(userID); err != nil {
+		return Reaction{}, errors.Join(err, ErrInvalidUserID)
+	}
+	if err := ValidateEmoji(emoji); err != nil {
+		return Reaction{}, err
+	}
+
+	return Reaction{
+		UserID:    userID,
+		Emoji:     emoji,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}, nil
+}
+
+// ReactionSummary представляет собой сводку реакций с одним эмодзи.
+type ReactionSummary struct {
+	Emoji   string // Эмодзи реакции
+	Count   int    // Количество пользователей, поставивших реакцию
+	Reacted bool   // Поставил ли реакцию запрашивающий пользователь
+}
+
+// HasReaction проверяет, поставил ли пользователь реакцию с эмодзи.
+func (m *Message) HasReaction(userID uuid.UUID, emoji string) bool {
+	return slices.ContainsFunc(m.Reactions, func(r Reaction) bool {
+		return r.UserID == userID && r.Emoji == emoji
+	})
+}
+
+// AddReaction добавляет реакцию на сообщение.
+// Ставить реакции могут только участники чата
+func (m *Message) AddReaction(chat chatt.Chat, reaction Reaction, eventsBuf *events.Buffer) error {
+	if m.IsDeleted() {
+		return ErrMessageIsDeleted
+	}
+
+	// Проверить является ли пользователь участником чата
+	if !chat.HasParticipant(reaction.UserID) {
+		return ErrUserIsNotMember
+	}
+
+	// В архивном чате реакции не меняются
+	if chat.IsArchived() {
+		return chatt.ErrChatIsArchived
+	}
+
+	// Пользователь может поставить один эмодзи только один раз
+	if m.HasReaction(reaction.UserID, reaction.Emoji) {
+		return ErrReactionExists
+	}
+
+	m.Reactions = append(m.Reactions, reaction)
+
+	// Добавить событие
+	eventsBuf.AddSafety(m.NewEventReactionAdded(chat, reaction))
+
+	return nil
+}
+
+// RemoveReaction удаляет реакцию пользователя с сообщения.
+func (m *Message) RemoveReaction(chat chatt.Chat, userID uuid.UUID, emoji string, eventsBuf *events.Buffer) error {
+	// В архивном чате реакции не меняются
+	if chat.IsArchived() {
+		return chatt.ErrChatIsArchived
+	}
+
+	// Найти индекс реакции
+	i := slices.IndexFunc(m.Reactions, func(r Reaction) bool {
+		return r.UserID == userID && r.Emoji == emoji
+	})
+	if i == -1 {
+		return ErrReactionNotExists
+	}
+
+	removedReaction := m.Reactions[i]
+
+	// Удалить реакцию из списка
+	m.Reactions = slices.Delete(m.Reactions, i, i+1)
+
+	// Добавить событие
+	eventsBuf.AddSafety(m.NewEventReactionRemoved(chat, removedReaction))
+
+	return nil
+}
+
+// ReactionSummaries возвращает сводку реакций на сообщение в порядке их первого появления.
+// Reacted отмечает реакции, поставленные пользователем subjectID
+func (m *Message) ReactionSummaries(subjectID uuid.UUID) []ReactionSummary {
+	summaries := make([]ReactionSummary, 0)
+	for _, r := range m.Reactions {
+		i := slices.IndexFunc(summaries, func(s ReactionSummary) bool {
+			return s.Emoji == r.Emoji
+		})
+		if i == -1 {
+			summaries = append(summaries, ReactionSummary{Emoji: r.Emoji})
+			i = len(summaries) - 1
+		}
+		summaries[i].Count++
+		if r.UserID == subjectID {
+			summaries[i].Reacted = true
+		}
+	}
+
+	return summaries
+}
+
+// ReactionSummariesOf возвращает сводки реакций для списка сообщений.
+// Ключ карты это ID сообщения
+func ReactionSummariesOf(messages []Message, subjectID uuid.UUID) map[uuid.UUID][]ReactionSummary {
+	summaries := make(map[uuid.UUID][]ReactionSummary, len(messages))
+	for _, m := range messages {
+		summaries[m.ID] = m.ReactionSummaries(subjectID)
+	}
+
+	return summaries
+}
@@ -0,0 +1,169 @@
+package messagee
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestNewReaction тестирует создание реакции.
+func TestNewReaction(t *testing.T) {
+	t.Run("параметр userID должен быть валидным UUID", func(t *testing.T) {
+		reaction, err := NewReaction(uuid.Nil, "👍")
+		assert.Zero(t, reaction)
+		assert.ErrorIs(t, err, ErrInvalidUserID)
+	})
+
+	t.Run("эмодзи должен быть валидным", func(t *testing.T) {
+		reaction, err := NewReaction(uuid.New(), "text")
+		assert.Zero(t, reaction)
+		assert.ErrorIs(t, err, ErrInvalidEmoji)
+	})
+
+	t.Run("свойства реакции равны переданным", func(t *testing.T) {
+		userID := uuid.New()
+		reaction, err := NewReaction(userID, "👍")
+		require.NoError(t, err)
+		assert.Equal(t, userID, reaction.UserID)
+		assert.Equal(t, "👍", reaction.Emoji)
+		assert.NotZero(t, reaction.CreatedAt)
+	})
+}
+
+// TestMessage_AddReaction тестирует добавление реакции.
+func TestMessage_AddReaction(t *testing.T) {
+	t.Run("ставить реакции могут только участники чата", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		err := message.AddReaction(chat, newReaction(t, uuid.New(), "👍"), nil)
+		assert.ErrorIs(t, err, ErrUserIsNotMember)
+		assert.Empty(t, message.Reactions)
+	})
+
+	t.Run("нельзя поставить реакцию на удаленное сообщение", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.Delete(chat, chat.ChiefID, nil))
+		err := message.AddReaction(chat, newReaction(t, chat.ChiefID, "👍"), nil)
+		assert.ErrorIs(t, err, ErrMessageIsDeleted)
+	})
+
+	t.Run("нельзя поставить один эмодзи дважды", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.AddReaction(chat, newReaction(t, chat.ChiefID, "👍"), nil))
+		err := message.AddReaction(chat, newReaction(t, chat.ChiefID, "👍"), nil)
+		assert.ErrorIs(t, err, ErrReactionExists)
+		assert.Len(t, message.Reactions, 1)
+	})
+
+	t.Run("пользователь может поставить разные эмодзи", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.AddReaction(chat, newReaction(t, chat.ChiefID, "👍"), nil))
+		require.NoError(t, message.AddReaction(chat, newReaction(t, chat.ChiefID, "🔥"), nil))
+		assert.Len(t, message.Reactions, 2)
+		assert.True(t, message.HasReaction(chat.ChiefID, "👍"))
+		assert.True(t, message.HasReaction(chat.ChiefID, "🔥"))
+	})
+
+	t.Run("после завершения операции, будут созданы события", func(t *testing.T) {
+		chat := newChat(t)
+		addParticipant(t, &chat)
+		message := newMessage(t, chat, chat.ChiefID)
+		reaction := newReaction(t, chat.ChiefID, "👍")
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, message.AddReaction(chat, reaction, eventsBuf))
+
+		// Событие добавления реакции
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventReactionAdded, event.Type)
+		assert.ElementsMatch(t, chat.ParticipantIDs(), event.Recipients)
+		assert.Equal(t, reaction, event.Data["reaction"].(Reaction))
+	})
+}
+
+// TestMessage_RemoveReaction тестирует удаление реакции.
+func TestMessage_RemoveReaction(t *testing.T) {
+	t.Run("реакция должна существовать", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		err := message.RemoveReaction(chat, chat.ChiefID, "👍", nil)
+		assert.ErrorIs(t, err, ErrReactionNotExists)
+	})
+
+	t.Run("удаляется только реакция пользователя с этим эмодзи", func(t *testing.T) {
+		chat := newChat(t)
+		p := addParticipant(t, &chat)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.AddReaction(chat, newReaction(t, chat.ChiefID, "👍"), nil))
+		require.NoError(t, message.AddReaction(chat, newReaction(t, chat.ChiefID, "🔥"), nil))
+		require.NoError(t, message.AddReaction(chat, newReaction(t, p.UserID, "👍"), nil))
+
+		require.NoError(t, message.RemoveReaction(chat, chat.ChiefID, "👍", nil))
+		assert.Len(t, message.Reactions, 2)
+		assert.False(t, message.HasReaction(chat.ChiefID, "👍"))
+		assert.True(t, message.HasReaction(chat.ChiefID, "🔥"))
+		assert.True(t, message.HasReaction(p.UserID, "👍"))
+	})
+
+	t.Run("после завершения операции, будут созданы события", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		reaction := newReaction(t, chat.ChiefID, "👍")
+		require.NoError(t, message.AddReaction(chat, reaction, nil))
+
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		require.NoError(t, message.RemoveReaction(chat, chat.ChiefID, "👍", eventsBuf))
+
+		// Событие удаления реакции
+		require.Len(t, eventsBuf.Events(), 1)
+		event := eventsBuf.Events()[0]
+		assert.Equal(t, EventReactionRemoved, event.Type)
+		assert.Equal(t, reaction, event.Data["reaction"].(Reaction))
+	})
+}
+
+// TestMessage_ReactionSummaries тестирует сводку реакций.
+func TestMessage_ReactionSummaries(t *testing.T) {
+	t.Run("без реакций сводка пустая", func(t *testing.T) {
+		chat := newChat(t)
+		message := newMessage(t, chat, chat.ChiefID)
+		assert.Empty(t, message.ReactionSummaries(chat.ChiefID))
+	})
+
+	t.Run("реакции сгруппированы по эмодзи в порядке появления", func(t *testing.T) {
+		chat := newChat(t)
+		p1 := addParticipant(t, &chat)
+		p2 := addParticipant(t, &chat)
+		message := newMessage(t, chat, chat.ChiefID)
+		require.NoError(t, message.AddReaction(chat, newReaction(t, p1.UserID, "🔥"), nil))
+		require.NoError(t, message.AddReaction(chat, newReaction(t, p2.UserID, "👍"), nil))
+		require.NoError(t, message.AddReaction(chat, newReaction(t, chat.ChiefID, "👍"), nil))
+		require.NoError(t, message.AddReaction(chat, newReaction(t, p2.UserID, "🔥"), nil))
+
+		assert.Equal(t, []ReactionSummary{
+			{Emoji: "🔥", Count: 2, Reacted: false},
+			{Emoji: "👍", Count: 2, Reacted: true},
+		}, message.ReactionSummaries(chat.ChiefID))
+	})
+}
+
+// newReaction создает реакцию для тестов
+func newReaction(t *testing.T, userID uuid.UUID, emoji string) Reaction {
+	t.Helper()
+	reaction, err := NewReaction(userID, emoji)
+	require.NoError(t, err)
+
+	return reaction
+}
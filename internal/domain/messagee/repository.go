@@ -0,0 +1,55 @@
+package messagee
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository представляет собой интерфейс для работы с репозиторием сообщений.
+type Repository interface {
+	List(Filter) ([]Message, error)
+	// CountUnread возвращает количество непрочитанных пользователем сообщений в каждом из чатов.
+	// Учитываются неудаленные сообщения других пользователей, не являющиеся ответами в тредах
+	CountUnread(userID uuid.UUID, markers []ReadMarker) (map[uuid.UUID]int, error)
+	// Search выполняет полнотекстовый поиск сообщений.
+	// Результаты упорядочены по убыванию релевантности
+	Search(SearchFilter) ([]SearchResult, error)
+	Upsert(Message) error
+	// Delete безвозвратно удаляет сообщения вместе с ответами в их тредах
+	Delete(ids []uuid.UUID) error
+	InTransaction(func(txRepo Repository) error) error
+}
+
+// Filter представляет собой фильтр для выборки сообщений.
+type Filter struct {
+	ID              uuid.UUID   // Фильтрация по ID сообщения
+	IDs             []uuid.UUID // Фильтрация по списку ID сообщений
+	ChatID          uuid.UUID   // Фильтрация по ID чата
+	ChatIDs         []uuid.UUID // Фильтрация по списку ID чатов
+	ParentID        uuid.UUID   // Фильтрация по ID корневого сообщения треда
+	MentionedUserID uuid.UUID   // Брать только сообщения, в которых упомянут пользователь
+	RootsOnly       bool        // Брать только сообщения, не являющиеся ответами в треде
+	CreatedBefore   time.Time   // Брать записи где CreatedAt меньше чем CreatedBefore
+	ExpiresBefore   time.Time   // Брать записи где ExpiresAt не позже ExpiresBefore
+	Limit           int         // Ограничить количество элементов
+}
+
+// ReadMarker представляет собой отметку прочтения пользователя в чате.
+type ReadMarker struct {
+	ChatID uuid.UUID // ID чата
+	ReadAt time.Time // Время создания последнего прочитанного сообщения
+}
+
+// Find возвращает сообщение либо ошибку ErrMessageNotExists
+func Find(repo Repository, filter Filter) (Message, error) {
+	messages, err := repo.List(filter)
+	if err != nil {
+		return Message{}, err
+	}
+	if len(messages) != 1 {
+		return Message{}, ErrMessageNotExists
+	}
+
+	return messages[0], nil
+}
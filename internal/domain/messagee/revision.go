@@ -0,0 +1,11 @@
+package messagee
+
+import (
+	"time"
+)
+
+// Revision представляет собой предыдущую версию текста сообщения.
+type Revision struct {
+	Text      string    // Текст сообщения в этой версии
+	CreatedAt time.Time // Время появления этой версии
+}
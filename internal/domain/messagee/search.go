@@ -0,0 +1,42 @@
+package messagee
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchFilter представляет собой фильтр для полнотекстового поиска сообщений.
+type SearchFilter struct {
+	Query         string        // Поисковый запрос
+	ChatIDs       []uuid.UUID   // Искать только в указанных чатах
+	AuthorID      uuid.UUID     // Фильтрация по ID автора
+	CreatedAfter  time.Time     // Брать записи где CreatedAt больше чем CreatedAfter
+	CreatedBefore time.Time     // Брать записи где CreatedAt меньше чем CreatedBefore
+	After         *SearchCursor // Брать записи, следующие в порядке выдачи за курсором
+	Limit         int           // Ограничить количество элементов
+}
+
+// SearchResult представляет собой найденное сообщение.
+type SearchResult struct {
+	Message Message // Найденное сообщение
+	Rank    float32 // Релевантность сообщения запросу
+	Snippet string  // Фрагмент текста в виде HTML: текст экранирован, совпадения выделены тегом <mark>
+}
+
+// SearchCursor представляет собой позицию в выдаче результатов поиска.
+// Результаты упорядочены по убыванию Rank, затем CreatedAt и ID
+type SearchCursor struct {
+	Rank      float32
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Cursor возвращает позицию результата в выдаче
+func (r SearchResult) Cursor() SearchCursor {
+	return SearchCursor{
+		Rank:      r.Rank,
+		CreatedAt: r.Message.CreatedAt,
+		ID:        r.Message.ID,
+	}
+}
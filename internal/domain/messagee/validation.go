@@ -0,0 +1,49 @@
+package messagee
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MessageTextMaxLen максимальная длина текста сообщения.
+const MessageTextMaxLen = 4096
+
+// EmojiMaxLen максимальная длина эмодзи реакции в символах.
+// Запас нужен для составных эмодзи с модификаторами и соединителями.
+const EmojiMaxLen = 16
+
+// ValidateMessageText проверяет корректность текста сообщения.
+func ValidateMessageText(text string) error {
+	// Проверить, не является ли текст пустым или содержит только пробелы
+	if strings.TrimSpace(text) == "" {
+		return ErrTextEmpty
+	}
+
+	// Проверка на длину текста
+	if len([]rune(text)) > MessageTextMaxLen {
+		return ErrTextTooLong
+	}
+
+	// Проверка разметки текста
+	if _, err := ParseMarkup(text); err != nil {
+		return err
+	}
+
+	return nil // Текст валиден
+}
+
+// ValidateEmoji проверяет корректность эмодзи реакции.
+// Эмодзи не может быть пустым, содержать буквы, пробельные и управляющие символы
+func ValidateEmoji(emoji string) error {
+	if emoji == "" || len([]rune(emoji)) > EmojiMaxLen {
+		return ErrInvalidEmoji
+	}
+
+	for _, r := range emoji {
+		if unicode.IsLetter(r) || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return ErrInvalidEmoji
+		}
+	}
+
+	return nil
+}
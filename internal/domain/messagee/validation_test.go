@@ -0,0 +1,65 @@
+package messagee
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMessageText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+	}{
+		{name: "пустая строка", text: "", wantErr: true},
+		{name: "только пробелы", text: "   ", wantErr: true},
+		{name: "только переносы строк и табы", text: "\n\t\n", wantErr: true},
+		{name: "превышает лимит", text: strings.Repeat("a", MessageTextMaxLen+1), wantErr: true},
+		{name: "превышает лимит в символах юникода", text: strings.Repeat("я", MessageTextMaxLen+1), wantErr: true},
+		{name: "один символ", text: "a", wantErr: false},
+		{name: "ровно лимит", text: strings.Repeat("я", MessageTextMaxLen), wantErr: false},
+		{name: "многострочный текст", text: "первая строка\nвторая строка", wantErr: false},
+		{name: "эмодзи", text: "😊", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateMessageText(tt.text); tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateEmoji(t *testing.T) {
+	tests := []struct {
+		name    string
+		emoji   string
+		wantErr bool
+	}{
+		{name: "пустая строка", emoji: "", wantErr: true},
+		{name: "буквы", emoji: "ok", wantErr: true},
+		{name: "кириллица", emoji: "да", wantErr: true},
+		{name: "пробел", emoji: "👍 ", wantErr: true},
+		{name: "управляющий символ", emoji: "👍\n", wantErr: true},
+		{name: "превышает лимит", emoji: strings.Repeat("👍", EmojiMaxLen+1), wantErr: true},
+		{name: "простой эмодзи", emoji: "👍", wantErr: false},
+		{name: "эмодзи с модификатором тона кожи", emoji: "👍🏽", wantErr: false},
+		{name: "составной эмодзи", emoji: "👨‍👩‍👧‍👦", wantErr: false},
+		{name: "флаг", emoji: "🇷🇺", wantErr: false},
+		{name: "эмодзи с вариационным селектором", emoji: "❤️", wantErr: false},
+		{name: "кейкап", emoji: "1️⃣", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateEmoji(tt.emoji); tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
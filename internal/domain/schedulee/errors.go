@@ -0,0 +1,14 @@
+package schedulee
+
+import "errors"
+
+var (
+	ErrInvalidAuthorID            = errors.New("некорректное значение AuthorID")
+	ErrSendAtInPast               = errors.New("время отправки должно быть в будущем")
+	ErrSendAtTooFar               = errors.New("время отправки слишком далеко в будущем")
+	ErrAuthorIsNotMember          = errors.New("автор сообщения не является участником чата")
+	ErrAuthorCannotSend           = errors.New("у автора нет права отправлять сообщения в чат")
+	ErrSubjectIsNotAuthor         = errors.New("пользователь не является автором отложенного сообщения")
+	ErrScheduledMessageNotPending = errors.New("отложенное сообщение уже отправлено или отменено")
+	ErrScheduledMessageNotExists  = errors.New("отложенного сообщения не существует")
+)
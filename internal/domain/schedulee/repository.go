@@ -0,0 +1,41 @@
+package schedulee
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository представляет собой интерфейс для работы с репозиторием отложенных сообщений.
+type Repository interface {
+	// List возвращает отложенные сообщения по фильтру.
+	// Внутри InTransaction выбранные сообщения блокируются до ее завершения
+	List(Filter) ([]ScheduledMessage, error)
+	// ClaimDue блокирует и возвращает ожидающие отправки сообщения, у которых SendAt не позже before.
+	// Должен вызываться внутри InTransaction: строки остаются заблокированными до ее завершения,
+	// а другие экземпляры приложения пропускают их
+	ClaimDue(before time.Time, limit int) ([]ScheduledMessage, error)
+	Upsert(ScheduledMessage) error
+	InTransaction(func(txRepo Repository) error) error
+}
+
+// Filter представляет собой фильтр для выборки отложенных сообщений.
+type Filter struct {
+	ID          uuid.UUID // Фильтрация по ID отложенного сообщения
+	AuthorID    uuid.UUID // Фильтрация по ID автора
+	ChatID      uuid.UUID // Фильтрация по ID чата
+	PendingOnly bool      // Брать только ожидающие отправки сообщения
+}
+
+// Find возвращает отложенное сообщение либо ошибку ErrScheduledMessageNotExists
+func Find(repo Repository, filter Filter) (ScheduledMessage, error) {
+	messages, err := repo.List(filter)
+	if err != nil {
+		return ScheduledMessage{}, err
+	}
+	if len(messages) != 1 {
+		return ScheduledMessage{}, ErrScheduledMessageNotExists
+	}
+
+	return messages[0], nil
+}
@@ -0,0 +1,116 @@
+package schedulee
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+// MaxScheduleAhead максимальный срок, на который можно отложить отправку сообщения
+const MaxScheduleAhead = 365 * 24 * time.Hour
+
+// ScheduledMessage представляет собой агрегат сообщения, отправка которого отложена до времени SendAt.
+type ScheduledMessage struct {
+	ID        uuid.UUID // Уникальный ID отложенного сообщения
+	ChatID    uuid.UUID // ID чата, в который будет отправлено сообщение
+	AuthorID  uuid.UUID // ID пользователя, запланировавшего сообщение
+	Text      string    // Текст сообщения
+	ParentID  uuid.UUID // ID корневого сообщения треда, если сообщение будет ответом
+	SendAt    time.Time // Время, в которое сообщение должно быть отправлено
+	CreatedAt time.Time // Время создания отложенного сообщения
+
+	SentAt     time.Time // Время фактической отправки
+	MessageID  uuid.UUID // ID отправленного сообщения
+	CanceledAt time.Time // Время отмены
+	FailedAt   time.Time // Время неудачной попытки отправки
+	FailReason string    // Причина неудачной отправки
+}
+
+// NewScheduledMessage создает новое отложенное сообщение в чате.
+func NewScheduledMessage(chat chatt.Chat, authorID uuid.UUID, text string, parentID uuid.UUID, sendAt time.Time) (ScheduledMessage, error) {
+	if err := domain.ValidateID(authorID); err != nil {
+		return ScheduledMessage{}, errors.Join(err, ErrInvalidAuthorID)
+	}
+	if err := messagee.ValidateMessageText(text); err != nil {
+		return ScheduledMessage{}, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	sendAt = sendAt.In(time.UTC).Truncate(time.Microsecond)
+	if !sendAt.After(now) {
+		return ScheduledMessage{}, ErrSendAtInPast
+	}
+	if sendAt.Sub(now) > MaxScheduleAhead {
+		return ScheduledMessage{}, ErrSendAtTooFar
+	}
+
+	// Планировать сообщения могут только участники чата
+	if !chat.HasParticipant(authorID) {
+		return ScheduledMessage{}, ErrAuthorIsNotMember
+	}
+	if chat.IsArchived() {
+		return ScheduledMessage{}, chatt.ErrChatIsArchived
+	}
+	if !chat.Can(authorID, chatt.PermissionSendMessages) {
+		return ScheduledMessage{}, ErrAuthorCannotSend
+	}
+
+	return ScheduledMessage{
+		ID:        uuid.New(),
+		ChatID:    chat.ID,
+		AuthorID:  authorID,
+		Text:      text,
+		ParentID:  parentID,
+		SendAt:    sendAt,
+		CreatedAt: now,
+	}, nil
+}
+
+// IsPending проверяет, ожидает ли сообщение отправки.
+func (s *ScheduledMessage) IsPending() bool {
+	return s.SentAt.IsZero() && s.CanceledAt.IsZero() && s.FailedAt.IsZero()
+}
+
+// Cancel отменяет отправку сообщения.
+// Отменить отправку может только автор
+func (s *ScheduledMessage) Cancel(subjectID uuid.UUID) error {
+	if subjectID != s.AuthorID {
+		return ErrSubjectIsNotAuthor
+	}
+	if !s.IsPending() {
+		return ErrScheduledMessageNotPending
+	}
+
+	s.CanceledAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	return nil
+}
+
+// MarkSent отмечает сообщение отправленным.
+func (s *ScheduledMessage) MarkSent(messageID uuid.UUID) error {
+	if !s.IsPending() {
+		return ErrScheduledMessageNotPending
+	}
+
+	s.SentAt = time.Now().UTC().Truncate(time.Microsecond)
+	s.MessageID = messageID
+
+	return nil
+}
+
+// MarkFailed отмечает, что сообщение не может быть отправлено.
+func (s *ScheduledMessage) MarkFailed(reason string) error {
+	if !s.IsPending() {
+		return ErrScheduledMessageNotPending
+	}
+
+	s.FailedAt = time.Now().UTC().Truncate(time.Microsecond)
+	s.FailReason = reason
+
+	return nil
+}
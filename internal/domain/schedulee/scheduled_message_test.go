@@ -0,0 +1,121 @@
+package schedulee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+// TestNewScheduledMessage тестирует создание отложенного сообщения.
+func TestNewScheduledMessage(t *testing.T) {
+	t.Run("время отправки должно быть в будущем", func(t *testing.T) {
+		chat := newChat(t)
+		s, err := NewScheduledMessage(chat, chat.ChiefID, "text", uuid.Nil, time.Now().Add(-time.Minute))
+		assert.ErrorIs(t, err, ErrSendAtInPast)
+		assert.Zero(t, s)
+	})
+
+	t.Run("время отправки ограничено сверху", func(t *testing.T) {
+		chat := newChat(t)
+		s, err := NewScheduledMessage(chat, chat.ChiefID, "text", uuid.Nil, time.Now().Add(MaxScheduleAhead+time.Hour))
+		assert.ErrorIs(t, err, ErrSendAtTooFar)
+		assert.Zero(t, s)
+	})
+
+	t.Run("текст должен быть корректным", func(t *testing.T) {
+		chat := newChat(t)
+		s, err := NewScheduledMessage(chat, chat.ChiefID, " ", uuid.Nil, time.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, messagee.ErrTextEmpty)
+		assert.Zero(t, s)
+	})
+
+	t.Run("планировать сообщения могут только участники чата", func(t *testing.T) {
+		chat := newChat(t)
+		s, err := NewScheduledMessage(chat, uuid.New(), "text", uuid.Nil, time.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, ErrAuthorIsNotMember)
+		assert.Zero(t, s)
+	})
+
+	t.Run("новое сообщение ожидает отправки", func(t *testing.T) {
+		chat := newChat(t)
+		sendAt := time.Now().Add(time.Hour)
+		s, err := NewScheduledMessage(chat, chat.ChiefID, "text", uuid.Nil, sendAt)
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, s.ID)
+		assert.Equal(t, chat.ID, s.ChatID)
+		assert.Equal(t, chat.ChiefID, s.AuthorID)
+		assert.Equal(t, sendAt.UTC().Truncate(time.Microsecond), s.SendAt)
+		assert.True(t, s.IsPending())
+	})
+}
+
+// TestScheduledMessage_Cancel тестирует отмену отложенного сообщения.
+func TestScheduledMessage_Cancel(t *testing.T) {
+	t.Run("отменить может только автор", func(t *testing.T) {
+		s := newScheduledMessage(t)
+		assert.ErrorIs(t, s.Cancel(uuid.New()), ErrSubjectIsNotAuthor)
+		assert.True(t, s.IsPending())
+	})
+
+	t.Run("отмененное сообщение больше не ожидает отправки", func(t *testing.T) {
+		s := newScheduledMessage(t)
+		require.NoError(t, s.Cancel(s.AuthorID))
+		assert.False(t, s.IsPending())
+		assert.NotZero(t, s.CanceledAt)
+	})
+
+	t.Run("нельзя отменить отправленное сообщение", func(t *testing.T) {
+		s := newScheduledMessage(t)
+		require.NoError(t, s.MarkSent(uuid.New()))
+		assert.ErrorIs(t, s.Cancel(s.AuthorID), ErrScheduledMessageNotPending)
+	})
+}
+
+// TestScheduledMessage_MarkSent тестирует отметку об отправке.
+func TestScheduledMessage_MarkSent(t *testing.T) {
+	t.Run("сохраняется ID отправленного сообщения", func(t *testing.T) {
+		s := newScheduledMessage(t)
+		messageID := uuid.New()
+		require.NoError(t, s.MarkSent(messageID))
+		assert.Equal(t, messageID, s.MessageID)
+		assert.NotZero(t, s.SentAt)
+		assert.False(t, s.IsPending())
+	})
+
+	t.Run("отмененное сообщение нельзя отправить", func(t *testing.T) {
+		s := newScheduledMessage(t)
+		require.NoError(t, s.Cancel(s.AuthorID))
+		assert.ErrorIs(t, s.MarkSent(uuid.New()), ErrScheduledMessageNotPending)
+	})
+}
+
+// TestScheduledMessage_MarkFailed тестирует отметку о неудачной отправке.
+func TestScheduledMessage_MarkFailed(t *testing.T) {
+	s := newScheduledMessage(t)
+	require.NoError(t, s.MarkFailed("reason"))
+	assert.Equal(t, "reason", s.FailReason)
+	assert.NotZero(t, s.FailedAt)
+	assert.False(t, s.IsPending())
+	assert.ErrorIs(t, s.MarkFailed("reason"), ErrScheduledMessageNotPending)
+}
+
+func newChat(t *testing.T) chatt.Chat {
+	t.Helper()
+	chat, err := chatt.NewChat("test chat", uuid.New(), nil)
+	require.NoError(t, err)
+	return chat
+}
+
+func newScheduledMessage(t *testing.T) ScheduledMessage {
+	t.Helper()
+	chat := newChat(t)
+	s, err := NewScheduledMessage(chat, chat.ChiefID, "text", uuid.Nil, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	return s
+}
@@ -0,0 +1,186 @@
+package userr
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Виды пользователей
+const (
+	KindHuman = "human" // Человек, входящий через сессии
+	KindBot   = "bot"   // Бот, аутентифицирующийся долгоживущим токеном
+)
+
+// BotTokenPrefix префикс токена бота, позволяющий отличить его от других токенов
+const BotTokenPrefix = "bot_"
+
+// WebhookURLMaxLen максимальная длина адреса исходящего вебхука.
+const WebhookURLMaxLen = 2048
+
+// nonPublicPrefixes диапазоны адресов, не охваченные проверками netip.Addr,
+// но не ведущие во внешнюю сеть
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // Текущая сеть
+	netip.MustParsePrefix("100.64.0.0/10"), // Сети операторов (CGNAT)
+	netip.MustParsePrefix("192.0.0.0/24"),  // Служебные адреса IETF
+	netip.MustParsePrefix("198.18.0.0/15"), // Тестирование производительности
+	netip.MustParsePrefix("240.0.0.0/4"),   // Зарезервированные и широковещательный адреса
+	netip.MustParsePrefix("64:ff9b::/96"),  // Трансляция NAT64 в адреса IPv4
+}
+
+// Bot представляет собой данные пользователя-бота.
+type Bot struct {
+	OwnerID       uuid.UUID // ID пользователя, создавшего бота
+	TokenHash     string    // SHA-256 хэш токена бота, сам токен не хранится
+	WebhookURL    string    // Адрес исходящего вебхука, пустой если бот не получает события
+	WebhookSecret string    // Секрет для подписи доставок вебхука
+
+	Commands []BotCommand // Команды, которые обрабатывает бот
+}
+
+// NewBot создает пользователя-бота, принадлежащего owner.
+// Возвращает бота и его токен, который больше нигде не сохраняется
+func NewBot(owner User, name, nick, webhookURL string) (User, string, error) {
+	if owner.IsBot() {
+		return User{}, "", ErrBotOwnerIsBot
+	}
+	if err := ValidateWebhookURL(webhookURL); err != nil {
+		return User{}, "", err
+	}
+
+	user, err := NewUser(name, nick)
+	if err != nil {
+		return User{}, "", err
+	}
+
+	token := newBotToken()
+	user.Kind = KindBot
+	user.Bot = Bot{
+		OwnerID:       owner.ID,
+		TokenHash:     HashBotToken(token),
+		WebhookURL:    webhookURL,
+		WebhookSecret: randomHex(32),
+	}
+
+	return user, token, nil
+}
+
+// IsBot проверяет, является ли пользователь ботом.
+func (u *User) IsBot() bool {
+	return u.Kind == KindBot
+}
+
+// HasWebhook проверяет, получает ли бот события через исходящий вебхук.
+func (u *User) HasWebhook() bool {
+	return u.IsBot() && u.Bot.WebhookURL != ""
+}
+
+// RotateBotToken выпускает боту новый токен, старый токен перестает действовать.
+// Выпустить токен может только владелец бота
+func (u *User) RotateBotToken(subjectID uuid.UUID) (string, error) {
+	if !u.IsBot() {
+		return "", ErrUserIsNotBot
+	}
+	if subjectID != u.Bot.OwnerID {
+		return "", ErrSubjectIsNotBotOwner
+	}
+
+	token := newBotToken()
+	u.Bot.TokenHash = HashBotToken(token)
+
+	return token, nil
+}
+
+// HashBotToken возвращает хэш токена бота, по которому бот ищется в репозитории.
+func HashBotToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateBotToken проверяет формат токена бота.
+func ValidateBotToken(token string) error {
+	if !strings.HasPrefix(token, BotTokenPrefix) || len(token) != len(BotTokenPrefix)+64 {
+		return ErrInvalidBotToken
+	}
+
+	return nil
+}
+
+// ValidateWebhookURL проверяет адрес исходящего вебхука.
+// Пустой адрес допустим. Допускаются только абсолютные адреса http и https,
+// ведущие во внешнюю сеть: локальные имена и непубличные IP-адреса запрещены,
+// чтобы через вебхук нельзя было обратиться к внутренним сервисам
+func ValidateWebhookURL(webhookURL string) error {
+	if webhookURL == "" {
+		return nil
+	}
+	if len(webhookURL) > WebhookURLMaxLen {
+		return ErrInvalidWebhookURL
+	}
+
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidWebhookURL
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !IsPublicWebhookAddr(addr) {
+			return ErrInvalidWebhookURL
+		}
+		return nil
+	}
+	// Имена без домена верхнего уровня разрешаются только внутри локальной сети
+	if !strings.Contains(host, ".") ||
+		strings.HasSuffix(host, ".localhost") ||
+		strings.HasSuffix(host, ".local") ||
+		strings.HasSuffix(host, ".internal") {
+		return ErrInvalidWebhookURL
+	}
+
+	return nil
+}
+
+// IsPublicWebhookAddr проверяет, что IP-адрес ведет во внешнюю сеть
+// и на него можно отправлять вебхуки.
+func IsPublicWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newBotToken создает случайный токен бота
+func newBotToken() string {
+	return BotTokenPrefix + randomHex(32)
+}
+
+// randomHex возвращает n случайных байт в шестнадцатеричном виде
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// equal сравнивает данные ботов
+func (b Bot) equal(b2 Bot) bool {
+	return b.OwnerID == b2.OwnerID &&
+		b.TokenHash == b2.TokenHash &&
+		b.WebhookURL == b2.WebhookURL &&
+		b.WebhookSecret == b2.WebhookSecret &&
+		slices.Equal(b.Commands, b2.Commands)
+}
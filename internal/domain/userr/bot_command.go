@@ -0,0 +1,62 @@
+package userr
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+)
+
+// MaxBotCommands максимальное количество команд одного бота
+const MaxBotCommands = 100
+
+// BotCommandDescriptionMaxLen максимальная длина описания команды бота
+const BotCommandDescriptionMaxLen = 256
+
+// BotCommand представляет собой команду, которую обрабатывает бот.
+type BotCommand struct {
+	Name        string // Имя команды без префикса
+	Description string // Описание команды для пользователей
+}
+
+// SetBotCommands заменяет список команд бота.
+// Изменить команды может сам бот или его владелец
+func (u *User) SetBotCommands(subjectID uuid.UUID, commands []BotCommand) error {
+	if !u.IsBot() {
+		return ErrUserIsNotBot
+	}
+	if subjectID != u.ID && subjectID != u.Bot.OwnerID {
+		return ErrSubjectIsNotBotOwner
+	}
+	if len(commands) > MaxBotCommands {
+		return ErrTooManyBotCommands
+	}
+
+	// Проверить команды
+	for i, command := range commands {
+		if err := commandd.ValidateName(command.Name); err != nil {
+			return err
+		}
+		if len([]rune(command.Description)) > BotCommandDescriptionMaxLen || strings.ContainsAny(command.Description, "\n\t") {
+			return ErrInvalidBotCommandDescription
+		}
+		if slices.ContainsFunc(commands[:i], func(c BotCommand) bool {
+			return c.Name == command.Name
+		}) {
+			return ErrDuplicateBotCommand
+		}
+	}
+
+	u.Bot.Commands = slices.Clone(commands)
+
+	return nil
+}
+
+// HasBotCommand проверяет, обрабатывает ли бот команду с именем name.
+func (u *User) HasBotCommand(name string) bool {
+	return slices.ContainsFunc(u.Bot.Commands, func(c BotCommand) bool {
+		return c.Name == name
+	})
+}
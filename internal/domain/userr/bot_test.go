@@ -0,0 +1,145 @@
+package userr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBot(t *testing.T) {
+	owner, err := NewUser("owner", "owner")
+	require.NoError(t, err)
+
+	t.Run("бот принадлежит владельцу и хранит только хэш токена", func(t *testing.T) {
+		bot, token, err := NewBot(owner, "bot", "bot", "https://example.com/hook")
+		require.NoError(t, err)
+		assert.True(t, bot.IsBot())
+		assert.True(t, bot.HasWebhook())
+		assert.Equal(t, owner.ID, bot.Bot.OwnerID)
+		assert.NoError(t, ValidateBotToken(token))
+		assert.Equal(t, HashBotToken(token), bot.Bot.TokenHash)
+		assert.NotContains(t, bot.Bot.TokenHash, token)
+		assert.NotEmpty(t, bot.Bot.WebhookSecret)
+	})
+
+	t.Run("вебхук необязателен", func(t *testing.T) {
+		bot, _, err := NewBot(owner, "bot", "bot", "")
+		require.NoError(t, err)
+		assert.False(t, bot.HasWebhook())
+	})
+
+	t.Run("бот не может создавать ботов", func(t *testing.T) {
+		bot, _, err := NewBot(owner, "bot", "bot", "")
+		require.NoError(t, err)
+		_, _, err = NewBot(bot, "bot2", "bot2", "")
+		assert.ErrorIs(t, err, ErrBotOwnerIsBot)
+	})
+
+	t.Run("некорректное имя", func(t *testing.T) {
+		_, _, err := NewBot(owner, "", "bot", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestUser_RotateBotToken(t *testing.T) {
+	owner, err := NewUser("owner", "owner")
+	require.NoError(t, err)
+	bot, token, err := NewBot(owner, "bot", "bot", "")
+	require.NoError(t, err)
+
+	t.Run("выпустить токен может только владелец", func(t *testing.T) {
+		_, err := bot.RotateBotToken(uuid.New())
+		assert.ErrorIs(t, err, ErrSubjectIsNotBotOwner)
+	})
+
+	t.Run("новый токен заменяет старый", func(t *testing.T) {
+		newToken, err := bot.RotateBotToken(owner.ID)
+		require.NoError(t, err)
+		assert.NotEqual(t, token, newToken)
+		assert.Equal(t, HashBotToken(newToken), bot.Bot.TokenHash)
+	})
+
+	t.Run("у человека нет токена", func(t *testing.T) {
+		_, err := owner.RotateBotToken(owner.ID)
+		assert.ErrorIs(t, err, ErrUserIsNotBot)
+	})
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		testname string
+		url      string
+		wantErr  bool
+	}{
+		{testname: "empty url", url: "", wantErr: false},
+		{testname: "https", url: "https://example.com/hook", wantErr: false},
+		{testname: "http with port", url: "http://example.com:8080/hook", wantErr: false},
+		{testname: "public ip", url: "https://93.184.216.34/hook", wantErr: false},
+		{testname: "public ipv6", url: "https://[2606:2800:220:1::1]/hook", wantErr: false},
+		{testname: "localhost", url: "http://localhost:8080/hook", wantErr: true},
+		{testname: "localhost subdomain", url: "http://api.localhost/hook", wantErr: true},
+		{testname: "single label host", url: "http://postgres:5432/hook", wantErr: true},
+		{testname: "internal domain", url: "http://metadata.google.internal/hook", wantErr: true},
+		{testname: "loopback", url: "http://127.0.0.1/hook", wantErr: true},
+		{testname: "loopback ipv6", url: "http://[::1]/hook", wantErr: true},
+		{testname: "private", url: "http://10.0.0.5/hook", wantErr: true},
+		{testname: "private 192.168", url: "http://192.168.1.1/hook", wantErr: true},
+		{testname: "link-local metadata", url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{testname: "unspecified", url: "http://0.0.0.0/hook", wantErr: true},
+		{testname: "cgnat", url: "http://100.64.0.1/hook", wantErr: true},
+		{testname: "ipv4-mapped loopback", url: "http://[::ffff:127.0.0.1]/hook", wantErr: true},
+		{testname: "unique local ipv6", url: "http://[fd00::1]/hook", wantErr: true},
+		{testname: "relative", url: "/hook", wantErr: true},
+		{testname: "ftp scheme", url: "ftp://example.com/hook", wantErr: true},
+		{testname: "no host", url: "https://", wantErr: true},
+		{testname: "too long", url: "https://example.com/" + strings.Repeat("a", WebhookURLMaxLen), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testname, func(t *testing.T) {
+			if err := ValidateWebhookURL(tt.url); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWebhookURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUser_SetBotCommands(t *testing.T) {
+	owner, err := NewUser("owner", "owner")
+	require.NoError(t, err)
+	bot, _, err := NewBot(owner, "bot", "bot", "")
+	require.NoError(t, err)
+
+	t.Run("изменить команды могут бот и владелец", func(t *testing.T) {
+		commands := []BotCommand{{Name: "deploy", Description: "Выкатить релиз"}}
+		require.NoError(t, bot.SetBotCommands(bot.ID, commands))
+		assert.Equal(t, commands, bot.Bot.Commands)
+		assert.True(t, bot.HasBotCommand("deploy"))
+		require.NoError(t, bot.SetBotCommands(owner.ID, nil))
+		assert.Empty(t, bot.Bot.Commands)
+		assert.False(t, bot.HasBotCommand("deploy"))
+	})
+
+	t.Run("посторонний не может изменить команды", func(t *testing.T) {
+		err := bot.SetBotCommands(uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrSubjectIsNotBotOwner)
+	})
+
+	t.Run("у человека нет команд", func(t *testing.T) {
+		err := owner.SetBotCommands(owner.ID, nil)
+		assert.ErrorIs(t, err, ErrUserIsNotBot)
+	})
+
+	t.Run("команды проверяются", func(t *testing.T) {
+		err := bot.SetBotCommands(bot.ID, []BotCommand{{Name: "Deploy"}})
+		assert.Error(t, err)
+		err = bot.SetBotCommands(bot.ID, []BotCommand{{Name: "deploy"}, {Name: "deploy"}})
+		assert.ErrorIs(t, err, ErrDuplicateBotCommand)
+		err = bot.SetBotCommands(bot.ID, []BotCommand{{Name: "deploy", Description: "a\nb"}})
+		assert.ErrorIs(t, err, ErrInvalidBotCommandDescription)
+		err = bot.SetBotCommands(bot.ID, make([]BotCommand, MaxBotCommands+1))
+		assert.ErrorIs(t, err, ErrTooManyBotCommands)
+	})
+}
@@ -11,11 +11,17 @@ type Repository interface {
 
 // Filter представляет собой фильтр для выборки пользователей.
 type Filter struct {
-	ID                uuid.UUID // ID пользователя для фильтрации
-	OauthUserID       string    // Фильтрация по ID пользователя провайдера
-	OauthProvider     string    // Фильтрация по провайдеру
-	BasicAuthLogin    string    // Логин пользователя для фильтрации
-	BasicAuthPassword string    // Пароль пользователя для фильтрации
+	ID                uuid.UUID   // ID пользователя для фильтрации
+	OauthUserID       string      // Фильтрация по ID пользователя провайдера
+	OauthProvider     string      // Фильтрация по провайдеру
+	BasicAuthLogin    string      // Логин пользователя для фильтрации
+	BasicAuthPassword string      // Пароль пользователя для фильтрации
+	Nicks             []string    // Ники пользователей для фильтрации без учета регистра
+	IDs               []uuid.UUID // Фильтрация по списку ID пользователей
+	Kind              string      // Фильтрация по виду пользователя
+	BotOwnerID        uuid.UUID   // Фильтрация ботов по владельцу
+	BotTokenHash      string      // Фильтрация ботов по хэшу токена
+	BotCommand        string      // Фильтрация ботов по имени обрабатываемой команды
 }
 
 // Find возвращает пользователя либо ошибку ErrUserNotExists
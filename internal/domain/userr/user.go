@@ -11,9 +11,11 @@ type User struct {
 	ID   uuid.UUID // ID пользователя
 	Name string    // Имя пользователя
 	Nick string    // Ник пользователя
+	Kind string    // Вид пользователя: человек или бот
 
 	BasicAuth     BasicAuth      // Данные для аутентификации по логину и паролю
 	OpenAuthUsers []OpenAuthUser // Связи для аутентификации по Oauth
+	Bot           Bot            // Данные бота, заполнены только у ботов
 }
 
 // NewUser создает нового пользователя с указанным именем и ником.
@@ -31,6 +33,7 @@ func NewUser(name string, nick string) (User, error) {
 		ID:            uuid.New(),
 		Name:          name,
 		Nick:          nick,
+		Kind:          KindHuman,
 		BasicAuth:     BasicAuth{},
 		OpenAuthUsers: []OpenAuthUser{},
 	}, nil
@@ -76,6 +79,9 @@ func (u *User) Equal(u2 User) bool {
 	if u.Nick != u2.Nick {
 		return false
 	}
+	if u.Kind != u2.Kind || !u.Bot.equal(u2.Bot) {
+		return false
+	}
 	if len(u2.OpenAuthUsers) != len(u.OpenAuthUsers) {
 		return false
 	}
@@ -0,0 +1,184 @@
+package webhookk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// Параметры повторных попыток доставки
+const (
+	MaxAttempts    = 8                // Количество попыток, после которого доставка считается неудачной
+	InitialBackoff = 10 * time.Second // Задержка перед второй попыткой
+	MaxBackoff     = time.Hour        // Максимальная задержка между попытками
+	ClaimTimeout   = 5 * time.Minute  // Время, на которое доставка откладывается, пока выполняется попытка
+)
+
+// Заголовки, с которыми отправляется доставка
+const (
+	HeaderEvent     = "X-Npchat-Event"     // Тип события
+	HeaderDelivery  = "X-Npchat-Delivery"  // ID доставки, одинаковый для всех попыток
+	HeaderTimestamp = "X-Npchat-Timestamp" // Время отправки попытки в секундах unix
+	HeaderSignature = "X-Npchat-Signature" // Подпись HMAC-SHA256
+)
+
+// Delivery представляет собой агрегат доставки события боту через исходящий вебхук.
+type Delivery struct {
+	ID            uuid.UUID // Уникальный ID доставки
+	BotID         uuid.UUID // ID бота-получателя
+	EventType     string    // Тип доставляемого события
+	Payload       []byte    // Тело запроса
+	Attempts      int       // Количество сделанных попыток
+	NextAttemptAt time.Time // Время следующей попытки
+	LastError     string    // Ошибка последней неудачной попытки
+	CreatedAt     time.Time // Время создания доставки
+
+	DeliveredAt time.Time // Время успешной доставки
+	FailedAt    time.Time // Время, когда попытки доставки были исчерпаны
+}
+
+// payload представляет собой тело запроса доставки
+type payload struct {
+	ID        uuid.UUID      `json:"id"`
+	Type      string         `json:"type"`
+	CreatedAt time.Time      `json:"created_at"`
+	Data      map[string]any `json:"data"`
+}
+
+// NewDelivery создает доставку события боту botID.
+// Первая попытка доставки выполняется сразу
+func NewDelivery(botID uuid.UUID, event events.Event) (Delivery, error) {
+	if err := domain.ValidateID(botID); err != nil {
+		return Delivery{}, errors.Join(err, ErrInvalidBotID)
+	}
+
+	id := uuid.New()
+	body, err := json.Marshal(payload{
+		ID:        id,
+		Type:      event.Type,
+		CreatedAt: event.CreatedIn,
+		Data:      event.Data,
+	})
+	if err != nil {
+		return Delivery{}, fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return Delivery{
+		ID:            id,
+		BotID:         botID,
+		EventType:     event.Type,
+		Payload:       body,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// IsPending проверяет, ожидает ли доставка очередной попытки.
+func (d *Delivery) IsPending() bool {
+	return d.DeliveredAt.IsZero() && d.FailedAt.IsZero()
+}
+
+// Headers возвращает заголовки попытки доставки, подписанной секретом secret в момент now.
+func (d *Delivery) Headers(secret string, now time.Time) map[string]string {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	return map[string]string{
+		"Content-Type":  "application/json",
+		HeaderEvent:     d.EventType,
+		HeaderDelivery:  d.ID.String(),
+		HeaderTimestamp: timestamp,
+		HeaderSignature: Sign(secret, timestamp, d.Payload),
+	}
+}
+
+// Claim откладывает доставку на время ClaimTimeout, пока выполняется попытка.
+// Другие экземпляры приложения не возьмут доставку в это время,
+// а если результат попытки не будет сохранен, доставка повторится по его истечении
+func (d *Delivery) Claim(now time.Time) error {
+	if !d.IsPending() {
+		return ErrDeliveryNotPending
+	}
+
+	d.NextAttemptAt = now.UTC().Truncate(time.Microsecond).Add(ClaimTimeout)
+
+	return nil
+}
+
+// MarkDelivered отмечает доставку успешной.
+func (d *Delivery) MarkDelivered() error {
+	if !d.IsPending() {
+		return ErrDeliveryNotPending
+	}
+
+	d.Attempts++
+	d.DeliveredAt = time.Now().UTC().Truncate(time.Microsecond)
+	d.LastError = ""
+
+	return nil
+}
+
+// MarkFailed отмечает неудачную попытку доставки и откладывает следующую.
+// Задержка удваивается с каждой попыткой, а после MaxAttempts попыток доставка считается неудачной
+func (d *Delivery) MarkFailed(reason string) error {
+	if !d.IsPending() {
+		return ErrDeliveryNotPending
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	d.Attempts++
+	d.LastError = reason
+	if d.Attempts >= MaxAttempts {
+		d.FailedAt = now
+		return nil
+	}
+	d.NextAttemptAt = now.Add(Backoff(d.Attempts))
+
+	return nil
+}
+
+// MarkAbandoned отмечает доставку неудачной без дальнейших попыток.
+func (d *Delivery) MarkAbandoned(reason string) error {
+	if !d.IsPending() {
+		return ErrDeliveryNotPending
+	}
+
+	d.LastError = reason
+	d.FailedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	return nil
+}
+
+// Backoff возвращает задержку перед следующей попыткой после attempts неудачных.
+func Backoff(attempts int) time.Duration {
+	backoff := InitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= MaxBackoff {
+			return MaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// Sign возвращает подпись тела body, отправленного в момент timestamp.
+// Подписывается строка "timestamp.body" алгоритмом HMAC-SHA256 с ключом secret
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,129 @@
+package webhookk
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// TestNewDelivery тестирует создание доставки события.
+func TestNewDelivery(t *testing.T) {
+	t.Run("тело содержит тип и данные события", func(t *testing.T) {
+		event := events.Event{
+			Type:      "message_created",
+			CreatedIn: time.Now(),
+			Data:      map[string]any{"text": "hello"},
+		}
+		delivery, err := NewDelivery(uuid.New(), event)
+		require.NoError(t, err)
+		assert.True(t, delivery.IsPending())
+		assert.Equal(t, event.Type, delivery.EventType)
+		assert.False(t, delivery.NextAttemptAt.After(time.Now()))
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(delivery.Payload, &body))
+		assert.Equal(t, delivery.ID.String(), body["id"])
+		assert.Equal(t, "message_created", body["type"])
+		assert.Equal(t, map[string]any{"text": "hello"}, body["data"])
+	})
+
+	t.Run("нужен ID бота", func(t *testing.T) {
+		_, err := NewDelivery(uuid.Nil, events.Event{})
+		assert.ErrorIs(t, err, ErrInvalidBotID)
+	})
+}
+
+// TestDelivery_MarkFailed тестирует повторные попытки доставки.
+func TestDelivery_MarkFailed(t *testing.T) {
+	t.Run("задержка между попытками растет", func(t *testing.T) {
+		delivery, err := NewDelivery(uuid.New(), events.Event{Type: "t"})
+		require.NoError(t, err)
+
+		require.NoError(t, delivery.MarkFailed("timeout"))
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, "timeout", delivery.LastError)
+		first := time.Until(delivery.NextAttemptAt)
+		require.NoError(t, delivery.MarkFailed("timeout"))
+		assert.Greater(t, time.Until(delivery.NextAttemptAt), first)
+		assert.True(t, delivery.IsPending())
+	})
+
+	t.Run("после последней попытки доставка неудачна", func(t *testing.T) {
+		delivery, err := NewDelivery(uuid.New(), events.Event{Type: "t"})
+		require.NoError(t, err)
+		for range MaxAttempts {
+			require.NoError(t, delivery.MarkFailed("500"))
+		}
+		assert.False(t, delivery.IsPending())
+		assert.NotZero(t, delivery.FailedAt)
+		assert.ErrorIs(t, delivery.MarkFailed("500"), ErrDeliveryNotPending)
+		assert.ErrorIs(t, delivery.MarkDelivered(), ErrDeliveryNotPending)
+	})
+
+	t.Run("успешная доставка завершает попытки", func(t *testing.T) {
+		delivery, err := NewDelivery(uuid.New(), events.Event{Type: "t"})
+		require.NoError(t, err)
+		require.NoError(t, delivery.MarkFailed("500"))
+		require.NoError(t, delivery.MarkDelivered())
+		assert.False(t, delivery.IsPending())
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.Empty(t, delivery.LastError)
+	})
+}
+
+// TestDelivery_Claim тестирует откладывание доставки на время попытки.
+func TestDelivery_Claim(t *testing.T) {
+	t.Run("следующая попытка откладывается на время ClaimTimeout", func(t *testing.T) {
+		delivery, err := NewDelivery(uuid.New(), events.Event{Type: "t"})
+		require.NoError(t, err)
+		now := time.Now()
+		require.NoError(t, delivery.Claim(now))
+		assert.Equal(t, now.UTC().Truncate(time.Microsecond).Add(ClaimTimeout), delivery.NextAttemptAt)
+		assert.Zero(t, delivery.Attempts)
+		assert.True(t, delivery.IsPending())
+	})
+
+	t.Run("завершенную доставку нельзя взять", func(t *testing.T) {
+		delivery, err := NewDelivery(uuid.New(), events.Event{Type: "t"})
+		require.NoError(t, err)
+		require.NoError(t, delivery.MarkDelivered())
+		assert.ErrorIs(t, delivery.Claim(time.Now()), ErrDeliveryNotPending)
+	})
+}
+
+// TestBackoff тестирует расчет задержки между попытками.
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, InitialBackoff, Backoff(1))
+	assert.Equal(t, 2*InitialBackoff, Backoff(2))
+	assert.Equal(t, 4*InitialBackoff, Backoff(3))
+	assert.Equal(t, MaxBackoff, Backoff(100))
+}
+
+// TestDelivery_Headers тестирует подпись доставки.
+func TestDelivery_Headers(t *testing.T) {
+	delivery, err := NewDelivery(uuid.New(), events.Event{Type: "message_created"})
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	headers := delivery.Headers("secret", now)
+	assert.Equal(t, "message_created", headers[HeaderEvent])
+	assert.Equal(t, delivery.ID.String(), headers[HeaderDelivery])
+	assert.Equal(t, "1700000000", headers[HeaderTimestamp])
+	assert.Equal(t, Sign("secret", "1700000000", delivery.Payload), headers[HeaderSignature])
+	assert.NotEqual(t, Sign("other", "1700000000", delivery.Payload), headers[HeaderSignature])
+}
+
+// TestSign тестирует подпись известного значения.
+func TestSign(t *testing.T) {
+	// echo -n '1.{}' | openssl dgst -sha256 -hmac key
+	assert.Equal(t,
+		"sha256=1ba6b8171186efc613e8bcc0cbdab2748f24984d7c5a84faa2637afa0e40d224",
+		Sign("key", "1", []byte("{}")),
+	)
+}
@@ -0,0 +1,8 @@
+package webhookk
+
+import "errors"
+
+var (
+	ErrInvalidBotID       = errors.New("некорректное значение BotID")
+	ErrDeliveryNotPending = errors.New("доставка вебхука уже завершена")
+)
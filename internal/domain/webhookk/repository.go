@@ -0,0 +1,15 @@
+package webhookk
+
+import (
+	"time"
+)
+
+// Repository представляет собой интерфейс для работы с репозиторием доставок вебхуков.
+type Repository interface {
+	// ClaimDue блокирует и возвращает ожидающие доставки, у которых NextAttemptAt не позже before.
+	// Должен вызываться внутри InTransaction: строки остаются заблокированными до ее завершения,
+	// а другие экземпляры приложения пропускают их
+	ClaimDue(before time.Time, limit int) ([]Delivery, error)
+	Upsert(Delivery) error
+	InTransaction(func(txRepo Repository) error) error
+}
@@ -0,0 +1,8 @@
+package webhookk
+
+// Sender представляет собой интерфейс отправителя вебхуков.
+type Sender interface {
+	// Send отправляет тело body с заголовками headers на адрес url.
+	// Возвращает ошибку, если получатель недоступен или ответил неуспешным статусом
+	Send(url string, headers map[string]string, body []byte) error
+}
@@ -0,0 +1,148 @@
+package pgsqlRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/nullism/bqb"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	sqlxRepo "github.com/nice-pea/npchat/internal/repository/pgsql_repository/sqlx_repo"
+)
+
+type AttachmenttRepository struct {
+	sqlxRepo.SqlxRepo
+}
+
+func (r *AttachmenttRepository) List(filter attachmentt.Filter) ([]attachmentt.Attachment, error) {
+	sel := bqb.New("SELECT a.* FROM attachments a")
+	where := bqb.Optional("WHERE")
+
+	if filter.ID != uuid.Nil {
+		where = where.And("a.id = ?", filter.ID)
+	}
+	if len(filter.IDs) > 0 {
+		ids := make([]string, len(filter.IDs))
+		for i, id := range filter.IDs {
+			ids[i] = id.String()
+		}
+		where = where.And("a.id = ANY(?)", pq.Array(ids))
+	}
+	if filter.ChatID != uuid.Nil {
+		where = where.And("a.chat_id = ?", filter.ChatID)
+	}
+
+	query, args, err := bqb.New("? ? ORDER BY a.created_at", sel, where).ToPgsql()
+	if err != nil {
+		return nil, fmt.Errorf("bqb.ToPgsql: %w", err)
+	}
+
+	var attachments []dbAttachment
+	if err := r.DB().Select(&attachments, query, args...); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	return toDomainAttachments(attachments), nil
+}
+
+func (r *AttachmenttRepository) Upsert(attachment attachmentt.Attachment) error {
+	if attachment.ID == uuid.Nil {
+		return fmt.Errorf("attachment ID is required")
+	}
+
+	if _, err := r.DB().NamedExec(`
+		INSERT INTO attachments(id, chat_id, uploader_id, name, mime_type, size, checksum, storage_key, created_at)
+		VALUES (:id, :chat_id, :uploader_id, :name, :mime_type, :size, :checksum, :storage_key, :created_at)
+		ON CONFLICT (id) DO UPDATE SET
+			chat_id=excluded.chat_id,
+			uploader_id=excluded.uploader_id,
+			name=excluded.name,
+			mime_type=excluded.mime_type,
+			size=excluded.size,
+			checksum=excluded.checksum,
+			storage_key=excluded.storage_key,
+			created_at=excluded.created_at
+	`, toDBAttachment(attachment)); err != nil {
+		return fmt.Errorf("r.DB().NamedExec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AttachmenttRepository) Delete(ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	attachmentIDs := make([]string, len(ids))
+	for i, id := range ids {
+		attachmentIDs[i] = id.String()
+	}
+
+	if _, err := r.DB().Exec(`
+		DELETE FROM attachments a
+		WHERE a.id = ANY($1)
+			AND NOT EXISTS (SELECT 1 FROM message_attachments ma WHERE ma.attachment_id = a.id)
+	`, pq.Array(attachmentIDs)); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AttachmenttRepository) InTransaction(fn func(txRepo attachmentt.Repository) error) error {
+	return r.SqlxRepo.InTransaction(func(txSqlxRepo sqlxRepo.SqlxRepo) error {
+		return fn(&AttachmenttRepository{SqlxRepo: txSqlxRepo})
+	})
+}
+
+type dbAttachment struct {
+	ID         string    `db:"id"`
+	ChatID     string    `db:"chat_id"`
+	UploaderID string    `db:"uploader_id"`
+	Name       string    `db:"name"`
+	MimeType   string    `db:"mime_type"`
+	Size       int64     `db:"size"`
+	Checksum   string    `db:"checksum"`
+	StorageKey string    `db:"storage_key"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func toDBAttachment(attachment attachmentt.Attachment) dbAttachment {
+	return dbAttachment{
+		ID:         attachment.ID.String(),
+		ChatID:     attachment.ChatID.String(),
+		UploaderID: attachment.UploaderID.String(),
+		Name:       attachment.Name,
+		MimeType:   attachment.MimeType,
+		Size:       attachment.Size,
+		Checksum:   attachment.Checksum,
+		StorageKey: attachment.StorageKey,
+		CreatedAt:  attachment.CreatedAt,
+	}
+}
+
+func toDomainAttachment(attachment dbAttachment) attachmentt.Attachment {
+	return attachmentt.Attachment{
+		ID:         uuid.MustParse(attachment.ID),
+		ChatID:     uuid.MustParse(attachment.ChatID),
+		UploaderID: uuid.MustParse(attachment.UploaderID),
+		Name:       attachment.Name,
+		MimeType:   attachment.MimeType,
+		Size:       attachment.Size,
+		Checksum:   attachment.Checksum,
+		StorageKey: attachment.StorageKey,
+		CreatedAt:  attachment.CreatedAt.UTC(),
+	}
+}
+
+func toDomainAttachments(attachments []dbAttachment) []attachmentt.Attachment {
+	domainAttachments := make([]attachmentt.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		domainAttachments[i] = toDomainAttachment(attachment)
+	}
+
+	return domainAttachments
+}
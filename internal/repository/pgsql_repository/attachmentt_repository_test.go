@@ -0,0 +1,137 @@
+package pgsqlRepository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/common"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+func (suite *Suite) Test_AttachmenttRepository() {
+	suite.Run("List", func() {
+		suite.Run("из пустого репозитория вернется пустой список", func() {
+			attachments, err := suite.RR.Attachments.List(attachmentt.Filter{})
+			suite.NoError(err)
+			suite.Empty(attachments)
+		})
+
+		suite.Run("с фильтром по ID вернется сохраненное вложение", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			attachments := make([]attachmentt.Attachment, 10)
+			for i := range attachments {
+				attachments[i] = suite.upsertAttachment(suite.rndAttachment(chat))
+			}
+			expected := common.RndElem(attachments)
+
+			fromRepo, err := suite.RR.Attachments.List(attachmentt.Filter{
+				ID: expected.ID,
+			})
+			suite.NoError(err)
+			suite.Require().Len(fromRepo, 1)
+			suite.Equal(expected, fromRepo[0])
+		})
+
+		suite.Run("с фильтром по IDs вернутся только указанные вложения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			attachments := make([]attachmentt.Attachment, 10)
+			for i := range attachments {
+				attachments[i] = suite.upsertAttachment(suite.rndAttachment(chat))
+			}
+
+			fromRepo, err := suite.RR.Attachments.List(attachmentt.Filter{
+				IDs: []uuid.UUID{attachments[2].ID, attachments[5].ID},
+			})
+			suite.NoError(err)
+			suite.ElementsMatch([]attachmentt.Attachment{attachments[2], attachments[5]}, fromRepo)
+		})
+
+		suite.Run("с фильтром по ChatID вернутся вложения этого чата", func() {
+			chats := make([]chatt.Chat, 3)
+			for i := range chats {
+				chats[i] = suite.upsertChat(suite.rndChat())
+				for range 2 {
+					suite.upsertAttachment(suite.rndAttachment(chats[i]))
+				}
+			}
+			expectedChat := common.RndElem(chats)
+
+			fromRepo, err := suite.RR.Attachments.List(attachmentt.Filter{
+				ChatID: expectedChat.ID,
+			})
+			suite.NoError(err)
+			suite.Require().Len(fromRepo, 2)
+			for _, a := range fromRepo {
+				suite.Equal(expectedChat.ID, a.ChatID)
+			}
+		})
+	})
+
+	suite.Run("Upsert", func() {
+		suite.Run("нельзя сохранять без ID", func() {
+			err := suite.RR.Attachments.Upsert(attachmentt.Attachment{})
+			suite.Error(err)
+		})
+
+		suite.Run("нельзя сохранять вложение в несуществующий чат", func() {
+			err := suite.RR.Attachments.Upsert(suite.rndAttachment(suite.rndChat()))
+			suite.Error(err)
+		})
+
+		suite.Run("сохраненное вложение полностью соответствует сохраняемому", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			attachment := suite.upsertAttachment(suite.rndAttachment(chat))
+
+			attachments, err := suite.RR.Attachments.List(attachmentt.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(attachments, 1)
+			suite.Equal(attachment, attachments[0])
+		})
+	})
+
+	suite.Run("Delete", func() {
+		suite.Run("удалятся только вложения без ссылок из сообщений", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			unused := suite.upsertAttachment(suite.rndAttachment(chat))
+			used := suite.upsertAttachment(suite.rndAttachment(chat))
+			message, err := messagee.NewMessageWithAttachments(chat, chat.ChiefID, "", []attachmentt.Attachment{used}, nil, nil)
+			suite.Require().NoError(err)
+			suite.upsertMessage(message)
+
+			err = suite.RR.Attachments.Delete([]uuid.UUID{unused.ID, used.ID})
+			suite.Require().NoError(err)
+
+			// Прочитать из репозитория
+			attachments, err := suite.RR.Attachments.List(attachmentt.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(attachments, 1)
+			suite.Equal(used, attachments[0])
+		})
+	})
+}
+
+// rndAttachment создает случайное вложение от главного администратора чата
+func (suite *Suite) rndAttachment(chat chatt.Chat) attachmentt.Attachment {
+	suite.T().Helper()
+	sum := sha256.Sum256([]byte(gofakeit.Sentence(5)))
+	attachment, err := attachmentt.NewAttachment(chat, chat.ChiefID,
+		gofakeit.Word()+".txt", "text/plain", int64(gofakeit.IntRange(1, 1000)),
+		hex.EncodeToString(sum[:]), uuid.NewString())
+	suite.Require().NoError(err)
+
+	return attachment
+}
+
+// upsertAttachment сохраняет вложение в репозиторий
+func (suite *Suite) upsertAttachment(attachment attachmentt.Attachment) attachmentt.Attachment {
+	suite.T().Helper()
+	err := suite.RR.Attachments.Upsert(attachment)
+	suite.Require().NoError(err)
+
+	return attachment
+}
@@ -1,7 +1,10 @@
 package pgsqlRepository
 
 import (
+	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,7 +31,8 @@ func (r *ChattRepository) List(filter chatt.Filter) ([]chatt.Chat, error) {
 		where = where.And("p.user_id = ?", filter.ParticipantID)
 	}
 
-	needJoinInvitations := filter.InvitationID != uuid.Nil || filter.InvitationRecipientID != uuid.Nil
+	needJoinInvitations := filter.InvitationID != uuid.Nil || filter.InvitationRecipientID != uuid.Nil ||
+		!filter.InvitationExpiresBefore.IsZero()
 	if needJoinInvitations {
 		sel = sel.Space("LEFT JOIN invitations i ON c.id = i.chat_id")
 	}
@@ -38,21 +42,51 @@ func (r *ChattRepository) List(filter chatt.Filter) ([]chatt.Chat, error) {
 	if filter.InvitationRecipientID != uuid.Nil {
 		where = where.And("i.recipient_id = ?", filter.InvitationRecipientID)
 	}
+	if !filter.InvitationExpiresBefore.IsZero() {
+		where = where.And("i.expires_at <= ?", filter.InvitationExpiresBefore)
+	}
 
 	if filter.ID != uuid.Nil {
 		where = where.And("c.id = ?", filter.ID)
 	}
 
+	if filter.Kind != "" {
+		where = where.And("c.kind = ?", filter.Kind)
+	}
+
+	if filter.DirectPair != [2]uuid.UUID{} {
+		where = where.And("c.direct_key = ?", directPairKey(filter.DirectPair[0], filter.DirectPair[1]))
+	}
+
 	if !filter.ActiveBefore.IsZero() {
 		where = where.And("c.last_active_at < ?", filter.ActiveBefore)
 	}
 
+	if filter.WithRetentionMaxAge {
+		where = where.And("c.retention_max_age_seconds > 0")
+	}
+
+	if filter.ArchivedOnly {
+		where = where.And("c.archived_at IS NOT NULL")
+	}
+	if filter.ExcludeArchived {
+		where = where.And("c.archived_at IS NULL")
+	}
+
 	limit := bqb.New("")
 	if filter.Limit > 0 {
 		limit = limit.Space("LIMIT ?", filter.Limit)
 	}
 
-	query, args, err := bqb.New("? ? GROUP BY c.id ORDER BY last_active_at DESC ?", sel, where, limit).ToPgsql()
+	q := bqb.New("? ? GROUP BY c.id ORDER BY last_active_at DESC ?", sel, where, limit)
+	// В транзакции выбранные чаты блокируются до ее завершения, чтобы параллельные
+	// изменения состава чата не затирали друг друга. FOR UPDATE несовместим с GROUP BY,
+	// поэтому блокировка выполняется внешним запросом по ID найденных чатов
+	if r.IsTx() {
+		q = bqb.New("SELECT * FROM chats WHERE id IN (SELECT f.id FROM (?) f) ORDER BY last_active_at DESC FOR UPDATE", q)
+	}
+
+	query, args, err := q.ToPgsql()
 	if err != nil {
 		return nil, fmt.Errorf("bqb.ToPgsql: %w", err)
 	}
@@ -96,6 +130,7 @@ func (r *ChattRepository) List(filter chatt.Filter) ([]chatt.Chat, error) {
 		SELECT *
 		FROM invitations
 		WHERE chat_id = ANY($1)
+		ORDER BY created_at
 	`, pq.Array(chatIDs)); err != nil {
 		return nil, fmt.Errorf("r.DB().Select: %w", err)
 	}
@@ -106,7 +141,24 @@ func (r *ChattRepository) List(filter chatt.Filter) ([]chatt.Chat, error) {
 		invitationsMap[i.ChatID] = append(invitationsMap[i.ChatID], i)
 	}
 
-	return toDomainChats(chats, participantsMap, invitationsMap), nil
+	// Найти закрепленные сообщения в чатах
+	var pins []dbPin
+	if err := r.DB().Select(&pins, `
+		SELECT *
+		FROM chat_pins
+		WHERE chat_id = ANY($1)
+		ORDER BY pinned_at
+	`, pq.Array(chatIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Создать карту, где ключ это ID чата, а значение это список закрепленных в нем сообщений
+	pinsMap := make(map[string][]dbPin, len(chats))
+	for _, p := range pins {
+		pinsMap[p.ChatID] = append(pinsMap[p.ChatID], p)
+	}
+
+	return toDomainChats(chats, participantsMap, invitationsMap, pinsMap), nil
 }
 
 func (r *ChattRepository) Upsert(chat chatt.Chat) error {
@@ -125,12 +177,15 @@ func (r *ChattRepository) Upsert(chat chatt.Chat) error {
 
 func (r *ChattRepository) upsert(chat chatt.Chat) error {
 	if _, err := r.DB().NamedExec(`
-		INSERT INTO chats(id, name, chief_id, last_active_at) 
-		VALUES (:id, :name, :chief_id, :last_active_at)
+		INSERT INTO chats(id, kind, direct_key, name, chief_id, last_active_at, retention_max_age_seconds, retention_self_destruct_seconds, archived_at) 
+		VALUES (:id, :kind, :direct_key, :name, :chief_id, :last_active_at, :retention_max_age_seconds, :retention_self_destruct_seconds, :archived_at)
 		ON CONFLICT (id) DO UPDATE SET
 			name=excluded.name,
 			chief_id=excluded.chief_id,
-			last_active_at=excluded.last_active_at
+			last_active_at=excluded.last_active_at,
+			retention_max_age_seconds=excluded.retention_max_age_seconds,
+			retention_self_destruct_seconds=excluded.retention_self_destruct_seconds,
+			archived_at=excluded.archived_at
 	`, toDBChat(chat)); err != nil {
 		return fmt.Errorf("r.DB().NamedExec: %w", err)
 	}
@@ -144,8 +199,8 @@ func (r *ChattRepository) upsert(chat chatt.Chat) error {
 
 	if len(chat.Participants) > 0 {
 		if _, err := r.DB().NamedExec(`
-			INSERT INTO participants(chat_id, user_id)
-			VALUES (:chat_id, :user_id)
+			INSERT INTO participants(chat_id, user_id, role, last_read_message_id, last_read_at)
+			VALUES (:chat_id, :user_id, :role, :last_read_message_id, :last_read_at)
 		`, toDBParticipants(chat)); err != nil {
 			return fmt.Errorf("r.DB().NamedExec: %w", err)
 		}
@@ -160,13 +215,75 @@ func (r *ChattRepository) upsert(chat chatt.Chat) error {
 
 	if len(chat.Invitations) > 0 {
 		if _, err := r.DB().NamedExec(`
-		INSERT INTO invitations(id, chat_id, subject_id, recipient_id)
-		VALUES (:id, :chat_id, :subject_id, :recipient_id)
+		INSERT INTO invitations(id, chat_id, subject_id, recipient_id, note, created_at, expires_at)
+		VALUES (:id, :chat_id, :subject_id, :recipient_id, :note, :created_at, :expires_at)
 	`, toDBInvitations(chat)); err != nil {
 			return fmt.Errorf("r.DB().NamedExec: %w", err)
 		}
 	}
 
+	// Удалить прошлые закрепления
+	if _, err := r.DB().Exec(`
+		DELETE FROM chat_pins WHERE chat_id = $1
+	`, chat.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	if len(chat.Pins) > 0 {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO chat_pins(chat_id, message_id, pinned_by, pinned_at)
+			VALUES (:chat_id, :message_id, :pinned_by, :pinned_at)
+		`, toDBPins(chat)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *ChattRepository) UpdateLastActiveAt(chatID uuid.UUID, lastActiveAt time.Time) error {
+	if chatID == uuid.Nil {
+		return fmt.Errorf("chat ID is required")
+	}
+
+	if _, err := r.DB().Exec(`
+		UPDATE chats SET last_active_at = GREATEST(last_active_at, $2) WHERE id = $1
+	`, chatID, lastActiveAt); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ChattRepository) Delete(chatID uuid.UUID) error {
+	if chatID == uuid.Nil {
+		return fmt.Errorf("chat ID is required")
+	}
+
+	if r.IsTx() {
+		return r.delete(chatID)
+	} else {
+		return r.InTransaction(func(txRepo chatt.Repository) error {
+			return txRepo.Delete(chatID)
+		})
+	}
+}
+
+func (r *ChattRepository) delete(chatID uuid.UUID) error {
+	// Удалить сообщения отдельно, чтобы вместе с ними удалились ссылки на вложения
+	if _, err := r.DB().Exec(`
+		DELETE FROM messages WHERE chat_id = $1
+	`, chatID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	// Удалить чат, остальное содержимое удалится каскадно
+	if _, err := r.DB().Exec(`
+		DELETE FROM chats WHERE id = $1
+	`, chatID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
 	return nil
 }
 
@@ -177,18 +294,32 @@ func (r *ChattRepository) InTransaction(fn func(txRepo chatt.Repository) error)
 }
 
 type dbChat struct {
-	ID           string    `db:"id"`
-	Name         string    `db:"name"`
-	ChiefID      string    `db:"chief_id"`
-	LastActiveAt time.Time `db:"last_active_at"`
+	ID           string         `db:"id"`
+	Kind         string         `db:"kind"`
+	DirectKey    sql.NullString `db:"direct_key"`
+	Name         string         `db:"name"`
+	ChiefID      string         `db:"chief_id"`
+	LastActiveAt time.Time      `db:"last_active_at"`
+
+	RetentionMaxAgeSeconds       int64 `db:"retention_max_age_seconds"`
+	RetentionSelfDestructSeconds int64 `db:"retention_self_destruct_seconds"`
+
+	ArchivedAt sql.NullTime `db:"archived_at"`
 }
 
 func toDBChat(chat chatt.Chat) dbChat {
 	return dbChat{
 		ID:           chat.ID.String(),
+		Kind:         string(chat.Kind),
+		DirectKey:    directKey(chat),
 		Name:         chat.Name,
 		ChiefID:      chat.ChiefID.String(),
 		LastActiveAt: chat.LastActiveAt,
+
+		RetentionMaxAgeSeconds:       int64(chat.Retention.MaxAge / time.Second),
+		RetentionSelfDestructSeconds: int64(chat.Retention.SelfDestruct / time.Second),
+
+		ArchivedAt: toNullTime(chat.ArchivedAt),
 	}
 }
 
@@ -196,41 +327,77 @@ func toDomainChat(
 	chat dbChat,
 	participants []dbParticipant,
 	invitations []dbInvitation,
+	pins []dbPin,
 ) chatt.Chat {
 	return chatt.Chat{
 		ID:           uuid.MustParse(chat.ID),
+		Kind:         chatt.Kind(chat.Kind),
 		Name:         chat.Name,
 		ChiefID:      uuid.MustParse(chat.ChiefID),
 		LastActiveAt: chat.LastActiveAt.UTC(),
+		Retention: chatt.Retention{
+			MaxAge:       time.Duration(chat.RetentionMaxAgeSeconds) * time.Second,
+			SelfDestruct: time.Duration(chat.RetentionSelfDestructSeconds) * time.Second,
+		},
+		ArchivedAt:   fromNullTime(chat.ArchivedAt),
 		Participants: toDomainParticipants(participants),
 		Invitations:  toDomainInvitations(invitations),
+		Pins:         toDomainPins(pins),
 	}
 }
 
+// directKey возвращает ключ пары участников личного чата.
+// Ключ не зависит от порядка участников и гарантирует единственность личного чата для пары пользователей
+func directKey(chat chatt.Chat) sql.NullString {
+	if !chat.IsDirect() || len(chat.Participants) != 2 {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{
+		String: directPairKey(chat.Participants[0].UserID, chat.Participants[1].UserID),
+		Valid:  true,
+	}
+}
+
+// directPairKey возвращает ключ личного чата пары пользователей, не зависящий от их порядка
+func directPairKey(userID1, userID2 uuid.UUID) string {
+	ids := []string{userID1.String(), userID2.String()}
+	slices.Sort(ids)
+
+	return strings.Join(ids, ":")
+}
+
 func toDomainChats(
 	chats []dbChat,
 	participants map[string][]dbParticipant,
 	invitations map[string][]dbInvitation,
+	pins map[string][]dbPin,
 ) []chatt.Chat {
 	domainChats := make([]chatt.Chat, len(chats))
 	for i, chat := range chats {
-		domainChats[i] = toDomainChat(chat, participants[chat.ID], invitations[chat.ID])
+		domainChats[i] = toDomainChat(chat, participants[chat.ID], invitations[chat.ID], pins[chat.ID])
 	}
 
 	return domainChats
 }
 
 type dbParticipant struct {
-	ChatID string `db:"chat_id"`
-	UserID string `db:"user_id"`
+	ChatID            string         `db:"chat_id"`
+	UserID            string         `db:"user_id"`
+	Role              string         `db:"role"`
+	LastReadMessageID sql.NullString `db:"last_read_message_id"`
+	LastReadAt        sql.NullTime   `db:"last_read_at"`
 }
 
 func toDBParticipants(chat chatt.Chat) []dbParticipant {
 	dbParticipants := make([]dbParticipant, len(chat.Participants))
 	for i, p := range chat.Participants {
 		dbParticipants[i] = dbParticipant{
-			ChatID: chat.ID.String(),
-			UserID: p.UserID.String(),
+			ChatID:            chat.ID.String(),
+			UserID:            p.UserID.String(),
+			Role:              string(p.Role),
+			LastReadMessageID: toNullUUID(p.LastReadMessageID),
+			LastReadAt:        toNullTime(p.LastReadAt),
 		}
 	}
 
@@ -240,7 +407,10 @@ func toDomainParticipants(participants []dbParticipant) []chatt.Participant {
 	pp := make([]chatt.Participant, len(participants))
 	for i, p := range participants {
 		pp[i] = chatt.Participant{
-			UserID: uuid.MustParse(p.UserID),
+			UserID:            uuid.MustParse(p.UserID),
+			Role:              chatt.Role(p.Role),
+			LastReadMessageID: fromNullUUID(p.LastReadMessageID),
+			LastReadAt:        fromNullTime(p.LastReadAt),
 		}
 	}
 
@@ -248,10 +418,13 @@ func toDomainParticipants(participants []dbParticipant) []chatt.Participant {
 }
 
 type dbInvitation struct {
-	ID          string `db:"id"`
-	ChatID      string `db:"chat_id"`
-	SubjectID   string `db:"subject_id"`
-	RecipientID string `db:"recipient_id"`
+	ID          string       `db:"id"`
+	ChatID      string       `db:"chat_id"`
+	SubjectID   string       `db:"subject_id"`
+	RecipientID string       `db:"recipient_id"`
+	Note        string       `db:"note"`
+	CreatedAt   time.Time    `db:"created_at"`
+	ExpiresAt   sql.NullTime `db:"expires_at"`
 }
 
 func toDBInvitations(chat chatt.Chat) []dbInvitation {
@@ -262,6 +435,9 @@ func toDBInvitations(chat chatt.Chat) []dbInvitation {
 			ChatID:      chat.ID.String(),
 			SubjectID:   inv.SubjectID.String(),
 			RecipientID: inv.RecipientID.String(),
+			Note:        inv.Note,
+			CreatedAt:   inv.CreatedAt,
+			ExpiresAt:   toNullTime(inv.ExpiresAt),
 		}
 	}
 
@@ -275,8 +451,45 @@ func toDomainInvitations(invitations []dbInvitation) []chatt.Invitation {
 			ID:          uuid.MustParse(inv.ID),
 			RecipientID: uuid.MustParse(inv.RecipientID),
 			SubjectID:   uuid.MustParse(inv.SubjectID),
+			Note:        inv.Note,
+			CreatedAt:   inv.CreatedAt.UTC(),
+			ExpiresAt:   fromNullTime(inv.ExpiresAt),
 		}
 	}
 
 	return ii
 }
+
+type dbPin struct {
+	ChatID    string    `db:"chat_id"`
+	MessageID string    `db:"message_id"`
+	PinnedBy  string    `db:"pinned_by"`
+	PinnedAt  time.Time `db:"pinned_at"`
+}
+
+func toDBPins(chat chatt.Chat) []dbPin {
+	dbPins := make([]dbPin, len(chat.Pins))
+	for i, p := range chat.Pins {
+		dbPins[i] = dbPin{
+			ChatID:    chat.ID.String(),
+			MessageID: p.MessageID.String(),
+			PinnedBy:  p.PinnedBy.String(),
+			PinnedAt:  p.PinnedAt,
+		}
+	}
+
+	return dbPins
+}
+
+func toDomainPins(pins []dbPin) []chatt.Pin {
+	pp := make([]chatt.Pin, len(pins))
+	for i, p := range pins {
+		pp[i] = chatt.Pin{
+			MessageID: uuid.MustParse(p.MessageID),
+			PinnedBy:  uuid.MustParse(p.PinnedBy),
+			PinnedAt:  p.PinnedAt.UTC(),
+		}
+	}
+
+	return pp
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/nice-pea/npchat/internal/common"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
 	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
 )
 
 func (suite *Suite) Test_ChattRepository() {
@@ -89,6 +92,31 @@ func (suite *Suite) Test_ChattRepository() {
 			suite.Equal(expectedChat, chatsFromRepo[0])
 		})
 
+		suite.Run("с фильтром по InvitationExpiresBefore вернутся чаты, имеющие истекшие приглашения", func() {
+			// Создать чаты с действующими приглашениями
+			for range 5 {
+				chat := suite.rndChat()
+				suite.addRndParticipant(&chat)
+				suite.addRndInv(&chat)
+				suite.upsertChat(chat)
+			}
+			// Создать чат с истекшим приглашением
+			expectedChat := suite.rndChat()
+			suite.addRndParticipant(&expectedChat)
+			suite.addRndInv(&expectedChat)
+			expectedChat.Invitations[0].ExpiresAt = time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+			suite.upsertChat(expectedChat)
+
+			// Получить список
+			chatsFromRepo, err := suite.RR.Chats.List(chatt.Filter{
+				InvitationExpiresBefore: time.Now(),
+			})
+			// Сравнить ожидания и результат
+			suite.NoError(err)
+			suite.Require().Len(chatsFromRepo, 1)
+			suite.Equal(expectedChat, chatsFromRepo[0])
+		})
+
 		suite.Run("с фильтром по ParticipantID вернутся чаты, в которых состоит пользователь с тем ID", func() {
 			// Создать много чатов
 			chats := make([]chatt.Chat, 10)
@@ -134,6 +162,77 @@ func (suite *Suite) Test_ChattRepository() {
 			suite.True(now.Add(time.Second).Equal(chatsFromRepo[1].LastActiveAt))
 		})
 
+		suite.Run("с фильтром WithRetentionMaxAge вернутся чаты с ограниченным сроком хранения", func() {
+			suite.upsertChat(suite.rndChat())
+			withSelfDestruct := suite.rndChat()
+			err := withSelfDestruct.SetRetention(chatt.Retention{SelfDestruct: time.Minute}, nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(withSelfDestruct)
+			withMaxAge := suite.rndChat()
+			err = withMaxAge.SetRetention(chatt.Retention{MaxAge: 30 * 24 * time.Hour}, nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(withMaxAge)
+
+			// Получить список
+			chatsFromRepo, err := suite.RR.Chats.List(chatt.Filter{
+				WithRetentionMaxAge: true,
+			})
+			suite.NoError(err)
+			suite.Equal([]chatt.Chat{withMaxAge}, chatsFromRepo)
+		})
+
+		suite.Run("с фильтрами ArchivedOnly и ExcludeArchived архивные чаты берутся отдельно", func() {
+			active := suite.upsertChat(suite.rndChat())
+			archived := suite.rndChat()
+			suite.Require().NoError(archived.Archive(archived.ChiefID, nil))
+			suite.upsertChat(archived)
+
+			// Получить только архивные
+			chatsFromRepo, err := suite.RR.Chats.List(chatt.Filter{
+				ArchivedOnly: true,
+			})
+			suite.NoError(err)
+			suite.Equal([]chatt.Chat{archived}, chatsFromRepo)
+
+			// Получить только активные
+			chatsFromRepo, err = suite.RR.Chats.List(chatt.Filter{
+				ExcludeArchived: true,
+			})
+			suite.NoError(err)
+			suite.Equal([]chatt.Chat{active}, chatsFromRepo)
+		})
+
+		suite.Run("с фильтром Kind вернутся чаты только этого вида", func() {
+			suite.upsertChat(suite.rndChat())
+			direct, err := chatt.NewDirectChat(uuid.New(), uuid.New(), nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(direct)
+
+			// Получить список
+			chatsFromRepo, err := suite.RR.Chats.List(chatt.Filter{
+				Kind: chatt.KindDirect,
+			})
+			suite.NoError(err)
+			suite.Equal([]chatt.Chat{direct}, chatsFromRepo)
+		})
+
+		suite.Run("с фильтром DirectPair вернется личный чат пары в любом порядке", func() {
+			subjectID, userID := uuid.New(), uuid.New()
+			direct, err := chatt.NewDirectChat(subjectID, userID, nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(direct)
+			other, err := chatt.NewDirectChat(subjectID, uuid.New(), nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(other)
+
+			// Получить список
+			chatsFromRepo, err := suite.RR.Chats.List(chatt.Filter{
+				DirectPair: [2]uuid.UUID{userID, subjectID},
+			})
+			suite.NoError(err)
+			suite.Equal([]chatt.Chat{direct}, chatsFromRepo)
+		})
+
 		suite.Run("с limit вернется ограниченное количество элементов", func() {
 			// Создать чаты
 			const limit = 10
@@ -231,6 +330,28 @@ func (suite *Suite) Test_ChattRepository() {
 				prevActiveAt = chat.LastActiveAt
 			}
 		})
+
+		suite.Run("в транзакции вернутся те же чаты с фильтрами и limit", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			expired := suite.upsertChat(suite.rndChat())
+			invitation, err := chatt.NewInvitation(expired.ChiefID, uuid.New(), "", time.Now().Add(time.Second))
+			suite.Require().NoError(err)
+			suite.Require().NoError(expired.AddInvitation(invitation, nil))
+			suite.upsertChat(expired)
+			time.Sleep(time.Second)
+
+			err = suite.RR.Chats.InTransaction(func(txRepo chatt.Repository) error {
+				chatsFromRepo, err := txRepo.List(chatt.Filter{ParticipantID: chat.ChiefID, Limit: 1})
+				suite.NoError(err)
+				suite.Equal([]chatt.Chat{chat}, chatsFromRepo)
+
+				chatsFromRepo, err = txRepo.List(chatt.Filter{InvitationExpiresBefore: time.Now()})
+				suite.NoError(err)
+				suite.Equal([]chatt.Chat{expired}, chatsFromRepo)
+				return nil
+			})
+			suite.NoError(err)
+		})
 	})
 
 	suite.Run("Upsert", func() {
@@ -266,6 +387,92 @@ func (suite *Suite) Test_ChattRepository() {
 			suite.Equal(chat, chats[0])
 		})
 
+		suite.Run("сохраненная отметка прочтения соответствует сохраняемой", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			// Отметить прочтение сообщения
+			p := common.RndElem(chat.Participants)
+			err := chat.MarkRead(p.UserID, uuid.New(), time.Now(), nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(chat)
+
+			// Прочитать из репозитория
+			chats, err := suite.RR.Chats.List(chatt.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(chats, 1)
+			suite.Equal(chat, chats[0])
+		})
+
+		suite.Run("сохраненные роли участников соответствуют сохраняемым", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			// Изменить роль участника
+			err := chat.SetParticipantRole(chat.ChiefID, chat.Participants[1].UserID, chatt.RoleModerator, nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(chat)
+
+			// Прочитать из репозитория
+			fromRepo, err := chatt.Find(suite.RR.Chats, chatt.Filter{ID: chat.ID})
+			suite.NoError(err)
+			suite.ElementsMatch(chat.Participants, fromRepo.Participants)
+		})
+
+		suite.Run("сохраненные закрепления соответствуют сохраняемым", func() {
+			chat := suite.rndChat()
+			// Закрепить несколько сообщений
+			for range 3 {
+				err := chat.PinMessage(uuid.New(), chat.ChiefID, chatt.DefaultMaxPins, nil)
+				suite.Require().NoError(err)
+			}
+			suite.upsertChat(chat)
+
+			// Прочитать из репозитория
+			chats, err := suite.RR.Chats.List(chatt.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(chats, 1)
+			suite.Equal(chat, chats[0])
+
+			// Открепить сообщение и перезаписать чат
+			err = chat.UnpinMessage(chat.Pins[1].MessageID, nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(chat)
+
+			// Прочитать из репозитория
+			chats, err = suite.RR.Chats.List(chatt.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(chats, 1)
+			suite.Equal(chat.Pins, chats[0].Pins)
+		})
+
+		suite.Run("сохраненная политика хранения соответствует сохраняемой", func() {
+			chat := suite.rndChat()
+			err := chat.SetRetention(chatt.Retention{
+				MaxAge:       7 * 24 * time.Hour,
+				SelfDestruct: time.Hour,
+			}, nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(chat)
+
+			// Прочитать из репозитория
+			chats, err := suite.RR.Chats.List(chatt.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(chats, 1)
+			suite.Equal(chat.Retention, chats[0].Retention)
+		})
+
+		suite.Run("у пары пользователей может быть только один личный чат", func() {
+			subjectID, userID := uuid.New(), uuid.New()
+			direct, err := chatt.NewDirectChat(subjectID, userID, nil)
+			suite.Require().NoError(err)
+			suite.upsertChat(direct)
+
+			// Сохранить второй личный чат той же пары в обратном порядке
+			duplicate, err := chatt.NewDirectChat(userID, subjectID, nil)
+			suite.Require().NoError(err)
+			err = suite.RR.Chats.Upsert(duplicate)
+			suite.Error(err)
+		})
+
 		suite.Run("перезапись с новыми значениями по ID", func() {
 			id := uuid.New()
 			// Несколько промежуточных состояний чата
@@ -286,6 +493,82 @@ func (suite *Suite) Test_ChattRepository() {
 			suite.Equal(expectedChat, chats[0])
 		})
 	})
+
+	suite.Run("UpdateLastActiveAt", func() {
+		suite.Run("нельзя обновлять чат без ID", func() {
+			err := suite.RR.Chats.UpdateLastActiveAt(uuid.Nil, time.Now())
+			suite.Error(err)
+		})
+
+		suite.Run("обновится только время активности, состав чата сохранится", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			suite.addRndInv(&chat)
+			suite.upsertChat(chat)
+			// Обновить время активности
+			lastActiveAt := chat.LastActiveAt.Add(time.Hour).Truncate(time.Microsecond)
+			err := suite.RR.Chats.UpdateLastActiveAt(chat.ID, lastActiveAt)
+			suite.Require().NoError(err)
+			// Прочитать из репозитория
+			chats, err := suite.RR.Chats.List(chatt.Filter{ID: chat.ID})
+			suite.Require().NoError(err)
+			suite.Require().Len(chats, 1)
+			suite.True(lastActiveAt.Equal(chats[0].LastActiveAt))
+			suite.Equal(chat.Participants, chats[0].Participants)
+			suite.Equal(chat.Invitations, chats[0].Invitations)
+		})
+
+		suite.Run("более раннее время не перезапишет сохраненное", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			// Обновить время активности более ранним значением
+			err := suite.RR.Chats.UpdateLastActiveAt(chat.ID, chat.LastActiveAt.Add(-time.Hour))
+			suite.Require().NoError(err)
+			// Прочитать из репозитория
+			chats, err := suite.RR.Chats.List(chatt.Filter{ID: chat.ID})
+			suite.Require().NoError(err)
+			suite.Require().Len(chats, 1)
+			suite.True(chat.LastActiveAt.Equal(chats[0].LastActiveAt))
+		})
+	})
+
+	suite.Run("Delete", func() {
+		suite.Run("нельзя удалять чат без ID", func() {
+			err := suite.RR.Chats.Delete(uuid.Nil)
+			suite.Error(err)
+		})
+
+		suite.Run("чат удаляется вместе с содержимым", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			suite.addRndInv(&chat)
+			suite.upsertChat(chat)
+			attachment := suite.upsertAttachment(suite.rndAttachment(chat))
+			message, err := messagee.NewMessageWithAttachments(chat, chat.ChiefID, "", []attachmentt.Attachment{attachment}, nil, nil)
+			suite.Require().NoError(err)
+			suite.upsertMessage(message)
+			suite.upsertScheduled(suite.rndScheduled(chat, chat.ChiefID))
+			// Другой чат не должен пострадать
+			other := suite.upsertChat(suite.rndChat())
+
+			err = suite.RR.Chats.Delete(chat.ID)
+			suite.Require().NoError(err)
+
+			// Проверить, что остался только другой чат
+			chats, err := suite.RR.Chats.List(chatt.Filter{})
+			suite.NoError(err)
+			suite.Equal([]chatt.Chat{other}, chats)
+			// Проверить, что содержимое удалено
+			messages, err := suite.RR.Messages.List(messagee.Filter{ChatID: chat.ID})
+			suite.NoError(err)
+			suite.Empty(messages)
+			attachments, err := suite.RR.Attachments.List(attachmentt.Filter{ChatID: chat.ID})
+			suite.NoError(err)
+			suite.Empty(attachments)
+			scheduled, err := suite.RR.Scheduled.List(schedulee.Filter{ChatID: chat.ID})
+			suite.NoError(err)
+			suite.Empty(scheduled)
+		})
+	})
 }
 
 // rndChat создает случайный экземпляр чата
@@ -325,7 +608,7 @@ func (suite *Suite) addRndParticipant(chat *chatt.Chat) {
 // addRndInv добавляет случайное приглашение в чат
 func (suite *Suite) addRndInv(chat *chatt.Chat) {
 	suite.T().Helper()
-	inv, err := chatt.NewInvitation(common.RndElem(chat.Participants).UserID, uuid.New())
+	inv, err := chatt.NewInvitation(common.RndElem(chat.Participants).UserID, uuid.New(), gofakeit.Sentence(5), time.Now().Add(time.Hour))
 	suite.Require().NoError(err)
 	suite.Require().NoError(chat.AddInvitation(inv, nil))
 }
@@ -0,0 +1,110 @@
+package pgsqlRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/nullism/bqb"
+
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	sqlxRepo "github.com/nice-pea/npchat/internal/repository/pgsql_repository/sqlx_repo"
+)
+
+type DrafttRepository struct {
+	sqlxRepo.SqlxRepo
+}
+
+func (r *DrafttRepository) List(filter draftt.Filter) ([]draftt.Draft, error) {
+	sel := bqb.New("SELECT d.* FROM drafts d")
+	where := bqb.Optional("WHERE")
+
+	if filter.UserID != uuid.Nil {
+		where = where.And("d.user_id = ?", filter.UserID)
+	}
+	if filter.ChatID != uuid.Nil {
+		where = where.And("d.chat_id = ?", filter.ChatID)
+	}
+	if len(filter.ChatIDs) > 0 {
+		chatIDs := make([]string, len(filter.ChatIDs))
+		for i, id := range filter.ChatIDs {
+			chatIDs[i] = id.String()
+		}
+		where = where.And("d.chat_id = ANY(?)", pq.Array(chatIDs))
+	}
+
+	query, args, err := bqb.New("? ? ORDER BY d.updated_at DESC", sel, where).ToPgsql()
+	if err != nil {
+		return nil, fmt.Errorf("bqb.ToPgsql: %w", err)
+	}
+
+	var drafts []dbDraft
+	if err := r.DB().Select(&drafts, query, args...); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	return toDomainDrafts(drafts), nil
+}
+
+func (r *DrafttRepository) Upsert(draft draftt.Draft) error {
+	if draft.UserID == uuid.Nil || draft.ChatID == uuid.Nil {
+		return fmt.Errorf("draft UserID and ChatID are required")
+	}
+
+	if _, err := r.DB().NamedExec(`
+		INSERT INTO drafts(user_id, chat_id, text, updated_at)
+		VALUES (:user_id, :chat_id, :text, :updated_at)
+		ON CONFLICT (user_id, chat_id) DO UPDATE SET
+			text=excluded.text,
+			updated_at=excluded.updated_at
+	`, toDBDraft(draft)); err != nil {
+		return fmt.Errorf("r.DB().NamedExec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *DrafttRepository) Delete(userID, chatID uuid.UUID) error {
+	if _, err := r.DB().Exec(`
+		DELETE FROM drafts WHERE user_id = $1 AND chat_id = $2
+	`, userID.String(), chatID.String()); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	return nil
+}
+
+type dbDraft struct {
+	UserID    string    `db:"user_id"`
+	ChatID    string    `db:"chat_id"`
+	Text      string    `db:"text"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func toDBDraft(draft draftt.Draft) dbDraft {
+	return dbDraft{
+		UserID:    draft.UserID.String(),
+		ChatID:    draft.ChatID.String(),
+		Text:      draft.Text,
+		UpdatedAt: draft.UpdatedAt,
+	}
+}
+
+func toDomainDraft(draft dbDraft) draftt.Draft {
+	return draftt.Draft{
+		UserID:    uuid.MustParse(draft.UserID),
+		ChatID:    uuid.MustParse(draft.ChatID),
+		Text:      draft.Text,
+		UpdatedAt: draft.UpdatedAt.UTC(),
+	}
+}
+
+func toDomainDrafts(drafts []dbDraft) []draftt.Draft {
+	domainDrafts := make([]draftt.Draft, len(drafts))
+	for i, draft := range drafts {
+		domainDrafts[i] = toDomainDraft(draft)
+	}
+
+	return domainDrafts
+}
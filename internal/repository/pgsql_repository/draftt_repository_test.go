@@ -0,0 +1,101 @@
+package pgsqlRepository
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+)
+
+func (suite *Suite) Test_DrafttRepository() {
+	suite.Run("List", func() {
+		suite.Run("из пустого репозитория вернется пустой список", func() {
+			drafts, err := suite.RR.Drafts.List(draftt.Filter{})
+			suite.NoError(err)
+			suite.Empty(drafts)
+		})
+
+		suite.Run("с фильтром по UserID и ChatIDs вернутся черновики пользователя в этих чатах", func() {
+			participant := suite.rndParticipant()
+			userID := participant.UserID
+			chats := make([]chatt.Chat, 3)
+			for i := range chats {
+				chats[i] = suite.rndChat()
+				suite.Require().NoError(chats[i].AddParticipant(participant, nil))
+				suite.upsertChat(chats[i])
+			}
+			expected := []draftt.Draft{
+				suite.upsertDraft(suite.rndDraft(chats[0], userID)),
+				suite.upsertDraft(suite.rndDraft(chats[1], userID)),
+			}
+			suite.upsertDraft(suite.rndDraft(chats[2], userID))
+			suite.upsertDraft(suite.rndDraft(chats[0], chats[0].ChiefID))
+
+			fromRepo, err := suite.RR.Drafts.List(draftt.Filter{
+				UserID:  userID,
+				ChatIDs: []uuid.UUID{chats[0].ID, chats[1].ID},
+			})
+			suite.NoError(err)
+			suite.ElementsMatch(expected, fromRepo)
+		})
+	})
+
+	suite.Run("Upsert", func() {
+		suite.Run("нельзя сохранять без ID пользователя и чата", func() {
+			err := suite.RR.Drafts.Upsert(draftt.Draft{})
+			suite.Error(err)
+		})
+
+		suite.Run("нельзя сохранять черновик несуществующего чата", func() {
+			chat := suite.rndChat()
+			err := suite.RR.Drafts.Upsert(suite.rndDraft(chat, chat.ChiefID))
+			suite.Error(err)
+		})
+
+		suite.Run("повторное сохранение заменяет черновик", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			suite.upsertDraft(suite.rndDraft(chat, chat.ChiefID))
+			draft := suite.upsertDraft(suite.rndDraft(chat, chat.ChiefID))
+
+			fromRepo, err := suite.RR.Drafts.List(draftt.Filter{ChatID: chat.ID})
+			suite.NoError(err)
+			suite.Equal([]draftt.Draft{draft}, fromRepo)
+		})
+	})
+
+	suite.Run("Delete", func() {
+		suite.Run("удаляется только черновик пользователя в чате", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			suite.upsertChat(chat)
+			deleted := suite.upsertDraft(suite.rndDraft(chat, chat.ChiefID))
+			kept := suite.upsertDraft(suite.rndDraft(chat, chat.Participants[1].UserID))
+
+			err := suite.RR.Drafts.Delete(deleted.UserID, deleted.ChatID)
+			suite.NoError(err)
+
+			fromRepo, err := suite.RR.Drafts.List(draftt.Filter{ChatID: chat.ID})
+			suite.NoError(err)
+			suite.Equal([]draftt.Draft{kept}, fromRepo)
+		})
+	})
+}
+
+// rndDraft создает случайный черновик пользователя в чате
+func (suite *Suite) rndDraft(chat chatt.Chat, userID uuid.UUID) draftt.Draft {
+	suite.T().Helper()
+	draft, err := draftt.NewDraft(chat, userID, uuid.New(), gofakeit.Sentence(5), nil)
+	suite.Require().NoError(err)
+
+	return draft
+}
+
+// upsertDraft сохраняет черновик в репозиторий
+func (suite *Suite) upsertDraft(draft draftt.Draft) draftt.Draft {
+	suite.T().Helper()
+	err := suite.RR.Drafts.Upsert(draft)
+	suite.Require().NoError(err)
+
+	return draft
+}
@@ -8,9 +8,16 @@ import (
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
 	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
 	"github.com/nice-pea/npchat/internal/domain/sessionn"
 	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
 	sqlxRepo "github.com/nice-pea/npchat/internal/repository/pgsql_repository/sqlx_repo"
 )
 
@@ -58,7 +65,7 @@ func (f *Factory) Cleanup() error {
 	}
 
 	// Список таблиц для очистки
-	tables := []string{"sessions", "oauth_users", "users", "participants", "invitations", "chats"}
+	tables := []string{"webhook_deliveries", "bot_commands", "drafts", "sessions", "oauth_users", "users", "message_attachments", "message_reactions", "message_revisions", "messages", "scheduled_messages", "attachments", "participants", "invitations", "chat_pins", "incoming_webhooks", "invite_link_uses", "invite_links", "chats"}
 
 	return sqlxRepo.New(f.db).InTransaction(func(tx sqlxRepo.SqlxRepo) error {
 		for _, table := range tables {
@@ -90,3 +97,52 @@ func (f *Factory) NewSessionnRepository() sessionn.Repository {
 		SqlxRepo: sqlxRepo.New(f.db),
 	}
 }
+
+// NewMessageeRepository создает репозиторий сообщений
+func (f *Factory) NewMessageeRepository() messagee.Repository {
+	return &MessageeRepository{
+		SqlxRepo: sqlxRepo.New(f.db),
+	}
+}
+
+// NewAttachmenttRepository создает репозиторий вложений
+func (f *Factory) NewAttachmenttRepository() attachmentt.Repository {
+	return &AttachmenttRepository{
+		SqlxRepo: sqlxRepo.New(f.db),
+	}
+}
+
+// NewScheduleeRepository создает репозиторий отложенных сообщений
+func (f *Factory) NewScheduleeRepository() schedulee.Repository {
+	return &ScheduleeRepository{
+		SqlxRepo: sqlxRepo.New(f.db),
+	}
+}
+
+// NewWebhookkRepository создает репозиторий доставок вебхуков
+func (f *Factory) NewWebhookkRepository() webhookk.Repository {
+	return &WebhookkRepository{
+		SqlxRepo: sqlxRepo.New(f.db),
+	}
+}
+
+// NewIntegrationnRepository создает репозиторий входящих вебхуков
+func (f *Factory) NewIntegrationnRepository() integrationn.Repository {
+	return &IntegrationnRepository{
+		SqlxRepo: sqlxRepo.New(f.db),
+	}
+}
+
+// NewInvitelinkkRepository создает репозиторий пригласительных ссылок
+func (f *Factory) NewInvitelinkkRepository() invitelinkk.Repository {
+	return &InvitelinkkRepository{
+		SqlxRepo: sqlxRepo.New(f.db),
+	}
+}
+
+// NewDrafttRepository создает репозиторий черновиков
+func (f *Factory) NewDrafttRepository() draftt.Repository {
+	return &DrafttRepository{
+		SqlxRepo: sqlxRepo.New(f.db),
+	}
+}
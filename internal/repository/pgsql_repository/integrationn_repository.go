@@ -0,0 +1,118 @@
+package pgsqlRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nullism/bqb"
+
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	sqlxRepo "github.com/nice-pea/npchat/internal/repository/pgsql_repository/sqlx_repo"
+)
+
+type IntegrationnRepository struct {
+	sqlxRepo.SqlxRepo
+}
+
+func (r *IntegrationnRepository) List(filter integrationn.Filter) ([]integrationn.Webhook, error) {
+	sel := bqb.New("SELECT w.* FROM incoming_webhooks w")
+	where := bqb.Optional("WHERE")
+
+	if filter.ID != uuid.Nil {
+		where = where.And("w.id = ?", filter.ID)
+	}
+	if filter.ChatID != uuid.Nil {
+		where = where.And("w.chat_id = ?", filter.ChatID)
+	}
+	if filter.TokenHash != "" {
+		where = where.And("w.token_hash = ?", filter.TokenHash)
+	}
+	if filter.ActiveOnly {
+		where = where.And("w.revoked_at IS NULL")
+	}
+
+	query, args, err := bqb.New("? ? ORDER BY w.created_at", sel, where).ToPgsql()
+	if err != nil {
+		return nil, fmt.Errorf("bqb.ToPgsql: %w", err)
+	}
+
+	var webhooks []dbIncomingWebhook
+	if err := r.DB().Select(&webhooks, query, args...); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	return toDomainIncomingWebhooks(webhooks), nil
+}
+
+func (r *IntegrationnRepository) Upsert(webhook integrationn.Webhook) error {
+	if webhook.ID == uuid.Nil {
+		return fmt.Errorf("webhook ID is required")
+	}
+
+	if _, err := r.DB().NamedExec(`
+		INSERT INTO incoming_webhooks(id, chat_id, name, token_hash, creator_id, created_at, revoked_at)
+		VALUES (:id, :chat_id, :name, :token_hash, :creator_id, :created_at, :revoked_at)
+		ON CONFLICT (id) DO UPDATE SET
+			chat_id=excluded.chat_id,
+			name=excluded.name,
+			token_hash=excluded.token_hash,
+			creator_id=excluded.creator_id,
+			created_at=excluded.created_at,
+			revoked_at=excluded.revoked_at
+	`, toDBIncomingWebhook(webhook)); err != nil {
+		return fmt.Errorf("r.DB().NamedExec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *IntegrationnRepository) InTransaction(fn func(txRepo integrationn.Repository) error) error {
+	return r.SqlxRepo.InTransaction(func(txSqlxRepo sqlxRepo.SqlxRepo) error {
+		return fn(&IntegrationnRepository{SqlxRepo: txSqlxRepo})
+	})
+}
+
+type dbIncomingWebhook struct {
+	ID        string       `db:"id"`
+	ChatID    string       `db:"chat_id"`
+	Name      string       `db:"name"`
+	TokenHash string       `db:"token_hash"`
+	CreatorID string       `db:"creator_id"`
+	CreatedAt time.Time    `db:"created_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
+
+func toDBIncomingWebhook(webhook integrationn.Webhook) dbIncomingWebhook {
+	return dbIncomingWebhook{
+		ID:        webhook.ID.String(),
+		ChatID:    webhook.ChatID.String(),
+		Name:      webhook.Name,
+		TokenHash: webhook.TokenHash,
+		CreatorID: webhook.CreatorID.String(),
+		CreatedAt: webhook.CreatedAt,
+		RevokedAt: toNullTime(webhook.RevokedAt),
+	}
+}
+
+func toDomainIncomingWebhook(webhook dbIncomingWebhook) integrationn.Webhook {
+	return integrationn.Webhook{
+		ID:        uuid.MustParse(webhook.ID),
+		ChatID:    uuid.MustParse(webhook.ChatID),
+		Name:      webhook.Name,
+		TokenHash: webhook.TokenHash,
+		CreatorID: uuid.MustParse(webhook.CreatorID),
+		CreatedAt: webhook.CreatedAt.UTC(),
+		RevokedAt: fromNullTime(webhook.RevokedAt),
+	}
+}
+
+func toDomainIncomingWebhooks(webhooks []dbIncomingWebhook) []integrationn.Webhook {
+	domainWebhooks := make([]integrationn.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		domainWebhooks[i] = toDomainIncomingWebhook(webhook)
+	}
+
+	return domainWebhooks
+}
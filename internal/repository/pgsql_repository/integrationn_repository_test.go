@@ -0,0 +1,115 @@
+package pgsqlRepository
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+func (suite *Suite) Test_IntegrationnRepository() {
+	suite.Run("List", func() {
+		suite.Run("из пустого репозитория вернется пустой список", func() {
+			webhooks, err := suite.RR.Integrations.List(integrationn.Filter{})
+			suite.NoError(err)
+			suite.Empty(webhooks)
+		})
+
+		suite.Run("с фильтром по ChatID вернутся вебхуки этого чата", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			expected := []integrationn.Webhook{
+				suite.upsertIncomingWebhook(suite.rndIncomingWebhook(chat)),
+				suite.upsertIncomingWebhook(suite.rndIncomingWebhook(chat)),
+			}
+			suite.upsertIncomingWebhook(suite.rndIncomingWebhook(suite.upsertChat(suite.rndChat())))
+
+			fromRepo, err := suite.RR.Integrations.List(integrationn.Filter{
+				ChatID: chat.ID,
+			})
+			suite.NoError(err)
+			suite.Equal(expected, fromRepo)
+		})
+
+		suite.Run("с фильтром по TokenHash вернется вебхук с этим токеном", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			expected := suite.upsertIncomingWebhook(suite.rndIncomingWebhook(chat))
+			suite.upsertIncomingWebhook(suite.rndIncomingWebhook(chat))
+
+			fromRepo, err := suite.RR.Integrations.List(integrationn.Filter{
+				TokenHash: expected.TokenHash,
+			})
+			suite.NoError(err)
+			suite.Equal([]integrationn.Webhook{expected}, fromRepo)
+		})
+
+		suite.Run("с фильтром ActiveOnly отозванные вебхуки не вернутся", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			active := suite.upsertIncomingWebhook(suite.rndIncomingWebhook(chat))
+			revoked := suite.rndIncomingWebhook(chat)
+			suite.Require().NoError(revoked.Revoke())
+			suite.upsertIncomingWebhook(revoked)
+
+			fromRepo, err := suite.RR.Integrations.List(integrationn.Filter{
+				ChatID:     chat.ID,
+				ActiveOnly: true,
+			})
+			suite.NoError(err)
+			suite.Equal([]integrationn.Webhook{active}, fromRepo)
+		})
+	})
+
+	suite.Run("Upsert", func() {
+		suite.Run("нельзя сохранять без ID", func() {
+			err := suite.RR.Integrations.Upsert(integrationn.Webhook{})
+			suite.Error(err)
+		})
+
+		suite.Run("нельзя сохранять вебхук несуществующего чата", func() {
+			err := suite.RR.Integrations.Upsert(suite.rndIncomingWebhook(suite.rndChat()))
+			suite.Error(err)
+		})
+
+		suite.Run("сохраненный вебхук полностью соответствует сохраняемому", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			webhook := suite.rndIncomingWebhook(chat)
+			suite.upsertIncomingWebhook(webhook)
+			suite.Require().NoError(webhook.Revoke())
+			suite.upsertIncomingWebhook(webhook)
+
+			fromRepo, err := integrationn.Find(suite.RR.Integrations, integrationn.Filter{ID: webhook.ID})
+			suite.NoError(err)
+			suite.Equal(webhook, fromRepo)
+		})
+	})
+
+	suite.Run("сообщение интеграции сохраняет имя интеграции", func() {
+		chat := suite.upsertChat(suite.rndChat())
+		webhook := suite.upsertIncomingWebhook(suite.rndIncomingWebhook(chat))
+		message, err := messagee.NewIntegrationMessage(chat, webhook.ID, webhook.Name, gofakeit.Sentence(5), nil)
+		suite.Require().NoError(err)
+		suite.upsertMessage(message)
+
+		fromRepo, err := messagee.Find(suite.RR.Messages, messagee.Filter{ID: message.ID})
+		suite.NoError(err)
+		suite.Equal(message, fromRepo)
+	})
+}
+
+// rndIncomingWebhook создает случайный входящий вебхук от главного администратора чата
+func (suite *Suite) rndIncomingWebhook(chat chatt.Chat) integrationn.Webhook {
+	suite.T().Helper()
+	webhook, _, err := integrationn.NewWebhook(chat.ID, chat.ChiefID, gofakeit.Noun())
+	suite.Require().NoError(err)
+
+	return webhook
+}
+
+// upsertIncomingWebhook сохраняет входящий вебхук в репозиторий
+func (suite *Suite) upsertIncomingWebhook(webhook integrationn.Webhook) integrationn.Webhook {
+	suite.T().Helper()
+	err := suite.RR.Integrations.Upsert(webhook)
+	suite.Require().NoError(err)
+
+	return webhook
+}
@@ -0,0 +1,232 @@
+package pgsqlRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/nullism/bqb"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	sqlxRepo "github.com/nice-pea/npchat/internal/repository/pgsql_repository/sqlx_repo"
+)
+
+type InvitelinkkRepository struct {
+	sqlxRepo.SqlxRepo
+}
+
+func (r *InvitelinkkRepository) List(filter invitelinkk.Filter) ([]invitelinkk.Link, error) {
+	sel := bqb.New("SELECT l.* FROM invite_links l")
+	where := bqb.Optional("WHERE")
+
+	if filter.ID != uuid.Nil {
+		where = where.And("l.id = ?", filter.ID)
+	}
+	if filter.ChatID != uuid.Nil {
+		where = where.And("l.chat_id = ?", filter.ChatID)
+	}
+	if filter.TokenHash != "" {
+		where = where.And("l.token_hash = ?", filter.TokenHash)
+	}
+	if filter.ActiveOnly {
+		where = where.And("l.revoked_at IS NULL")
+	}
+
+	q := bqb.New("? ? ORDER BY l.created_at", sel, where)
+	// В транзакции выбранные строки блокируются до ее завершения,
+	// чтобы параллельные использования ссылки не затирали друг друга
+	if r.IsTx() {
+		q = q.Space("FOR UPDATE")
+	}
+
+	query, args, err := q.ToPgsql()
+	if err != nil {
+		return nil, fmt.Errorf("bqb.ToPgsql: %w", err)
+	}
+
+	// Запросить ссылки
+	var links []dbInviteLink
+	if err := r.DB().Select(&links, query, args...); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Если ссылок нет, сразу вернуть пустой список
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	// Собрать ID найденных ссылок
+	linkIDs := make([]string, len(links))
+	for i, l := range links {
+		linkIDs[i] = l.ID
+	}
+
+	// Найти использования ссылок
+	var uses []dbInviteLinkUse
+	if err := r.DB().Select(&uses, `
+		SELECT *
+		FROM invite_link_uses
+		WHERE link_id = ANY($1)
+		ORDER BY used_at
+	`, pq.Array(linkIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Создать карту, где ключ это ID ссылки, а значение это список ее использований
+	usesMap := make(map[string][]dbInviteLinkUse, len(links))
+	for _, u := range uses {
+		usesMap[u.LinkID] = append(usesMap[u.LinkID], u)
+	}
+
+	return toDomainInviteLinks(links, usesMap), nil
+}
+
+func (r *InvitelinkkRepository) Upsert(link invitelinkk.Link) error {
+	if link.ID == uuid.Nil {
+		return fmt.Errorf("link ID is required")
+	}
+
+	if r.IsTx() {
+		return r.upsert(link)
+	} else {
+		return r.InTransaction(func(txRepo invitelinkk.Repository) error {
+			return txRepo.Upsert(link)
+		})
+	}
+}
+
+func (r *InvitelinkkRepository) upsert(link invitelinkk.Link) error {
+	if _, err := r.DB().NamedExec(`
+		INSERT INTO invite_links(id, chat_id, token_hash, creator_id, expires_at, max_uses, requires_approval, created_at, revoked_at)
+		VALUES (:id, :chat_id, :token_hash, :creator_id, :expires_at, :max_uses, :requires_approval, :created_at, :revoked_at)
+		ON CONFLICT (id) DO UPDATE SET
+			chat_id=excluded.chat_id,
+			token_hash=excluded.token_hash,
+			creator_id=excluded.creator_id,
+			expires_at=excluded.expires_at,
+			max_uses=excluded.max_uses,
+			requires_approval=excluded.requires_approval,
+			created_at=excluded.created_at,
+			revoked_at=excluded.revoked_at
+	`, toDBInviteLink(link)); err != nil {
+		return fmt.Errorf("r.DB().NamedExec: %w", err)
+	}
+
+	// Удалить прошлые использования
+	if _, err := r.DB().Exec(`
+		DELETE FROM invite_link_uses WHERE link_id = $1
+	`, link.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	if len(link.Uses) > 0 {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO invite_link_uses(link_id, user_id, status, used_at, reviewed_by)
+			VALUES (:link_id, :user_id, :status, :used_at, :reviewed_by)
+		`, toDBInviteLinkUses(link)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *InvitelinkkRepository) InTransaction(fn func(txRepo invitelinkk.Repository) error) error {
+	return r.SqlxRepo.InTransaction(func(txSqlxRepo sqlxRepo.SqlxRepo) error {
+		return fn(&InvitelinkkRepository{SqlxRepo: txSqlxRepo})
+	})
+}
+
+func (r *InvitelinkkRepository) ChatsRepo() chatt.Repository {
+	return &ChattRepository{SqlxRepo: r.SqlxRepo}
+}
+
+type dbInviteLink struct {
+	ID               string       `db:"id"`
+	ChatID           string       `db:"chat_id"`
+	TokenHash        string       `db:"token_hash"`
+	CreatorID        string       `db:"creator_id"`
+	ExpiresAt        sql.NullTime `db:"expires_at"`
+	MaxUses          int          `db:"max_uses"`
+	RequiresApproval bool         `db:"requires_approval"`
+	CreatedAt        time.Time    `db:"created_at"`
+	RevokedAt        sql.NullTime `db:"revoked_at"`
+}
+
+type dbInviteLinkUse struct {
+	LinkID     string         `db:"link_id"`
+	UserID     string         `db:"user_id"`
+	Status     string         `db:"status"`
+	UsedAt     time.Time      `db:"used_at"`
+	ReviewedBy sql.NullString `db:"reviewed_by"`
+}
+
+func toDBInviteLink(link invitelinkk.Link) dbInviteLink {
+	return dbInviteLink{
+		ID:               link.ID.String(),
+		ChatID:           link.ChatID.String(),
+		TokenHash:        link.TokenHash,
+		CreatorID:        link.CreatorID.String(),
+		ExpiresAt:        toNullTime(link.ExpiresAt),
+		MaxUses:          link.MaxUses,
+		RequiresApproval: link.RequiresApproval,
+		CreatedAt:        link.CreatedAt,
+		RevokedAt:        toNullTime(link.RevokedAt),
+	}
+}
+
+func toDomainInviteLink(link dbInviteLink, uses []dbInviteLinkUse) invitelinkk.Link {
+	return invitelinkk.Link{
+		ID:               uuid.MustParse(link.ID),
+		ChatID:           uuid.MustParse(link.ChatID),
+		TokenHash:        link.TokenHash,
+		CreatorID:        uuid.MustParse(link.CreatorID),
+		ExpiresAt:        fromNullTime(link.ExpiresAt),
+		MaxUses:          link.MaxUses,
+		RequiresApproval: link.RequiresApproval,
+		CreatedAt:        link.CreatedAt.UTC(),
+		RevokedAt:        fromNullTime(link.RevokedAt),
+		Uses:             toDomainInviteLinkUses(uses),
+	}
+}
+
+func toDomainInviteLinks(links []dbInviteLink, usesMap map[string][]dbInviteLinkUse) []invitelinkk.Link {
+	domainLinks := make([]invitelinkk.Link, len(links))
+	for i, link := range links {
+		domainLinks[i] = toDomainInviteLink(link, usesMap[link.ID])
+	}
+
+	return domainLinks
+}
+
+func toDBInviteLinkUses(link invitelinkk.Link) []dbInviteLinkUse {
+	uses := make([]dbInviteLinkUse, len(link.Uses))
+	for i, u := range link.Uses {
+		uses[i] = dbInviteLinkUse{
+			LinkID:     link.ID.String(),
+			UserID:     u.UserID.String(),
+			Status:     string(u.Status),
+			UsedAt:     u.UsedAt,
+			ReviewedBy: toNullUUID(u.ReviewedBy),
+		}
+	}
+
+	return uses
+}
+
+func toDomainInviteLinkUses(uses []dbInviteLinkUse) []invitelinkk.Use {
+	domainUses := make([]invitelinkk.Use, len(uses))
+	for i, u := range uses {
+		domainUses[i] = invitelinkk.Use{
+			UserID:     uuid.MustParse(u.UserID),
+			Status:     invitelinkk.UseStatus(u.Status),
+			UsedAt:     u.UsedAt.UTC(),
+			ReviewedBy: fromNullUUID(u.ReviewedBy),
+		}
+	}
+
+	return domainUses
+}
@@ -0,0 +1,161 @@
+package pgsqlRepository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+)
+
+func (suite *Suite) Test_InvitelinkkRepository() {
+	suite.Run("List", func() {
+		suite.Run("из пустого репозитория вернется пустой список", func() {
+			links, err := suite.RR.InviteLinks.List(invitelinkk.Filter{})
+			suite.NoError(err)
+			suite.Empty(links)
+		})
+
+		suite.Run("с фильтром по ChatID вернутся ссылки этого чата", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			expected := []invitelinkk.Link{
+				suite.upsertInviteLink(suite.rndInviteLink(chat)),
+				suite.upsertInviteLink(suite.rndInviteLink(chat)),
+			}
+			suite.upsertInviteLink(suite.rndInviteLink(suite.upsertChat(suite.rndChat())))
+
+			fromRepo, err := suite.RR.InviteLinks.List(invitelinkk.Filter{
+				ChatID: chat.ID,
+			})
+			suite.NoError(err)
+			suite.Equal(expected, fromRepo)
+		})
+
+		suite.Run("с фильтром по TokenHash вернется ссылка с этим токеном", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			expected := suite.upsertInviteLink(suite.rndInviteLink(chat))
+			suite.upsertInviteLink(suite.rndInviteLink(chat))
+
+			fromRepo, err := suite.RR.InviteLinks.List(invitelinkk.Filter{
+				TokenHash: expected.TokenHash,
+			})
+			suite.NoError(err)
+			suite.Equal([]invitelinkk.Link{expected}, fromRepo)
+		})
+
+		suite.Run("с фильтром ActiveOnly отозванные ссылки не вернутся", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			active := suite.upsertInviteLink(suite.rndInviteLink(chat))
+			revoked := suite.rndInviteLink(chat)
+			suite.Require().NoError(revoked.Revoke())
+			suite.upsertInviteLink(revoked)
+
+			fromRepo, err := suite.RR.InviteLinks.List(invitelinkk.Filter{
+				ChatID:     chat.ID,
+				ActiveOnly: true,
+			})
+			suite.NoError(err)
+			suite.Equal([]invitelinkk.Link{active}, fromRepo)
+		})
+	})
+
+	suite.Run("Upsert", func() {
+		suite.Run("нельзя сохранять без ID", func() {
+			err := suite.RR.InviteLinks.Upsert(invitelinkk.Link{})
+			suite.Error(err)
+		})
+
+		suite.Run("нельзя сохранять ссылку несуществующего чата", func() {
+			err := suite.RR.InviteLinks.Upsert(suite.rndInviteLink(suite.rndChat()))
+			suite.Error(err)
+		})
+
+		suite.Run("сохраненная ссылка полностью соответствует сохраняемой", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			link, _, err := invitelinkk.NewLink(chat.ID, chat.ChiefID, time.Now().Add(time.Hour), 10, true)
+			suite.Require().NoError(err)
+			suite.upsertInviteLink(link)
+
+			// Добавить использования ссылки
+			joinedID := uuid.New()
+			_, err = link.Use(chat, joinedID, time.Now(), nil)
+			suite.Require().NoError(err)
+			_, err = link.Approve(chat.ChiefID, joinedID)
+			suite.Require().NoError(err)
+			_, err = link.Use(chat, uuid.New(), time.Now(), nil)
+			suite.Require().NoError(err)
+			suite.Require().NoError(link.Revoke())
+			suite.upsertInviteLink(link)
+
+			fromRepo, err := invitelinkk.Find(suite.RR.InviteLinks, invitelinkk.Filter{ID: link.ID})
+			suite.NoError(err)
+			suite.Equal(link, fromRepo)
+		})
+	})
+
+	suite.Run("InTransaction", func() {
+		suite.Run("ссылка и чат сохраняются в одной транзакции", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			link := suite.upsertInviteLink(suite.rndInviteLink(chat))
+			userID := uuid.New()
+
+			errRollback := errors.New("rollback")
+			err := suite.RR.InviteLinks.InTransaction(func(txRepo invitelinkk.Repository) error {
+				// Ссылка и чат читаются с блокировкой
+				txLink, err := invitelinkk.Find(txRepo, invitelinkk.Filter{ID: link.ID})
+				suite.Require().NoError(err)
+				txChat, err := chatt.Find(txRepo.ChatsRepo(), chatt.Filter{ID: chat.ID})
+				suite.Require().NoError(err)
+				suite.Equal(chat, txChat)
+
+				_, err = txLink.Use(txChat, userID, time.Now(), nil)
+				suite.Require().NoError(err)
+				suite.Require().NoError(txRepo.Upsert(txLink))
+				suite.Require().NoError(txChat.AddParticipant(chatt.Participant{UserID: userID, Role: chatt.RoleMember}, nil))
+				suite.Require().NoError(txRepo.ChatsRepo().Upsert(txChat))
+
+				return errRollback
+			})
+			suite.ErrorIs(err, errRollback)
+
+			// После отката не сохранилось ни использование ссылки, ни участник
+			fromRepo, err := invitelinkk.Find(suite.RR.InviteLinks, invitelinkk.Filter{ID: link.ID})
+			suite.NoError(err)
+			suite.Empty(fromRepo.Uses)
+			chatFromRepo, err := chatt.Find(suite.RR.Chats, chatt.Filter{ID: chat.ID})
+			suite.NoError(err)
+			suite.False(chatFromRepo.HasParticipant(userID))
+		})
+	})
+
+	suite.Run("ссылки удаляются вместе с чатом", func() {
+		chat := suite.upsertChat(suite.rndChat())
+		suite.upsertInviteLink(suite.rndInviteLink(chat))
+
+		suite.Require().NoError(suite.RR.Chats.Delete(chat.ID))
+
+		links, err := suite.RR.InviteLinks.List(invitelinkk.Filter{ChatID: chat.ID})
+		suite.NoError(err)
+		suite.Empty(links)
+	})
+}
+
+// rndInviteLink создает случайную пригласительную ссылку от главного администратора чата
+func (suite *Suite) rndInviteLink(chat chatt.Chat) invitelinkk.Link {
+	suite.T().Helper()
+	link, _, err := invitelinkk.NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, false)
+	suite.Require().NoError(err)
+
+	return link
+}
+
+// upsertInviteLink сохраняет пригласительную ссылку в репозиторий
+func (suite *Suite) upsertInviteLink(link invitelinkk.Link) invitelinkk.Link {
+	suite.T().Helper()
+	err := suite.RR.InviteLinks.Upsert(link)
+	suite.Require().NoError(err)
+
+	return link
+}
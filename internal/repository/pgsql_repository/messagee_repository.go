@@ -0,0 +1,882 @@
+package pgsqlRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/nullism/bqb"
+
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	sqlxRepo "github.com/nice-pea/npchat/internal/repository/pgsql_repository/sqlx_repo"
+)
+
+type MessageeRepository struct {
+	sqlxRepo.SqlxRepo
+}
+
+// messageColumns перечисляет колонки сообщения, соответствующие dbMessage
+const messageColumns = `m.id, m.chat_id, m.author_id, m.text, m.created_at, m.edited_at, m.deleted_at, m.expires_at,
+	m.parent_id, m.reply_count, m.last_reply_at,
+	m.forwarded_message_id, m.forwarded_chat_id, m.forwarded_author_id, m.forwarded_created_at,
+	m.integration_name`
+
+func (r *MessageeRepository) List(filter messagee.Filter) ([]messagee.Message, error) {
+	sel := bqb.New("SELECT " + messageColumns + " FROM messages m")
+	where := bqb.Optional("WHERE")
+
+	if filter.ID != uuid.Nil {
+		where = where.And("m.id = ?", filter.ID)
+	}
+	if len(filter.IDs) > 0 {
+		ids := make([]string, len(filter.IDs))
+		for i, id := range filter.IDs {
+			ids[i] = id.String()
+		}
+		where = where.And("m.id = ANY(?)", pq.Array(ids))
+	}
+	if filter.ChatID != uuid.Nil {
+		where = where.And("m.chat_id = ?", filter.ChatID)
+	}
+	if len(filter.ChatIDs) > 0 {
+		chatIDs := make([]string, len(filter.ChatIDs))
+		for i, id := range filter.ChatIDs {
+			chatIDs[i] = id.String()
+		}
+		where = where.And("m.chat_id = ANY(?)", pq.Array(chatIDs))
+	}
+	if filter.MentionedUserID != uuid.Nil {
+		where = where.And("EXISTS (SELECT 1 FROM message_mentions mm WHERE mm.message_id = m.id AND mm.user_id = ?)", filter.MentionedUserID)
+	}
+	if filter.ParentID != uuid.Nil {
+		where = where.And("m.parent_id = ?", filter.ParentID)
+	}
+	if filter.RootsOnly {
+		where = where.And("m.parent_id IS NULL")
+	}
+	if !filter.CreatedBefore.IsZero() {
+		where = where.And("m.created_at < ?", filter.CreatedBefore)
+	}
+	if !filter.ExpiresBefore.IsZero() {
+		where = where.And("m.expires_at <= ?", filter.ExpiresBefore)
+	}
+
+	limit := bqb.New("")
+	if filter.Limit > 0 {
+		limit = limit.Space("LIMIT ?", filter.Limit)
+	}
+
+	q := bqb.New("? ? ORDER BY m.created_at DESC ?", sel, where, limit)
+
+	// В транзакции выбранные строки блокируются до ее завершения,
+	// чтобы параллельные изменения сообщения, например голоса в опросе, не перезаписывали друг друга
+	if r.IsTx() {
+		q = q.Space("FOR UPDATE OF m")
+	}
+
+	query, args, err := q.ToPgsql()
+	if err != nil {
+		return nil, fmt.Errorf("bqb.ToPgsql: %w", err)
+	}
+
+	// Запросить сообщения
+	var messages []dbMessage
+	if err := r.DB().Select(&messages, query, args...); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Если сообщений нет, сразу вернуть пустой список
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	return r.withRelations(messages)
+}
+
+// withRelations загружает форматирование, версии, реакции, вложения, упоминания и опросы сообщений и преобразует их в доменные модели
+func (r *MessageeRepository) withRelations(messages []dbMessage) ([]messagee.Message, error) {
+	// Собрать ID найденных сообщений
+	messageIDs := make([]string, len(messages))
+	for i, m := range messages {
+		messageIDs[i] = m.ID
+	}
+
+	// Найти форматирование текста сообщений
+	var entities []dbMessageEntity
+	if err := r.DB().Select(&entities, `
+		SELECT *
+		FROM message_entities
+		WHERE message_id = ANY($1)
+		ORDER BY position
+	`, pq.Array(messageIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Создать карту, где ключ это ID сообщения, а значение это список сущностей его форматирования
+	entitiesMap := make(map[string][]dbMessageEntity, len(messages))
+	for _, entity := range entities {
+		entitiesMap[entity.MessageID] = append(entitiesMap[entity.MessageID], entity)
+	}
+
+	// Найти предыдущие версии сообщений
+	var revisions []dbRevision
+	if err := r.DB().Select(&revisions, `
+		SELECT *
+		FROM message_revisions
+		WHERE message_id = ANY($1)
+		ORDER BY created_at
+	`, pq.Array(messageIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Создать карту, где ключ это ID сообщения, а значение это список его версий
+	revisionsMap := make(map[string][]dbRevision, len(messages))
+	for _, rev := range revisions {
+		revisionsMap[rev.MessageID] = append(revisionsMap[rev.MessageID], rev)
+	}
+
+	// Найти реакции на сообщения
+	var reactions []dbReaction
+	if err := r.DB().Select(&reactions, `
+		SELECT *
+		FROM message_reactions
+		WHERE message_id = ANY($1)
+		ORDER BY created_at
+	`, pq.Array(messageIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Создать карту, где ключ это ID сообщения, а значение это список реакций на него
+	reactionsMap := make(map[string][]dbReaction, len(messages))
+	for _, reaction := range reactions {
+		reactionsMap[reaction.MessageID] = append(reactionsMap[reaction.MessageID], reaction)
+	}
+
+	// Найти вложения сообщений
+	var attachments []dbMessageAttachment
+	if err := r.DB().Select(&attachments, `
+		SELECT *
+		FROM message_attachments
+		WHERE message_id = ANY($1)
+		ORDER BY position
+	`, pq.Array(messageIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Создать карту, где ключ это ID сообщения, а значение это список его вложений
+	attachmentsMap := make(map[string][]dbMessageAttachment, len(messages))
+	for _, attachment := range attachments {
+		attachmentsMap[attachment.MessageID] = append(attachmentsMap[attachment.MessageID], attachment)
+	}
+
+	// Найти упоминания в сообщениях
+	var mentions []dbMessageMention
+	if err := r.DB().Select(&mentions, `
+		SELECT *
+		FROM message_mentions
+		WHERE message_id = ANY($1)
+		ORDER BY position
+	`, pq.Array(messageIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Создать карту, где ключ это ID сообщения, а значение это список упоминаний в нем
+	mentionsMap := make(map[string][]dbMessageMention, len(messages))
+	for _, mention := range mentions {
+		mentionsMap[mention.MessageID] = append(mentionsMap[mention.MessageID], mention)
+	}
+
+	// Найти опросы
+	var polls []dbPoll
+	if err := r.DB().Select(&polls, `
+		SELECT *
+		FROM polls
+		WHERE message_id = ANY($1)
+	`, pq.Array(messageIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Найти голоса в опросах
+	var votes []dbPollVote
+	if len(polls) > 0 {
+		if err := r.DB().Select(&votes, `
+			SELECT *
+			FROM poll_votes
+			WHERE message_id = ANY($1)
+			ORDER BY created_at, option_index
+		`, pq.Array(messageIDs)); err != nil {
+			return nil, fmt.Errorf("r.DB().Select: %w", err)
+		}
+	}
+
+	// Создать карту, где ключ это ID сообщения, а значение это опрос вместе с голосами
+	pollsMap := make(map[string]*messagee.Poll, len(polls))
+	for _, poll := range polls {
+		pollsMap[poll.MessageID] = toDomainPoll(poll)
+	}
+	for _, vote := range votes {
+		poll := pollsMap[vote.MessageID]
+		poll.Votes = append(poll.Votes, toDomainPollVote(vote))
+	}
+
+	return toDomainMessages(messages, entitiesMap, revisionsMap, reactionsMap, attachmentsMap, mentionsMap, pollsMap), nil
+}
+
+func (r *MessageeRepository) Search(filter messagee.SearchFilter) ([]messagee.SearchResult, error) {
+	where := bqb.New("WHERE m.search_vector @@ q.query")
+
+	if len(filter.ChatIDs) > 0 {
+		chatIDs := make([]string, len(filter.ChatIDs))
+		for i, id := range filter.ChatIDs {
+			chatIDs[i] = id.String()
+		}
+		where = where.And("m.chat_id = ANY(?)", pq.Array(chatIDs))
+	}
+	if filter.AuthorID != uuid.Nil {
+		where = where.And("m.author_id = ?", filter.AuthorID)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		where = where.And("m.created_at > ?", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		where = where.And("m.created_at < ?", filter.CreatedBefore)
+	}
+	if filter.After != nil {
+		where = where.And("(ts_rank(m.search_vector, q.query), m.created_at, m.id) < (?::real, ?, ?)",
+			filter.After.Rank, filter.After.CreatedAt, filter.After.ID)
+	}
+
+	limit := bqb.New("")
+	if filter.Limit > 0 {
+		limit = limit.Space("LIMIT ?", filter.Limit)
+	}
+
+	// Сначала отобрать страницу результатов, затем построить фрагменты только для нее.
+	// Текст экранируется до выделения, чтобы HTML-разметкой во фрагменте были только теги <mark>
+	query, args, err := bqb.New(`
+		SELECT s.*, ts_headline('simple', `+htmlEscapeSQL("s.text")+`, websearch_to_tsquery('simple', ?),
+			'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5') AS snippet
+		FROM (
+			SELECT `+messageColumns+`, ts_rank(m.search_vector, q.query) AS rank
+			FROM messages m, websearch_to_tsquery('simple', ?) AS q(query)
+			?
+			ORDER BY rank DESC, m.created_at DESC, m.id DESC
+			?
+		) s
+		ORDER BY s.rank DESC, s.created_at DESC, s.id DESC
+	`, filter.Query, filter.Query, where, limit).ToPgsql()
+	if err != nil {
+		return nil, fmt.Errorf("bqb.ToPgsql: %w", err)
+	}
+
+	// Найти сообщения
+	var rows []dbSearchResult
+	if err := r.DB().Select(&rows, query, args...); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	// Если сообщений нет, сразу вернуть пустой список
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	// Загрузить версии и реакции найденных сообщений
+	dbMessages := make([]dbMessage, len(rows))
+	for i, row := range rows {
+		dbMessages[i] = row.dbMessage
+	}
+	messages, err := r.withRelations(dbMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]messagee.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = messagee.SearchResult{
+			Message: messages[i],
+			Rank:    row.Rank,
+			Snippet: row.Snippet,
+		}
+	}
+
+	return results, nil
+}
+
+func (r *MessageeRepository) CountUnread(userID uuid.UUID, markers []messagee.ReadMarker) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(markers))
+	if len(markers) == 0 {
+		return counts, nil
+	}
+
+	// Разложить отметки прочтения на параллельные массивы
+	chatIDs := make([]string, len(markers))
+	readAts := make([]string, len(markers))
+	for i, marker := range markers {
+		chatIDs[i] = marker.ChatID.String()
+		readAts[i] = marker.ReadAt.UTC().Format(time.RFC3339Nano)
+		counts[marker.ChatID] = 0
+	}
+
+	// Посчитать сообщения после отметки прочтения в каждом чате
+	var rows []struct {
+		ChatID string `db:"chat_id"`
+		Count  int    `db:"count"`
+	}
+	if err := r.DB().Select(&rows, `
+		SELECT m.chat_id, COUNT(*) AS count
+		FROM messages m
+			JOIN unnest($2::text[], $3::timestamptz[]) AS rm(chat_id, read_at)
+				ON m.chat_id = rm.chat_id
+		WHERE m.author_id <> $1
+			AND m.created_at > rm.read_at
+			AND m.deleted_at IS NULL
+			AND m.parent_id IS NULL
+		GROUP BY m.chat_id
+	`, userID, pq.Array(chatIDs), pq.Array(readAts)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	for _, row := range rows {
+		counts[uuid.MustParse(row.ChatID)] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *MessageeRepository) Upsert(message messagee.Message) error {
+	if message.ID == uuid.Nil {
+		return fmt.Errorf("message ID is required")
+	}
+
+	if r.IsTx() {
+		return r.upsert(message)
+	} else {
+		return r.InTransaction(func(txRepo messagee.Repository) error {
+			return txRepo.Upsert(message)
+		})
+	}
+}
+
+func (r *MessageeRepository) upsert(message messagee.Message) error {
+	if _, err := r.DB().NamedExec(`
+		INSERT INTO messages(id, chat_id, author_id, text, created_at, edited_at, deleted_at, expires_at,
+		                     parent_id, reply_count, last_reply_at,
+		                     forwarded_message_id, forwarded_chat_id, forwarded_author_id, forwarded_created_at,
+		                     integration_name, search_vector)
+		VALUES (:id, :chat_id, :author_id, :text, :created_at, :edited_at, :deleted_at, :expires_at,
+		        :parent_id, :reply_count, :last_reply_at,
+		        :forwarded_message_id, :forwarded_chat_id, :forwarded_author_id, :forwarded_created_at,
+		        :integration_name, to_tsvector('simple', :text))
+		ON CONFLICT (id) DO UPDATE SET
+			chat_id=excluded.chat_id,
+			author_id=excluded.author_id,
+			text=excluded.text,
+			created_at=excluded.created_at,
+			edited_at=excluded.edited_at,
+			deleted_at=excluded.deleted_at,
+			expires_at=excluded.expires_at,
+			parent_id=excluded.parent_id,
+			reply_count=excluded.reply_count,
+			last_reply_at=excluded.last_reply_at,
+			forwarded_message_id=excluded.forwarded_message_id,
+			forwarded_chat_id=excluded.forwarded_chat_id,
+			forwarded_author_id=excluded.forwarded_author_id,
+			forwarded_created_at=excluded.forwarded_created_at,
+			integration_name=excluded.integration_name,
+			search_vector=excluded.search_vector
+	`, toDBMessage(message)); err != nil {
+		return fmt.Errorf("r.DB().NamedExec: %w", err)
+	}
+
+	// Удалить прошлое форматирование
+	if _, err := r.DB().Exec(`
+		DELETE FROM message_entities WHERE message_id = $1
+	`, message.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	if len(message.Entities) > 0 {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO message_entities(message_id, position, type, start, length, url)
+			VALUES (:message_id, :position, :type, :start, :length, :url)
+		`, toDBMessageEntities(message)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+	}
+
+	// Удалить прошлые версии
+	if _, err := r.DB().Exec(`
+		DELETE FROM message_revisions WHERE message_id = $1
+	`, message.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	if len(message.Revisions) > 0 {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO message_revisions(message_id, text, created_at)
+			VALUES (:message_id, :text, :created_at)
+		`, toDBRevisions(message)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+	}
+
+	// Удалить прошлые реакции
+	if _, err := r.DB().Exec(`
+		DELETE FROM message_reactions WHERE message_id = $1
+	`, message.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	if len(message.Reactions) > 0 {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO message_reactions(message_id, user_id, emoji, created_at)
+			VALUES (:message_id, :user_id, :emoji, :created_at)
+		`, toDBReactions(message)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+	}
+
+	// Удалить прошлые вложения
+	if _, err := r.DB().Exec(`
+		DELETE FROM message_attachments WHERE message_id = $1
+	`, message.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	if len(message.AttachmentIDs) > 0 {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO message_attachments(message_id, attachment_id, position)
+			VALUES (:message_id, :attachment_id, :position)
+		`, toDBMessageAttachments(message)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+	}
+
+	// Удалить прошлые упоминания
+	if _, err := r.DB().Exec(`
+		DELETE FROM message_mentions WHERE message_id = $1
+	`, message.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	if len(message.Mentions) > 0 {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO message_mentions(message_id, user_id, position)
+			VALUES (:message_id, :user_id, :position)
+		`, toDBMessageMentions(message)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+	}
+
+	// Удалить прошлый опрос, голоса удаляются каскадно
+	if _, err := r.DB().Exec(`
+		DELETE FROM polls WHERE message_id = $1
+	`, message.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	if message.Poll != nil {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO polls(message_id, question, options, multiple_choice, anonymous, closes_at)
+			VALUES (:message_id, :question, :options, :multiple_choice, :anonymous, :closes_at)
+		`, toDBPoll(message)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+
+		if len(message.Poll.Votes) > 0 {
+			if _, err := r.DB().NamedExec(`
+				INSERT INTO poll_votes(message_id, user_id, option_index, created_at)
+				VALUES (:message_id, :user_id, :option_index, :created_at)
+			`, toDBPollVotes(message)); err != nil {
+				return fmt.Errorf("r.DB().NamedExec: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *MessageeRepository) Delete(ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	messageIDs := make([]string, len(ids))
+	for i, id := range ids {
+		messageIDs[i] = id.String()
+	}
+
+	// Форматирование, версии, реакции, ссылки на вложения, упоминания, опросы и ответы удаляются каскадно
+	if _, err := r.DB().Exec(`
+		DELETE FROM messages WHERE id = ANY($1)
+	`, pq.Array(messageIDs)); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MessageeRepository) InTransaction(fn func(txRepo messagee.Repository) error) error {
+	return r.SqlxRepo.InTransaction(func(txSqlxRepo sqlxRepo.SqlxRepo) error {
+		return fn(&MessageeRepository{SqlxRepo: txSqlxRepo})
+	})
+}
+
+type dbMessage struct {
+	ID        string       `db:"id"`
+	ChatID    string       `db:"chat_id"`
+	AuthorID  string       `db:"author_id"`
+	Text      string       `db:"text"`
+	CreatedAt time.Time    `db:"created_at"`
+	EditedAt  sql.NullTime `db:"edited_at"`
+	DeletedAt sql.NullTime `db:"deleted_at"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+
+	ParentID    sql.NullString `db:"parent_id"`
+	ReplyCount  int            `db:"reply_count"`
+	LastReplyAt sql.NullTime   `db:"last_reply_at"`
+
+	ForwardedMessageID sql.NullString `db:"forwarded_message_id"`
+	ForwardedChatID    sql.NullString `db:"forwarded_chat_id"`
+	ForwardedAuthorID  sql.NullString `db:"forwarded_author_id"`
+	ForwardedCreatedAt sql.NullTime   `db:"forwarded_created_at"`
+
+	IntegrationName string `db:"integration_name"`
+}
+
+// htmlEscapeSQL возвращает SQL-выражение, экранирующее спецсимволы HTML в значении column
+func htmlEscapeSQL(column string) string {
+	return `replace(replace(replace(replace(replace(` + column + `,
+		'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+}
+
+type dbSearchResult struct {
+	dbMessage
+	Rank    float32 `db:"rank"`
+	Snippet string  `db:"snippet"`
+}
+
+func toDBMessage(message messagee.Message) dbMessage {
+	return dbMessage{
+		ID:        message.ID.String(),
+		ChatID:    message.ChatID.String(),
+		AuthorID:  message.AuthorID.String(),
+		Text:      message.Text,
+		CreatedAt: message.CreatedAt,
+		EditedAt:  toNullTime(message.EditedAt),
+		DeletedAt: toNullTime(message.DeletedAt),
+		ExpiresAt: toNullTime(message.ExpiresAt),
+
+		ParentID:    toNullUUID(message.ParentID),
+		ReplyCount:  message.ReplyCount,
+		LastReplyAt: toNullTime(message.LastReplyAt),
+
+		ForwardedMessageID: toNullUUID(message.ForwardedFrom.MessageID),
+		ForwardedChatID:    toNullUUID(message.ForwardedFrom.ChatID),
+		ForwardedAuthorID:  toNullUUID(message.ForwardedFrom.AuthorID),
+		ForwardedCreatedAt: toNullTime(message.ForwardedFrom.CreatedAt),
+
+		IntegrationName: message.IntegrationName,
+	}
+}
+
+func toDomainMessage(message dbMessage, entities []dbMessageEntity, revisions []dbRevision, reactions []dbReaction, attachments []dbMessageAttachment, mentions []dbMessageMention, poll *messagee.Poll) messagee.Message {
+	return messagee.Message{
+		ID:        uuid.MustParse(message.ID),
+		ChatID:    uuid.MustParse(message.ChatID),
+		AuthorID:  uuid.MustParse(message.AuthorID),
+		Text:      message.Text,
+		Entities:  toDomainMessageEntities(entities),
+		CreatedAt: message.CreatedAt.UTC(),
+		EditedAt:  fromNullTime(message.EditedAt),
+		DeletedAt: fromNullTime(message.DeletedAt),
+		ExpiresAt: fromNullTime(message.ExpiresAt),
+
+		ParentID:    fromNullUUID(message.ParentID),
+		ReplyCount:  message.ReplyCount,
+		LastReplyAt: fromNullTime(message.LastReplyAt),
+
+		ForwardedFrom: messagee.Forward{
+			MessageID: fromNullUUID(message.ForwardedMessageID),
+			ChatID:    fromNullUUID(message.ForwardedChatID),
+			AuthorID:  fromNullUUID(message.ForwardedAuthorID),
+			CreatedAt: fromNullTime(message.ForwardedCreatedAt),
+		},
+
+		IntegrationName: message.IntegrationName,
+
+		Revisions:     toDomainRevisions(revisions),
+		Reactions:     toDomainReactions(reactions),
+		AttachmentIDs: toDomainMessageAttachments(attachments),
+		Mentions:      toDomainMessageMentions(mentions),
+
+		Poll: poll,
+	}
+}
+
+func toDomainMessages(
+	messages []dbMessage,
+	entities map[string][]dbMessageEntity,
+	revisions map[string][]dbRevision,
+	reactions map[string][]dbReaction,
+	attachments map[string][]dbMessageAttachment,
+	mentions map[string][]dbMessageMention,
+	polls map[string]*messagee.Poll,
+) []messagee.Message {
+	domainMessages := make([]messagee.Message, len(messages))
+	for i, message := range messages {
+		domainMessages[i] = toDomainMessage(message, entities[message.ID], revisions[message.ID], reactions[message.ID], attachments[message.ID], mentions[message.ID], polls[message.ID])
+	}
+
+	return domainMessages
+}
+
+type dbRevision struct {
+	MessageID string    `db:"message_id"`
+	Text      string    `db:"text"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func toDBRevisions(message messagee.Message) []dbRevision {
+	dbRevisions := make([]dbRevision, len(message.Revisions))
+	for i, rev := range message.Revisions {
+		dbRevisions[i] = dbRevision{
+			MessageID: message.ID.String(),
+			Text:      rev.Text,
+			CreatedAt: rev.CreatedAt,
+		}
+	}
+
+	return dbRevisions
+}
+
+func toDomainRevisions(revisions []dbRevision) []messagee.Revision {
+	rr := make([]messagee.Revision, len(revisions))
+	for i, rev := range revisions {
+		rr[i] = messagee.Revision{
+			Text:      rev.Text,
+			CreatedAt: rev.CreatedAt.UTC(),
+		}
+	}
+
+	return rr
+}
+
+type dbReaction struct {
+	MessageID string    `db:"message_id"`
+	UserID    string    `db:"user_id"`
+	Emoji     string    `db:"emoji"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func toDBReactions(message messagee.Message) []dbReaction {
+	dbReactions := make([]dbReaction, len(message.Reactions))
+	for i, reaction := range message.Reactions {
+		dbReactions[i] = dbReaction{
+			MessageID: message.ID.String(),
+			UserID:    reaction.UserID.String(),
+			Emoji:     reaction.Emoji,
+			CreatedAt: reaction.CreatedAt,
+		}
+	}
+
+	return dbReactions
+}
+
+func toDomainReactions(reactions []dbReaction) []messagee.Reaction {
+	rr := make([]messagee.Reaction, len(reactions))
+	for i, reaction := range reactions {
+		rr[i] = messagee.Reaction{
+			UserID:    uuid.MustParse(reaction.UserID),
+			Emoji:     reaction.Emoji,
+			CreatedAt: reaction.CreatedAt.UTC(),
+		}
+	}
+
+	return rr
+}
+
+// toNullTime преобразует нулевое время в NULL
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// fromNullTime преобразует NULL в нулевое время
+func fromNullTime(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time.UTC()
+}
+
+// toNullUUID преобразует нулевой UUID в NULL
+func toNullUUID(id uuid.UUID) sql.NullString {
+	return sql.NullString{String: id.String(), Valid: id != uuid.Nil}
+}
+
+// fromNullUUID преобразует NULL в нулевой UUID
+func fromNullUUID(id sql.NullString) uuid.UUID {
+	if !id.Valid {
+		return uuid.Nil
+	}
+
+	return uuid.MustParse(id.String)
+}
+
+type dbMessageAttachment struct {
+	MessageID    string `db:"message_id"`
+	AttachmentID string `db:"attachment_id"`
+	Position     int    `db:"position"`
+}
+
+func toDBMessageAttachments(message messagee.Message) []dbMessageAttachment {
+	attachments := make([]dbMessageAttachment, len(message.AttachmentIDs))
+	for i, id := range message.AttachmentIDs {
+		attachments[i] = dbMessageAttachment{
+			MessageID:    message.ID.String(),
+			AttachmentID: id.String(),
+			Position:     i,
+		}
+	}
+
+	return attachments
+}
+
+func toDomainMessageAttachments(attachments []dbMessageAttachment) []uuid.UUID {
+	ids := make([]uuid.UUID, len(attachments))
+	for i, attachment := range attachments {
+		ids[i] = uuid.MustParse(attachment.AttachmentID)
+	}
+
+	return ids
+}
+
+type dbMessageMention struct {
+	MessageID string `db:"message_id"`
+	UserID    string `db:"user_id"`
+	Position  int    `db:"position"`
+}
+
+func toDBMessageMentions(message messagee.Message) []dbMessageMention {
+	mentions := make([]dbMessageMention, len(message.Mentions))
+	for i, id := range message.Mentions {
+		mentions[i] = dbMessageMention{
+			MessageID: message.ID.String(),
+			UserID:    id.String(),
+			Position:  i,
+		}
+	}
+
+	return mentions
+}
+
+func toDomainMessageMentions(mentions []dbMessageMention) []uuid.UUID {
+	ids := make([]uuid.UUID, len(mentions))
+	for i, mention := range mentions {
+		ids[i] = uuid.MustParse(mention.UserID)
+	}
+
+	return ids
+}
+
+type dbMessageEntity struct {
+	MessageID string `db:"message_id"`
+	Position  int    `db:"position"`
+	Type      string `db:"type"`
+	Start     int    `db:"start"`
+	Length    int    `db:"length"`
+	URL       string `db:"url"`
+}
+
+func toDBMessageEntities(message messagee.Message) []dbMessageEntity {
+	entities := make([]dbMessageEntity, len(message.Entities))
+	for i, entity := range message.Entities {
+		entities[i] = dbMessageEntity{
+			MessageID: message.ID.String(),
+			Position:  i,
+			Type:      entity.Type,
+			Start:     entity.Offset,
+			Length:    entity.Length,
+			URL:       entity.URL,
+		}
+	}
+
+	return entities
+}
+
+func toDomainMessageEntities(entities []dbMessageEntity) []messagee.Entity {
+	ee := make([]messagee.Entity, len(entities))
+	for i, entity := range entities {
+		ee[i] = messagee.Entity{
+			Type:   entity.Type,
+			Offset: entity.Start,
+			Length: entity.Length,
+			URL:    entity.URL,
+		}
+	}
+
+	return ee
+}
+
+type dbPoll struct {
+	MessageID      string         `db:"message_id"`
+	Question       string         `db:"question"`
+	Options        pq.StringArray `db:"options"`
+	MultipleChoice bool           `db:"multiple_choice"`
+	Anonymous      bool           `db:"anonymous"`
+	ClosesAt       sql.NullTime   `db:"closes_at"`
+}
+
+func toDBPoll(message messagee.Message) dbPoll {
+	return dbPoll{
+		MessageID:      message.ID.String(),
+		Question:       message.Poll.Question,
+		Options:        message.Poll.Options,
+		MultipleChoice: message.Poll.MultipleChoice,
+		Anonymous:      message.Poll.Anonymous,
+		ClosesAt:       toNullTime(message.Poll.ClosesAt),
+	}
+}
+
+func toDomainPoll(poll dbPoll) *messagee.Poll {
+	return &messagee.Poll{
+		Question:       poll.Question,
+		Options:        poll.Options,
+		MultipleChoice: poll.MultipleChoice,
+		Anonymous:      poll.Anonymous,
+		ClosesAt:       fromNullTime(poll.ClosesAt),
+		Votes:          []messagee.Vote{},
+	}
+}
+
+type dbPollVote struct {
+	MessageID   string    `db:"message_id"`
+	UserID      string    `db:"user_id"`
+	OptionIndex int       `db:"option_index"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func toDBPollVotes(message messagee.Message) []dbPollVote {
+	votes := make([]dbPollVote, len(message.Poll.Votes))
+	for i, vote := range message.Poll.Votes {
+		votes[i] = dbPollVote{
+			MessageID:   message.ID.String(),
+			UserID:      vote.UserID.String(),
+			OptionIndex: vote.Option,
+			CreatedAt:   vote.CreatedAt,
+		}
+	}
+
+	return votes
+}
+
+func toDomainPollVote(vote dbPollVote) messagee.Vote {
+	return messagee.Vote{
+		UserID:    uuid.MustParse(vote.UserID),
+		Option:    vote.OptionIndex,
+		CreatedAt: vote.CreatedAt.UTC(),
+	}
+}
@@ -0,0 +1,732 @@
+package pgsqlRepository
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/common"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+func (suite *Suite) Test_MessageeRepository() {
+	suite.Run("List", func() {
+		suite.Run("из пустого репозитория вернется пустой список", func() {
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Empty(messages)
+		})
+
+		suite.Run("без фильтра из репозитория вернутся все сохраненные сообщения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			messages := make([]messagee.Message, 10)
+			for i := range messages {
+				messages[i] = suite.upsertMessage(suite.rndMessage(chat))
+			}
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Len(messagesFromRepo, len(messages))
+		})
+
+		suite.Run("с фильтром по ID вернется сохраненное сообщение", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			// Создать много сообщений
+			messages := make([]messagee.Message, 10)
+			for i := range messages {
+				messages[i] = suite.upsertMessage(suite.rndMessage(chat))
+			}
+			// Определить случайное искомое сообщение
+			expectedMessage := common.RndElem(messages)
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				ID: expectedMessage.ID,
+			})
+			// Сравнить ожидания и результат
+			suite.NoError(err)
+			suite.Require().Len(messagesFromRepo, 1)
+			suite.Equal(expectedMessage, messagesFromRepo[0])
+		})
+
+		suite.Run("с фильтром по IDs вернутся только указанные сообщения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			// Создать много сообщений
+			messages := make([]messagee.Message, 10)
+			for i := range messages {
+				messages[i] = suite.upsertMessage(suite.rndMessage(chat))
+			}
+			// Определить искомые сообщения
+			expectedMessages := []messagee.Message{messages[2], messages[5], messages[7]}
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				IDs: []uuid.UUID{messages[2].ID, messages[5].ID, messages[7].ID},
+			})
+			// Сравнить ожидания и результат
+			suite.NoError(err)
+			suite.ElementsMatch(expectedMessages, messagesFromRepo)
+		})
+
+		suite.Run("с фильтром по ChatID вернутся сообщения этого чата", func() {
+			// Создать сообщения в разных чатах
+			chats := make([]chatt.Chat, 5)
+			for i := range chats {
+				chats[i] = suite.upsertChat(suite.rndChat())
+				for range 3 {
+					suite.upsertMessage(suite.rndMessage(chats[i]))
+				}
+			}
+			// Определить случайный искомый чат
+			expectedChat := common.RndElem(chats)
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				ChatID: expectedChat.ID,
+			})
+			// Сравнить ожидания и результат
+			suite.NoError(err)
+			suite.Require().Len(messagesFromRepo, 3)
+			for _, m := range messagesFromRepo {
+				suite.Equal(expectedChat.ID, m.ChatID)
+			}
+		})
+
+		suite.Run("с фильтром по ChatIDs вернутся сообщения этих чатов", func() {
+			// Создать сообщения в разных чатах
+			chats := make([]chatt.Chat, 5)
+			for i := range chats {
+				chats[i] = suite.upsertChat(suite.rndChat())
+				for range 3 {
+					suite.upsertMessage(suite.rndMessage(chats[i]))
+				}
+			}
+			expectedChatIDs := []uuid.UUID{chats[0].ID, chats[3].ID}
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				ChatIDs: expectedChatIDs,
+			})
+			// Сравнить ожидания и результат
+			suite.NoError(err)
+			suite.Require().Len(messagesFromRepo, 6)
+			for _, m := range messagesFromRepo {
+				suite.Contains(expectedChatIDs, m.ChatID)
+			}
+		})
+
+		suite.Run("с фильтром по MentionedUserID вернутся сообщения с упоминанием пользователя", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			suite.addRndParticipant(&chat)
+			suite.upsertChat(chat)
+			mentioned, other := chat.Participants[1].UserID, chat.Participants[2].UserID
+			// Создать сообщения с разными упоминаниями
+			suite.upsertMessage(suite.rndMessage(chat))
+			suite.upsertMessage(suite.rndMessageWithMentions(chat, other))
+			expected := []messagee.Message{
+				suite.upsertMessage(suite.rndMessageWithMentions(chat, mentioned)),
+				suite.upsertMessage(suite.rndMessageWithMentions(chat, other, mentioned)),
+			}
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				MentionedUserID: mentioned,
+			})
+			// Сравнить ожидания и результат
+			suite.NoError(err)
+			suite.Require().Len(messagesFromRepo, len(expected))
+			for _, m := range messagesFromRepo {
+				suite.Contains(m.Mentions, mentioned)
+			}
+		})
+
+		suite.Run("с фильтром по ParentID вернутся ответы в треде", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			parent := suite.rndMessage(chat)
+			// Создать ответы в треде и обычные сообщения
+			var replies []messagee.Message
+			for range 3 {
+				reply, err := messagee.NewReply(chat, &parent, chat.ChiefID, gofakeit.Sentence(5), nil)
+				suite.Require().NoError(err)
+				replies = append(replies, reply)
+				suite.upsertMessage(suite.rndMessage(chat))
+			}
+			suite.upsertMessage(parent)
+			for _, reply := range replies {
+				suite.upsertMessage(reply)
+			}
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				ParentID: parent.ID,
+			})
+			suite.NoError(err)
+			suite.Require().Len(messagesFromRepo, len(replies))
+			for _, m := range messagesFromRepo {
+				suite.Equal(parent.ID, m.ParentID)
+			}
+		})
+
+		suite.Run("с фильтром RootsOnly не вернутся ответы в треде", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			parent := suite.rndMessage(chat)
+			reply, err := messagee.NewReply(chat, &parent, chat.ChiefID, gofakeit.Sentence(5), nil)
+			suite.Require().NoError(err)
+			suite.upsertMessage(parent)
+			suite.upsertMessage(reply)
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				RootsOnly: true,
+			})
+			suite.NoError(err)
+			suite.Require().Len(messagesFromRepo, 1)
+			suite.Equal(parent, messagesFromRepo[0])
+		})
+
+		suite.Run("с фильтром CreatedBefore вернутся сообщения с меньшим CreatedAt", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			// Создать сообщения с разными CreatedAt
+			now := time.Now().UTC().Truncate(time.Microsecond)
+			for _, duration := range []time.Duration{
+				time.Hour,
+				time.Second,
+				time.Hour * 2,
+				time.Minute,
+			} {
+				message := suite.rndMessage(chat)
+				message.CreatedAt = now.Add(duration)
+				suite.upsertMessage(message)
+			}
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				CreatedBefore: now.Add(time.Hour),
+			})
+			suite.NoError(err)
+			suite.Require().Len(messagesFromRepo, 2)
+			suite.True(now.Add(time.Minute).Equal(messagesFromRepo[0].CreatedAt))
+			suite.True(now.Add(time.Second).Equal(messagesFromRepo[1].CreatedAt))
+		})
+
+		suite.Run("с фильтром ExpiresBefore вернутся сообщения с наступившим временем самоуничтожения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			now := time.Now().UTC().Truncate(time.Microsecond)
+			suite.upsertMessage(suite.rndMessage(chat))
+			expired := suite.rndMessage(chat)
+			expired.ExpiresAt = now.Add(-time.Second)
+			suite.upsertMessage(expired)
+			notExpired := suite.rndMessage(chat)
+			notExpired.ExpiresAt = now.Add(time.Hour)
+			suite.upsertMessage(notExpired)
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				ExpiresBefore: now,
+			})
+			suite.NoError(err)
+			suite.Equal([]messagee.Message{expired}, messagesFromRepo)
+		})
+
+		suite.Run("с limit вернется ограниченное количество последних сообщений", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			// Создать сообщения
+			const limit = 10
+			var createdMessages []messagee.Message
+			for range limit * 2 {
+				createdMessages = append(createdMessages, suite.upsertMessage(suite.rndMessage(chat)))
+				time.Sleep(time.Millisecond * 10)
+			}
+
+			// Получить список
+			messagesFromRepo, err := suite.RR.Messages.List(messagee.Filter{
+				Limit: limit,
+			})
+			suite.NoError(err)
+			suite.Require().Len(messagesFromRepo, limit)
+
+			for i := 0; i < limit; i++ {
+				expectedIdx := len(createdMessages) - 1 - i
+				suite.Equal(createdMessages[expectedIdx], messagesFromRepo[i])
+			}
+		})
+	})
+
+	suite.Run("Upsert", func() {
+		suite.Run("нельзя сохранять сообщение без ID", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message := suite.rndMessage(chat)
+			message.ID = uuid.Nil
+			err := suite.RR.Messages.Upsert(message)
+			suite.Error(err)
+		})
+
+		suite.Run("нельзя сохранять сообщение в несуществующий чат", func() {
+			err := suite.RR.Messages.Upsert(suite.rndMessage(suite.rndChat()))
+			suite.Error(err)
+		})
+
+		suite.Run("сохраненное сообщение полностью соответствует сохраняемому", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message := suite.rndMessage(chat)
+
+			// Сохранить сообщение
+			err := suite.RR.Messages.Upsert(message)
+			suite.Require().NoError(err)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+		})
+
+		suite.Run("сохраненная история версий соответствует сохраняемой", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message := suite.rndMessage(chat)
+			// Отредактировать сообщение несколько раз
+			for range 3 {
+				err := message.Edit(chat, chat.ChiefID, gofakeit.Sentence(5), nil, nil)
+				suite.Require().NoError(err)
+				time.Sleep(time.Millisecond)
+			}
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+			suite.Len(messages[0].Revisions, 3)
+		})
+
+		suite.Run("сохраненные реакции соответствуют сохраняемым", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			suite.upsertChat(chat)
+			message := suite.rndMessage(chat)
+			// Поставить реакции от разных участников
+			for _, p := range chat.Participants {
+				for _, emoji := range []string{"👍", "🔥"} {
+					reaction, err := messagee.NewReaction(p.UserID, emoji)
+					suite.Require().NoError(err)
+					suite.Require().NoError(message.AddReaction(chat, reaction, nil))
+					time.Sleep(time.Millisecond)
+				}
+			}
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+			suite.Len(messages[0].Reactions, 4)
+		})
+
+		suite.Run("сохраненные вложения соответствуют сохраняемым", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			attachments := make([]attachmentt.Attachment, 3)
+			for i := range attachments {
+				attachments[i] = suite.upsertAttachment(suite.rndAttachment(chat))
+			}
+			message, err := messagee.NewMessageWithAttachments(chat, chat.ChiefID, "", attachments, nil, nil)
+			suite.Require().NoError(err)
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+			suite.Len(messages[0].AttachmentIDs, 3)
+		})
+
+		suite.Run("сохраненные упоминания соответствуют сохраняемым в исходном порядке", func() {
+			chat := suite.rndChat()
+			for range 3 {
+				suite.addRndParticipant(&chat)
+			}
+			suite.upsertChat(chat)
+			message := suite.rndMessageWithMentions(chat, chat.Participants[3].UserID, chat.Participants[1].UserID)
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+			suite.Equal([]uuid.UUID{chat.Participants[3].UserID, chat.Participants[1].UserID}, messages[0].Mentions)
+		})
+
+		suite.Run("сохраненное форматирование соответствует сохраняемому", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message, err := messagee.NewMessage(chat, chat.ChiefID, "**жирный __курсив__** `код` [ссылка](https://example.com)", nil)
+			suite.Require().NoError(err)
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+			suite.Len(messages[0].Entities, 4)
+		})
+
+		suite.Run("сохраненный опрос соответствует сохраняемому вместе с голосами", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			suite.upsertChat(chat)
+			participant := chat.Participants[1]
+			closesAt := time.Now().Add(time.Hour)
+			poll, err := messagee.NewPoll("question", []string{"a", "b", "c"}, true, false, closesAt)
+			suite.Require().NoError(err)
+			message, err := messagee.NewPollMessage(chat, chat.ChiefID, poll, nil)
+			suite.Require().NoError(err)
+			suite.Require().NoError(message.Vote(chat, chat.ChiefID, []int{0, 2}, nil))
+			suite.Require().NoError(message.Vote(chat, participant.UserID, []int{1}, nil))
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+			suite.Len(messages[0].Poll.Votes, 3)
+
+			// Отозвать голос и перезаписать
+			suite.Require().NoError(message.RetractVote(chat, chat.ChiefID, nil))
+			suite.upsertMessage(message)
+			messages, err = suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+		})
+
+		suite.Run("сохраненная пересланная копия ссылается на исходное сообщение", func() {
+			source := suite.upsertChat(suite.rndChat())
+			target := suite.upsertChat(suite.rndChat())
+			original := suite.upsertMessage(suite.rndMessage(source))
+			message, err := messagee.NewForwardedMessage(target, target.ChiefID, original, nil)
+			suite.Require().NoError(err)
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{ID: message.ID})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+			suite.Equal(original.ID, messages[0].ForwardedFrom.MessageID)
+		})
+
+		suite.Run("удаленное сообщение сохраняется как метка удаления", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message := suite.rndMessage(chat)
+			suite.Require().NoError(message.Edit(chat, chat.ChiefID, gofakeit.Sentence(5), nil, nil))
+			suite.upsertMessage(message)
+			// Удалить сообщение
+			suite.Require().NoError(message.Delete(chat, chat.ChiefID, nil))
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+			suite.True(messages[0].IsDeleted())
+			suite.Empty(messages[0].Revisions)
+		})
+
+		suite.Run("перезапись с новыми значениями по ID", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message := suite.upsertMessage(suite.rndMessage(chat))
+			// Изменить текст сообщения
+			message.Text = gofakeit.Sentence(5)
+			suite.upsertMessage(message)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Require().Len(messages, 1)
+			suite.Equal(message, messages[0])
+		})
+	})
+
+	suite.Run("Delete", func() {
+		suite.Run("удаляются только указанные сообщения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			deleted := suite.upsertMessage(suite.rndMessage(chat))
+			kept := suite.upsertMessage(suite.rndMessage(chat))
+
+			err := suite.RR.Messages.Delete([]uuid.UUID{deleted.ID})
+			suite.Require().NoError(err)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Equal([]messagee.Message{kept}, messages)
+		})
+
+		suite.Run("вместе с корневым сообщением удаляются ответы в треде", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			parent := suite.rndMessage(chat)
+			reply, err := messagee.NewReply(chat, &parent, chat.ChiefID, gofakeit.Sentence(5), nil)
+			suite.Require().NoError(err)
+			reaction, err := messagee.NewReaction(chat.ChiefID, "👍")
+			suite.Require().NoError(err)
+			suite.Require().NoError(reply.AddReaction(chat, reaction, nil))
+			suite.upsertMessage(parent)
+			suite.upsertMessage(reply)
+
+			err = suite.RR.Messages.Delete([]uuid.UUID{parent.ID})
+			suite.Require().NoError(err)
+
+			// Прочитать из репозитория
+			messages, err := suite.RR.Messages.List(messagee.Filter{})
+			suite.NoError(err)
+			suite.Empty(messages)
+		})
+	})
+
+	suite.Run("Search", func() {
+		suite.Run("из пустого репозитория вернется пустой список", func() {
+			results, err := suite.RR.Messages.Search(messagee.SearchFilter{Query: "слово"})
+			suite.NoError(err)
+			suite.Empty(results)
+		})
+
+		suite.Run("вернутся только сообщения, содержащие слова запроса", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			expected := suite.upsertMessage(suite.newMessage(chat, chat.ChiefID, "решили переехать на postgres"))
+			suite.upsertMessage(suite.newMessage(chat, chat.ChiefID, "обсуждаем дизайн"))
+
+			results, err := suite.RR.Messages.Search(messagee.SearchFilter{Query: "postgres"})
+			suite.NoError(err)
+			suite.Require().Len(results, 1)
+			suite.Equal(expected, results[0].Message)
+			suite.Positive(results[0].Rank)
+			suite.Contains(results[0].Snippet, "<mark>postgres</mark>")
+		})
+
+		suite.Run("разметка из текста сообщения во фрагменте экранируется", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			suite.upsertMessage(suite.newMessage(chat, chat.ChiefID, `<img src=x onerror="alert(1)"> postgres`))
+
+			results, err := suite.RR.Messages.Search(messagee.SearchFilter{Query: "postgres"})
+			suite.NoError(err)
+			suite.Require().Len(results, 1)
+			suite.NotContains(results[0].Snippet, "<img")
+			suite.Contains(results[0].Snippet, "&lt;img")
+			suite.Contains(results[0].Snippet, "<mark>postgres</mark>")
+		})
+
+		suite.Run("отредактированное сообщение ищется по новому тексту", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message := suite.newMessage(chat, chat.ChiefID, "старый текст")
+			suite.Require().NoError(message.Edit(chat, chat.ChiefID, "новый текст", nil, nil))
+			suite.upsertMessage(message)
+
+			results, err := suite.RR.Messages.Search(messagee.SearchFilter{Query: "старый"})
+			suite.NoError(err)
+			suite.Empty(results)
+			results, err = suite.RR.Messages.Search(messagee.SearchFilter{Query: "новый"})
+			suite.NoError(err)
+			suite.Len(results, 1)
+		})
+
+		suite.Run("удаленные сообщения не ищутся", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message := suite.newMessage(chat, chat.ChiefID, "секретный текст")
+			suite.Require().NoError(message.Delete(chat, chat.ChiefID, nil))
+			suite.upsertMessage(message)
+
+			results, err := suite.RR.Messages.Search(messagee.SearchFilter{Query: "секретный"})
+			suite.NoError(err)
+			suite.Empty(results)
+		})
+
+		suite.Run("с фильтрами по чатам, автору и времени вернутся подходящие сообщения", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			suite.upsertChat(chat)
+			p := chat.Participants[1]
+			otherChat := suite.upsertChat(suite.rndChat())
+			suite.upsertMessage(suite.newMessage(otherChat, otherChat.ChiefID, "релиз в пятницу"))
+			suite.upsertMessage(suite.newMessage(chat, chat.ChiefID, "релиз в пятницу"))
+			time.Sleep(time.Millisecond)
+			expected := suite.upsertMessage(suite.newMessage(chat, p.UserID, "релиз в пятницу"))
+			time.Sleep(time.Millisecond)
+			suite.upsertMessage(suite.newMessage(chat, p.UserID, "релиз в пятницу"))
+
+			results, err := suite.RR.Messages.Search(messagee.SearchFilter{
+				Query:         "релиз",
+				ChatIDs:       []uuid.UUID{chat.ID},
+				AuthorID:      p.UserID,
+				CreatedAfter:  expected.CreatedAt.Add(-time.Microsecond),
+				CreatedBefore: expected.CreatedAt.Add(time.Microsecond),
+			})
+			suite.NoError(err)
+			suite.Require().Len(results, 1)
+			suite.Equal(expected.ID, results[0].Message.ID)
+		})
+
+		suite.Run("более релевантные сообщения возвращаются первыми", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			suite.upsertMessage(suite.newMessage(chat, chat.ChiefID, "деплой завтра, а потом отпуск и много других дел"))
+			relevant := suite.upsertMessage(suite.newMessage(chat, chat.ChiefID, "деплой деплой деплой"))
+
+			results, err := suite.RR.Messages.Search(messagee.SearchFilter{Query: "деплой"})
+			suite.NoError(err)
+			suite.Require().Len(results, 2)
+			suite.Equal(relevant.ID, results[0].Message.ID)
+			suite.GreaterOrEqual(results[0].Rank, results[1].Rank)
+		})
+
+		suite.Run("постраничный поиск вернет все сообщения без повторов", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			for range 7 {
+				suite.upsertMessage(suite.newMessage(chat, chat.ChiefID, "встреча "+gofakeit.Word()))
+			}
+
+			var after *messagee.SearchCursor
+			seen := make(map[uuid.UUID]bool)
+			for {
+				results, err := suite.RR.Messages.Search(messagee.SearchFilter{
+					Query: "встреча",
+					After: after,
+					Limit: 3,
+				})
+				suite.Require().NoError(err)
+				for _, r := range results {
+					suite.False(seen[r.Message.ID])
+					seen[r.Message.ID] = true
+				}
+				if len(results) < 3 {
+					break
+				}
+				cursor := results[len(results)-1].Cursor()
+				after = &cursor
+			}
+			suite.Len(seen, 7)
+		})
+	})
+
+	suite.Run("CountUnread", func() {
+		suite.Run("без отметок вернется пустой результат", func() {
+			counts, err := suite.RR.Messages.CountUnread(uuid.New(), nil)
+			suite.NoError(err)
+			suite.Empty(counts)
+		})
+
+		suite.Run("без отметки прочтения непрочитанными считаются все сообщения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			for range 3 {
+				suite.upsertMessage(suite.rndMessage(chat))
+			}
+
+			counts, err := suite.RR.Messages.CountUnread(uuid.New(), []messagee.ReadMarker{
+				{ChatID: chat.ID},
+			})
+			suite.NoError(err)
+			suite.Equal(map[uuid.UUID]int{chat.ID: 3}, counts)
+		})
+
+		suite.Run("считаются только сообщения после отметки прочтения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			messages := make([]messagee.Message, 5)
+			for i := range messages {
+				messages[i] = suite.upsertMessage(suite.rndMessage(chat))
+				time.Sleep(time.Millisecond)
+			}
+
+			counts, err := suite.RR.Messages.CountUnread(uuid.New(), []messagee.ReadMarker{
+				{ChatID: chat.ID, ReadAt: messages[1].CreatedAt},
+			})
+			suite.NoError(err)
+			suite.Equal(map[uuid.UUID]int{chat.ID: 3}, counts)
+		})
+
+		suite.Run("не считаются собственные, удаленные сообщения и ответы в тредах", func() {
+			chat := suite.rndChat()
+			suite.addRndParticipant(&chat)
+			suite.upsertChat(chat)
+			subjectID := chat.Participants[1].UserID
+			// Собственное сообщение
+			own, err := messagee.NewMessage(chat, subjectID, gofakeit.Sentence(5), nil)
+			suite.Require().NoError(err)
+			suite.upsertMessage(own)
+			// Удаленное сообщение
+			deleted := suite.rndMessage(chat)
+			suite.Require().NoError(deleted.Delete(chat, chat.ChiefID, nil))
+			suite.upsertMessage(deleted)
+			// Ответ в треде
+			parent := suite.rndMessage(chat)
+			reply, err := messagee.NewReply(chat, &parent, chat.ChiefID, gofakeit.Sentence(5), nil)
+			suite.Require().NoError(err)
+			suite.upsertMessage(parent)
+			suite.upsertMessage(reply)
+
+			counts, err := suite.RR.Messages.CountUnread(subjectID, []messagee.ReadMarker{
+				{ChatID: chat.ID},
+			})
+			suite.NoError(err)
+			suite.Equal(map[uuid.UUID]int{chat.ID: 1}, counts)
+		})
+
+		suite.Run("количество считается отдельно для каждого чата", func() {
+			chats := make([]chatt.Chat, 3)
+			markers := make([]messagee.ReadMarker, len(chats))
+			expected := make(map[uuid.UUID]int, len(chats))
+			for i := range chats {
+				chats[i] = suite.upsertChat(suite.rndChat())
+				for range i {
+					suite.upsertMessage(suite.rndMessage(chats[i]))
+				}
+				markers[i] = messagee.ReadMarker{ChatID: chats[i].ID}
+				expected[chats[i].ID] = i
+			}
+
+			counts, err := suite.RR.Messages.CountUnread(uuid.New(), markers)
+			suite.NoError(err)
+			suite.Equal(expected, counts)
+		})
+	})
+}
+
+// rndMessage создает случайное сообщение от главного администратора чата
+func (suite *Suite) rndMessage(chat chatt.Chat) messagee.Message {
+	suite.T().Helper()
+	message, err := messagee.NewMessage(chat, chat.ChiefID, gofakeit.Sentence(5), nil)
+	suite.Require().NoError(err)
+
+	return message
+}
+
+// newMessage создает сообщение с заданным автором и текстом
+func (suite *Suite) newMessage(chat chatt.Chat, authorID uuid.UUID, text string) messagee.Message {
+	suite.T().Helper()
+	message, err := messagee.NewMessage(chat, authorID, text, nil)
+	suite.Require().NoError(err)
+
+	return message
+}
+
+// rndMessageWithMentions создает случайное сообщение главного администратора с упоминаниями участников
+func (suite *Suite) rndMessageWithMentions(chat chatt.Chat, mentions ...uuid.UUID) messagee.Message {
+	suite.T().Helper()
+	m, err := messagee.NewMessageWithAttachments(chat, chat.ChiefID, gofakeit.Sentence(5), nil, mentions, nil)
+	suite.Require().NoError(err)
+	return m
+}
+
+// upsertMessage сохраняет сообщение в репозиторий
+func (suite *Suite) upsertMessage(message messagee.Message) messagee.Message {
+	suite.T().Helper()
+	err := suite.RR.Messages.Upsert(message)
+	suite.Require().NoError(err)
+
+	return message
+}
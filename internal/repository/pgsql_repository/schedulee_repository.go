@@ -0,0 +1,175 @@
+package pgsqlRepository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nullism/bqb"
+
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+	sqlxRepo "github.com/nice-pea/npchat/internal/repository/pgsql_repository/sqlx_repo"
+)
+
+type ScheduleeRepository struct {
+	sqlxRepo.SqlxRepo
+}
+
+// pendingCondition условие выборки ожидающих отправки сообщений
+const pendingCondition = "s.sent_at IS NULL AND s.canceled_at IS NULL AND s.failed_at IS NULL"
+
+func (r *ScheduleeRepository) List(filter schedulee.Filter) ([]schedulee.ScheduledMessage, error) {
+	sel := bqb.New("SELECT s.* FROM scheduled_messages s")
+	where := bqb.Optional("WHERE")
+
+	if filter.ID != uuid.Nil {
+		where = where.And("s.id = ?", filter.ID)
+	}
+	if filter.AuthorID != uuid.Nil {
+		where = where.And("s.author_id = ?", filter.AuthorID)
+	}
+	if filter.ChatID != uuid.Nil {
+		where = where.And("s.chat_id = ?", filter.ChatID)
+	}
+	if filter.PendingOnly {
+		where = where.And(pendingCondition)
+	}
+
+	q := bqb.New("? ? ORDER BY s.send_at", sel, where)
+	// В транзакции выбранные строки блокируются до ее завершения,
+	// чтобы изменения не пересекались с отправкой сообщений
+	if r.IsTx() {
+		q = q.Space("FOR UPDATE")
+	}
+
+	query, args, err := q.ToPgsql()
+	if err != nil {
+		return nil, fmt.Errorf("bqb.ToPgsql: %w", err)
+	}
+
+	var messages []dbScheduledMessage
+	if err := r.DB().Select(&messages, query, args...); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	return toDomainScheduledMessages(messages), nil
+}
+
+func (r *ScheduleeRepository) ClaimDue(before time.Time, limit int) ([]schedulee.ScheduledMessage, error) {
+	// Блокировка имеет смысл только в транзакции
+	if !r.IsTx() {
+		return nil, errors.New("ClaimDue must be called in transaction")
+	}
+
+	var messages []dbScheduledMessage
+	if err := r.DB().Select(&messages, `
+		SELECT s.*
+		FROM scheduled_messages s
+		WHERE `+pendingCondition+` AND s.send_at <= $1
+		ORDER BY s.send_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, before, limit); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	return toDomainScheduledMessages(messages), nil
+}
+
+func (r *ScheduleeRepository) Upsert(message schedulee.ScheduledMessage) error {
+	if message.ID == uuid.Nil {
+		return fmt.Errorf("scheduled message ID is required")
+	}
+
+	if _, err := r.DB().NamedExec(`
+		INSERT INTO scheduled_messages(id, chat_id, author_id, text, parent_id, send_at, created_at,
+		                               sent_at, message_id, canceled_at, failed_at, fail_reason)
+		VALUES (:id, :chat_id, :author_id, :text, :parent_id, :send_at, :created_at,
+		        :sent_at, :message_id, :canceled_at, :failed_at, :fail_reason)
+		ON CONFLICT (id) DO UPDATE SET
+			chat_id=excluded.chat_id,
+			author_id=excluded.author_id,
+			text=excluded.text,
+			parent_id=excluded.parent_id,
+			send_at=excluded.send_at,
+			created_at=excluded.created_at,
+			sent_at=excluded.sent_at,
+			message_id=excluded.message_id,
+			canceled_at=excluded.canceled_at,
+			failed_at=excluded.failed_at,
+			fail_reason=excluded.fail_reason
+	`, toDBScheduledMessage(message)); err != nil {
+		return fmt.Errorf("r.DB().NamedExec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ScheduleeRepository) InTransaction(fn func(txRepo schedulee.Repository) error) error {
+	return r.SqlxRepo.InTransaction(func(txSqlxRepo sqlxRepo.SqlxRepo) error {
+		return fn(&ScheduleeRepository{SqlxRepo: txSqlxRepo})
+	})
+}
+
+type dbScheduledMessage struct {
+	ID        string         `db:"id"`
+	ChatID    string         `db:"chat_id"`
+	AuthorID  string         `db:"author_id"`
+	Text      string         `db:"text"`
+	ParentID  sql.NullString `db:"parent_id"`
+	SendAt    time.Time      `db:"send_at"`
+	CreatedAt time.Time      `db:"created_at"`
+
+	SentAt     sql.NullTime   `db:"sent_at"`
+	MessageID  sql.NullString `db:"message_id"`
+	CanceledAt sql.NullTime   `db:"canceled_at"`
+	FailedAt   sql.NullTime   `db:"failed_at"`
+	FailReason string         `db:"fail_reason"`
+}
+
+func toDBScheduledMessage(message schedulee.ScheduledMessage) dbScheduledMessage {
+	return dbScheduledMessage{
+		ID:        message.ID.String(),
+		ChatID:    message.ChatID.String(),
+		AuthorID:  message.AuthorID.String(),
+		Text:      message.Text,
+		ParentID:  toNullUUID(message.ParentID),
+		SendAt:    message.SendAt,
+		CreatedAt: message.CreatedAt,
+
+		SentAt:     toNullTime(message.SentAt),
+		MessageID:  toNullUUID(message.MessageID),
+		CanceledAt: toNullTime(message.CanceledAt),
+		FailedAt:   toNullTime(message.FailedAt),
+		FailReason: message.FailReason,
+	}
+}
+
+func toDomainScheduledMessage(message dbScheduledMessage) schedulee.ScheduledMessage {
+	return schedulee.ScheduledMessage{
+		ID:        uuid.MustParse(message.ID),
+		ChatID:    uuid.MustParse(message.ChatID),
+		AuthorID:  uuid.MustParse(message.AuthorID),
+		Text:      message.Text,
+		ParentID:  fromNullUUID(message.ParentID),
+		SendAt:    message.SendAt.UTC(),
+		CreatedAt: message.CreatedAt.UTC(),
+
+		SentAt:     fromNullTime(message.SentAt),
+		MessageID:  fromNullUUID(message.MessageID),
+		CanceledAt: fromNullTime(message.CanceledAt),
+		FailedAt:   fromNullTime(message.FailedAt),
+		FailReason: message.FailReason,
+	}
+}
+
+func toDomainScheduledMessages(messages []dbScheduledMessage) []schedulee.ScheduledMessage {
+	domainMessages := make([]schedulee.ScheduledMessage, len(messages))
+	for i, message := range messages {
+		domainMessages[i] = toDomainScheduledMessage(message)
+	}
+
+	return domainMessages
+}
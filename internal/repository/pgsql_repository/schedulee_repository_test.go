@@ -0,0 +1,140 @@
+package pgsqlRepository
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+)
+
+func (suite *Suite) Test_ScheduleeRepository() {
+	suite.Run("List", func() {
+		suite.Run("из пустого репозитория вернется пустой список", func() {
+			messages, err := suite.RR.Scheduled.List(schedulee.Filter{})
+			suite.NoError(err)
+			suite.Empty(messages)
+		})
+
+		suite.Run("с фильтром по AuthorID вернутся сообщения автора", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			p := chat.Participants[0]
+			suite.addRndParticipant(&chat)
+			other := chat.Participants[1]
+			suite.upsertChat(chat)
+			expected := []schedulee.ScheduledMessage{
+				suite.upsertScheduled(suite.rndScheduled(chat, p.UserID)),
+				suite.upsertScheduled(suite.rndScheduled(chat, p.UserID)),
+			}
+			suite.upsertScheduled(suite.rndScheduled(chat, other.UserID))
+
+			fromRepo, err := suite.RR.Scheduled.List(schedulee.Filter{AuthorID: p.UserID})
+			suite.NoError(err)
+			suite.ElementsMatch(expected, fromRepo)
+		})
+
+		suite.Run("с фильтром PendingOnly вернутся только ожидающие отправки сообщения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			pending := suite.upsertScheduled(suite.rndScheduled(chat, chat.ChiefID))
+			canceled := suite.rndScheduled(chat, chat.ChiefID)
+			suite.Require().NoError(canceled.Cancel(chat.ChiefID))
+			suite.upsertScheduled(canceled)
+			sent := suite.rndScheduled(chat, chat.ChiefID)
+			suite.Require().NoError(sent.MarkSent(uuid.New()))
+			suite.upsertScheduled(sent)
+
+			fromRepo, err := suite.RR.Scheduled.List(schedulee.Filter{PendingOnly: true})
+			suite.NoError(err)
+			suite.Equal([]schedulee.ScheduledMessage{pending}, fromRepo)
+		})
+	})
+
+	suite.Run("ClaimDue", func() {
+		suite.Run("вне транзакции вернется ошибка", func() {
+			messages, err := suite.RR.Scheduled.ClaimDue(time.Now(), 10)
+			suite.Error(err)
+			suite.Empty(messages)
+		})
+
+		suite.Run("вернутся только наступившие ожидающие сообщения", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			due := suite.rndScheduled(chat, chat.ChiefID)
+			due.SendAt = time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+			suite.upsertScheduled(due)
+			suite.upsertScheduled(suite.rndScheduled(chat, chat.ChiefID))
+
+			err := suite.RR.Scheduled.InTransaction(func(txRepo schedulee.Repository) error {
+				claimed, err := txRepo.ClaimDue(time.Now(), 10)
+				suite.NoError(err)
+				suite.Equal([]schedulee.ScheduledMessage{due}, claimed)
+				return nil
+			})
+			suite.NoError(err)
+		})
+
+		suite.Run("заблокированные сообщения пропускаются другими транзакциями", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			for range 2 {
+				due := suite.rndScheduled(chat, chat.ChiefID)
+				due.SendAt = time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+				suite.upsertScheduled(due)
+			}
+
+			err := suite.RR.Scheduled.InTransaction(func(txRepo schedulee.Repository) error {
+				claimed, err := txRepo.ClaimDue(time.Now(), 1)
+				suite.NoError(err)
+				suite.Require().Len(claimed, 1)
+
+				// Параллельная транзакция получит только незаблокированное сообщение
+				return suite.RR.Scheduled.InTransaction(func(txRepo2 schedulee.Repository) error {
+					claimed2, err := txRepo2.ClaimDue(time.Now(), 10)
+					suite.NoError(err)
+					suite.Require().Len(claimed2, 1)
+					suite.NotEqual(claimed[0].ID, claimed2[0].ID)
+					return nil
+				})
+			})
+			suite.NoError(err)
+		})
+	})
+
+	suite.Run("Upsert", func() {
+		suite.Run("нельзя сохранять без ID", func() {
+			err := suite.RR.Scheduled.Upsert(schedulee.ScheduledMessage{})
+			suite.Error(err)
+		})
+
+		suite.Run("сохраненное сообщение полностью соответствует сохраняемому", func() {
+			chat := suite.upsertChat(suite.rndChat())
+			message := suite.rndScheduled(chat, chat.ChiefID)
+			message.ParentID = uuid.New()
+			suite.Require().NoError(message.MarkFailed(gofakeit.Sentence(3)))
+			suite.upsertScheduled(message)
+
+			fromRepo, err := suite.RR.Scheduled.List(schedulee.Filter{ID: message.ID})
+			suite.NoError(err)
+			suite.Require().Len(fromRepo, 1)
+			suite.Equal(message, fromRepo[0])
+		})
+	})
+}
+
+// rndScheduled создает случайное отложенное сообщение
+func (suite *Suite) rndScheduled(chat chatt.Chat, authorID uuid.UUID) schedulee.ScheduledMessage {
+	suite.T().Helper()
+	message, err := schedulee.NewScheduledMessage(chat, authorID, gofakeit.Sentence(5), uuid.Nil, time.Now().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	return message
+}
+
+// upsertScheduled сохраняет отложенное сообщение в репозиторий
+func (suite *Suite) upsertScheduled(message schedulee.ScheduledMessage) schedulee.ScheduledMessage {
+	suite.T().Helper()
+	err := suite.RR.Scheduled.Upsert(message)
+	suite.Require().NoError(err)
+
+	return message
+}
@@ -11,9 +11,16 @@ import (
 	testifySuite "github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
 	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
 	"github.com/nice-pea/npchat/internal/domain/sessionn"
 	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
 )
 
 type Suite struct {
@@ -21,9 +28,16 @@ type Suite struct {
 	factory       *Factory
 	factoryCloser func()
 	RR            struct {
-		Chats    chatt.Repository
-		Sessions sessionn.Repository
-		Users    userr.Repository
+		Attachments  attachmentt.Repository
+		Chats        chatt.Repository
+		Drafts       draftt.Repository
+		Integrations integrationn.Repository
+		InviteLinks  invitelinkk.Repository
+		Messages     messagee.Repository
+		Scheduled    schedulee.Repository
+		Sessions     sessionn.Repository
+		Users        userr.Repository
+		Webhooks     webhookk.Repository
 	}
 }
 
@@ -90,9 +104,16 @@ func (suite *Suite) SetupTest() {
 	}
 
 	// Инициализация репозиториев
+	suite.RR.Attachments = suite.factory.NewAttachmenttRepository()
 	suite.RR.Chats = suite.factory.NewChattRepository()
+	suite.RR.Integrations = suite.factory.NewIntegrationnRepository()
+	suite.RR.InviteLinks = suite.factory.NewInvitelinkkRepository()
+	suite.RR.Drafts = suite.factory.NewDrafttRepository()
+	suite.RR.Messages = suite.factory.NewMessageeRepository()
+	suite.RR.Scheduled = suite.factory.NewScheduleeRepository()
 	suite.RR.Users = suite.factory.NewUserrRepository()
 	suite.RR.Sessions = suite.factory.NewSessionnRepository()
+	suite.RR.Webhooks = suite.factory.NewWebhookkRepository()
 }
 
 // TearDownSubTest выполняется после каждого подтеста, связанного с suite
@@ -1,7 +1,9 @@
 package pgsqlRepository
 
 import (
+	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -42,6 +44,32 @@ func (r *UserrRepository) List(filter userr.Filter) ([]userr.User, error) {
 	if filter.BasicAuthPassword != "" {
 		where = where.And("u.password = ?", filter.BasicAuthPassword)
 	}
+	if len(filter.Nicks) > 0 {
+		nicks := make([]string, len(filter.Nicks))
+		for i, nick := range filter.Nicks {
+			nicks[i] = strings.ToLower(nick)
+		}
+		where = where.And("lower(u.nick) = ANY(?)", pq.Array(nicks))
+	}
+	if len(filter.IDs) > 0 {
+		ids := make([]string, len(filter.IDs))
+		for i, id := range filter.IDs {
+			ids[i] = id.String()
+		}
+		where = where.And("u.id = ANY(?)", pq.Array(ids))
+	}
+	if filter.Kind != "" {
+		where = where.And("u.kind = ?", filter.Kind)
+	}
+	if filter.BotOwnerID != uuid.Nil {
+		where = where.And("u.bot_owner_id = ?", filter.BotOwnerID)
+	}
+	if filter.BotTokenHash != "" {
+		where = where.And("u.bot_token_hash = ?", filter.BotTokenHash)
+	}
+	if filter.BotCommand != "" {
+		where = where.And("EXISTS (SELECT 1 FROM bot_commands c WHERE c.bot_id = u.id AND c.name = ?)", filter.BotCommand)
+	}
 
 	query, args, err := bqb.New("? ? GROUP BY u.id", sel, where).ToPgsql()
 	if err != nil {
@@ -80,7 +108,22 @@ func (r *UserrRepository) List(filter userr.Filter) ([]userr.User, error) {
 		oauthUsersMap[u.UserID] = append(oauthUsersMap[u.UserID], u)
 	}
 
-	return toDomainUsers(users, oauthUsersMap), nil
+	// Найти команды ботов
+	var botCommands []dbBotCommand
+	if err := r.DB().Select(&botCommands, `
+		SELECT *
+		FROM bot_commands
+		WHERE bot_id = ANY($1)
+		ORDER BY position
+	`, pq.Array(userIDs)); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+	botCommandsMap := make(map[string][]dbBotCommand, len(users))
+	for _, c := range botCommands {
+		botCommandsMap[c.BotID] = append(botCommandsMap[c.BotID], c)
+	}
+
+	return toDomainUsers(users, oauthUsersMap, botCommandsMap), nil
 }
 
 func (r *UserrRepository) Upsert(user userr.User) error {
@@ -99,17 +142,37 @@ func (r *UserrRepository) Upsert(user userr.User) error {
 
 func (r *UserrRepository) upsert(user userr.User) error {
 	if _, err := r.DB().NamedExec(`
-		INSERT INTO users(id, name, nick, login, password) 
-		VALUES (:id, :name, :nick, :login, :password)
+		INSERT INTO users(id, name, nick, login, password, kind, bot_owner_id, bot_token_hash, webhook_url, webhook_secret) 
+		VALUES (:id, :name, :nick, :login, :password, :kind, :bot_owner_id, :bot_token_hash, :webhook_url, :webhook_secret)
 		ON CONFLICT (id) DO UPDATE SET
 			name = excluded.name,
 			nick = excluded.nick,
 			login = excluded.login,
-			password = excluded.password
+			password = excluded.password,
+			kind = excluded.kind,
+			bot_owner_id = excluded.bot_owner_id,
+			bot_token_hash = excluded.bot_token_hash,
+			webhook_url = excluded.webhook_url,
+			webhook_secret = excluded.webhook_secret
 	`, toDBUser(user)); err != nil {
 		return fmt.Errorf("r.DB().NamedExec: %w", err)
 	}
 
+	// Заменить команды бота
+	if _, err := r.DB().Exec(`
+		DELETE FROM bot_commands WHERE bot_id = $1
+	`, user.ID); err != nil {
+		return fmt.Errorf("r.DB().Exec: %w", err)
+	}
+	if len(user.Bot.Commands) > 0 {
+		if _, err := r.DB().NamedExec(`
+			INSERT INTO bot_commands(bot_id, name, description, position)
+			VALUES (:bot_id, :name, :description, :position)
+		`, toDBBotCommands(user)); err != nil {
+			return fmt.Errorf("r.DB().NamedExec: %w", err)
+		}
+	}
+
 	// Удалить прошлых связанных oauth пользователей
 	if _, err := r.DB().Exec(`
 		DELETE FROM oauth_users	WHERE user_id = $1
@@ -139,40 +202,58 @@ func (r *UserrRepository) InTransaction(fn func(txRepo userr.Repository) error)
 }
 
 type dbUser struct {
-	ID       string `db:"id"`
-	Name     string `db:"name"`
-	Nick     string `db:"nick"`
-	Login    string `db:"login"`
-	Password string `db:"password"`
+	ID            string         `db:"id"`
+	Name          string         `db:"name"`
+	Nick          string         `db:"nick"`
+	Login         string         `db:"login"`
+	Password      string         `db:"password"`
+	Kind          string         `db:"kind"`
+	BotOwnerID    sql.NullString `db:"bot_owner_id"`
+	BotTokenHash  string         `db:"bot_token_hash"`
+	WebhookURL    string         `db:"webhook_url"`
+	WebhookSecret string         `db:"webhook_secret"`
 }
 
 func toDBUser(user userr.User) dbUser {
 	return dbUser{
-		ID:       user.ID.String(),
-		Name:     user.Name,
-		Nick:     user.Nick,
-		Login:    user.BasicAuth.Login,
-		Password: user.BasicAuth.Password,
+		ID:            user.ID.String(),
+		Name:          user.Name,
+		Nick:          user.Nick,
+		Login:         user.BasicAuth.Login,
+		Password:      user.BasicAuth.Password,
+		Kind:          user.Kind,
+		BotOwnerID:    toNullUUID(user.Bot.OwnerID),
+		BotTokenHash:  user.Bot.TokenHash,
+		WebhookURL:    user.Bot.WebhookURL,
+		WebhookSecret: user.Bot.WebhookSecret,
 	}
 }
 
-func toDomainUser(user dbUser, oauthUsers []dbOauthUser) userr.User {
+func toDomainUser(user dbUser, oauthUsers []dbOauthUser, botCommands []dbBotCommand) userr.User {
 	return userr.User{
 		ID:            uuid.MustParse(user.ID),
 		Name:          user.Name,
 		Nick:          user.Nick,
+		Kind:          user.Kind,
 		OpenAuthUsers: toDomainOauthUsers(oauthUsers),
 		BasicAuth: userr.BasicAuth{
 			Login:    user.Login,
 			Password: user.Password,
 		},
+		Bot: userr.Bot{
+			OwnerID:       fromNullUUID(user.BotOwnerID),
+			TokenHash:     user.BotTokenHash,
+			WebhookURL:    user.WebhookURL,
+			WebhookSecret: user.WebhookSecret,
+			Commands:      toDomainBotCommands(botCommands),
+		},
 	}
 }
 
-func toDomainUsers(users []dbUser, oauthUsers map[string][]dbOauthUser) []userr.User {
+func toDomainUsers(users []dbUser, oauthUsers map[string][]dbOauthUser, botCommands map[string][]dbBotCommand) []userr.User {
 	domainUsers := make([]userr.User, len(users))
 	for i, u := range users {
-		domainUsers[i] = toDomainUser(u, oauthUsers[u.ID])
+		domainUsers[i] = toDomainUser(u, oauthUsers[u.ID], botCommands[u.ID])
 	}
 
 	return domainUsers
@@ -231,3 +312,40 @@ func toDomainOauthUsers(users []dbOauthUser) []userr.OpenAuthUser {
 
 	return domainUsers
 }
+
+type dbBotCommand struct {
+	BotID       string `db:"bot_id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+	Position    int    `db:"position"`
+}
+
+func toDBBotCommands(user userr.User) []dbBotCommand {
+	dbCommands := make([]dbBotCommand, len(user.Bot.Commands))
+	for i, c := range user.Bot.Commands {
+		dbCommands[i] = dbBotCommand{
+			BotID:       user.ID.String(),
+			Name:        c.Name,
+			Description: c.Description,
+			Position:    i,
+		}
+	}
+
+	return dbCommands
+}
+
+func toDomainBotCommands(commands []dbBotCommand) []userr.BotCommand {
+	if len(commands) == 0 {
+		return nil
+	}
+
+	domainCommands := make([]userr.BotCommand, len(commands))
+	for i, c := range commands {
+		domainCommands[i] = userr.BotCommand{
+			Name:        c.Name,
+			Description: c.Description,
+		}
+	}
+
+	return domainCommands
+}
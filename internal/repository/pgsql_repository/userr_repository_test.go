@@ -1,6 +1,7 @@
 package pgsqlRepository
 
 import (
+	"strings"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -101,6 +102,21 @@ func (suite *Suite) Test_UserrRepository() {
 			suite.Equal(expected, fromRepo[0])
 		})
 
+		suite.Run("с фильтром по Nicks вернутся, имеющие эти ники без учета регистра", func() {
+			// Создать много
+			users := suite.upsertRndUsers(10)
+			// Определить случайны искомый
+			expected := common.RndElem(users)
+			// Получить список
+			fromRepo, err := suite.RR.Users.List(userr.Filter{
+				Nicks: []string{strings.ToUpper(expected.Nick), "nobody"},
+			})
+			// Сравнить ожидания и результат
+			suite.NoError(err)
+			suite.Require().Len(fromRepo, 1)
+			suite.Equal(expected, fromRepo[0])
+		})
+
 		suite.Run("можно искать по всем фильтрам сразу", func() {
 			// Создать много
 			users := suite.upsertRndUsers(10)
@@ -121,6 +137,60 @@ func (suite *Suite) Test_UserrRepository() {
 		})
 	})
 
+	suite.Run("боты ищутся по хэшу токена, владельцу и виду", func() {
+		owner := suite.rndUser()
+		suite.upsertUser(owner)
+		bot, token, err := userr.NewBot(owner, "bot", "bot", "https://example.com/hook")
+		suite.Require().NoError(err)
+		suite.upsertUser(bot)
+
+		// По хэшу токена
+		fromRepo, err := suite.RR.Users.List(userr.Filter{BotTokenHash: userr.HashBotToken(token)})
+		suite.NoError(err)
+		suite.Require().Len(fromRepo, 1)
+		suite.Equal(bot, fromRepo[0])
+		// По владельцу
+		fromRepo, err = suite.RR.Users.List(userr.Filter{BotOwnerID: owner.ID})
+		suite.NoError(err)
+		suite.Require().Len(fromRepo, 1)
+		suite.Equal(bot.ID, fromRepo[0].ID)
+		// По виду и списку ID
+		fromRepo, err = suite.RR.Users.List(userr.Filter{
+			IDs:  []uuid.UUID{owner.ID, bot.ID},
+			Kind: userr.KindHuman,
+		})
+		suite.NoError(err)
+		suite.Require().Len(fromRepo, 1)
+		suite.Equal(owner, fromRepo[0])
+	})
+
+	suite.Run("команды бота сохраняются и по ним ищутся боты", func() {
+		owner := suite.rndUser()
+		suite.upsertUser(owner)
+		bot, _, err := userr.NewBot(owner, "bot", "bot", "")
+		suite.Require().NoError(err)
+		suite.Require().NoError(bot.SetBotCommands(bot.ID, []userr.BotCommand{
+			{Name: "deploy", Description: "Выкатить релиз"},
+			{Name: "status"},
+		}))
+		suite.upsertUser(bot)
+		other, _, err := userr.NewBot(owner, "other", "other", "")
+		suite.Require().NoError(err)
+		suite.upsertUser(other)
+
+		fromRepo, err := suite.RR.Users.List(userr.Filter{BotCommand: "status"})
+		suite.NoError(err)
+		suite.Require().Len(fromRepo, 1)
+		suite.Equal(bot, fromRepo[0])
+
+		// Команды заменяются целиком
+		suite.Require().NoError(bot.SetBotCommands(bot.ID, []userr.BotCommand{{Name: "status"}}))
+		suite.upsertUser(bot)
+		fromRepo, err = suite.RR.Users.List(userr.Filter{BotCommand: "deploy"})
+		suite.NoError(err)
+		suite.Empty(fromRepo)
+	})
+
 	suite.Run("Upsert", func() {
 		suite.Run("нельзя сохранять без ID", func() {
 			err := suite.RR.Users.Upsert(userr.User{
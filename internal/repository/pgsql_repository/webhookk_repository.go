@@ -0,0 +1,126 @@
+package pgsqlRepository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
+	sqlxRepo "github.com/nice-pea/npchat/internal/repository/pgsql_repository/sqlx_repo"
+)
+
+type WebhookkRepository struct {
+	sqlxRepo.SqlxRepo
+}
+
+func (r *WebhookkRepository) ClaimDue(before time.Time, limit int) ([]webhookk.Delivery, error) {
+	// Блокировка имеет смысл только в транзакции
+	if !r.IsTx() {
+		return nil, errors.New("ClaimDue must be called in transaction")
+	}
+
+	var deliveries []dbWebhookDelivery
+	if err := r.DB().Select(&deliveries, `
+		SELECT d.*
+		FROM webhook_deliveries d
+		WHERE d.delivered_at IS NULL AND d.failed_at IS NULL AND d.next_attempt_at <= $1
+		ORDER BY d.next_attempt_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, before, limit); err != nil {
+		return nil, fmt.Errorf("r.DB().Select: %w", err)
+	}
+
+	return toDomainWebhookDeliveries(deliveries), nil
+}
+
+func (r *WebhookkRepository) Upsert(delivery webhookk.Delivery) error {
+	if delivery.ID == uuid.Nil {
+		return fmt.Errorf("webhook delivery ID is required")
+	}
+
+	if _, err := r.DB().NamedExec(`
+		INSERT INTO webhook_deliveries(id, bot_id, event_type, payload, attempts, next_attempt_at, last_error,
+		                               created_at, delivered_at, failed_at)
+		VALUES (:id, :bot_id, :event_type, :payload, :attempts, :next_attempt_at, :last_error,
+		        :created_at, :delivered_at, :failed_at)
+		ON CONFLICT (id) DO UPDATE SET
+			bot_id=excluded.bot_id,
+			event_type=excluded.event_type,
+			payload=excluded.payload,
+			attempts=excluded.attempts,
+			next_attempt_at=excluded.next_attempt_at,
+			last_error=excluded.last_error,
+			created_at=excluded.created_at,
+			delivered_at=excluded.delivered_at,
+			failed_at=excluded.failed_at
+	`, toDBWebhookDelivery(delivery)); err != nil {
+		return fmt.Errorf("r.DB().NamedExec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookkRepository) InTransaction(fn func(txRepo webhookk.Repository) error) error {
+	return r.SqlxRepo.InTransaction(func(txSqlxRepo sqlxRepo.SqlxRepo) error {
+		return fn(&WebhookkRepository{SqlxRepo: txSqlxRepo})
+	})
+}
+
+type dbWebhookDelivery struct {
+	ID            string    `db:"id"`
+	BotID         string    `db:"bot_id"`
+	EventType     string    `db:"event_type"`
+	Payload       []byte    `db:"payload"`
+	Attempts      int       `db:"attempts"`
+	NextAttemptAt time.Time `db:"next_attempt_at"`
+	LastError     string    `db:"last_error"`
+	CreatedAt     time.Time `db:"created_at"`
+
+	DeliveredAt sql.NullTime `db:"delivered_at"`
+	FailedAt    sql.NullTime `db:"failed_at"`
+}
+
+func toDBWebhookDelivery(delivery webhookk.Delivery) dbWebhookDelivery {
+	return dbWebhookDelivery{
+		ID:            delivery.ID.String(),
+		BotID:         delivery.BotID.String(),
+		EventType:     delivery.EventType,
+		Payload:       delivery.Payload,
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     delivery.LastError,
+		CreatedAt:     delivery.CreatedAt,
+
+		DeliveredAt: toNullTime(delivery.DeliveredAt),
+		FailedAt:    toNullTime(delivery.FailedAt),
+	}
+}
+
+func toDomainWebhookDelivery(delivery dbWebhookDelivery) webhookk.Delivery {
+	return webhookk.Delivery{
+		ID:            uuid.MustParse(delivery.ID),
+		BotID:         uuid.MustParse(delivery.BotID),
+		EventType:     delivery.EventType,
+		Payload:       delivery.Payload,
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt.UTC(),
+		LastError:     delivery.LastError,
+		CreatedAt:     delivery.CreatedAt.UTC(),
+
+		DeliveredAt: fromNullTime(delivery.DeliveredAt),
+		FailedAt:    fromNullTime(delivery.FailedAt),
+	}
+}
+
+func toDomainWebhookDeliveries(deliveries []dbWebhookDelivery) []webhookk.Delivery {
+	domainDeliveries := make([]webhookk.Delivery, len(deliveries))
+	for i, delivery := range deliveries {
+		domainDeliveries[i] = toDomainWebhookDelivery(delivery)
+	}
+
+	return domainDeliveries
+}
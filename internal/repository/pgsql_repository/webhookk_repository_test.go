@@ -0,0 +1,111 @@
+package pgsqlRepository
+
+import (
+	"time"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+func (suite *Suite) Test_WebhookkRepository() {
+	suite.Run("ClaimDue", func() {
+		suite.Run("вне транзакции вернется ошибка", func() {
+			deliveries, err := suite.RR.Webhooks.ClaimDue(time.Now(), 10)
+			suite.Error(err)
+			suite.Empty(deliveries)
+		})
+
+		suite.Run("вернутся только наступившие ожидающие доставки", func() {
+			bot := suite.upsertRndBot()
+			due := suite.upsertDelivery(suite.rndDelivery(bot))
+			later := suite.rndDelivery(bot)
+			suite.Require().NoError(later.MarkFailed("timeout"))
+			suite.upsertDelivery(later)
+			delivered := suite.rndDelivery(bot)
+			suite.Require().NoError(delivered.MarkDelivered())
+			suite.upsertDelivery(delivered)
+
+			err := suite.RR.Webhooks.InTransaction(func(txRepo webhookk.Repository) error {
+				claimed, err := txRepo.ClaimDue(time.Now(), 10)
+				suite.NoError(err)
+				suite.Equal([]webhookk.Delivery{due}, claimed)
+				return nil
+			})
+			suite.NoError(err)
+		})
+
+		suite.Run("заблокированные доставки пропускаются другими транзакциями", func() {
+			bot := suite.upsertRndBot()
+			for range 2 {
+				suite.upsertDelivery(suite.rndDelivery(bot))
+			}
+
+			err := suite.RR.Webhooks.InTransaction(func(txRepo webhookk.Repository) error {
+				claimed, err := txRepo.ClaimDue(time.Now(), 1)
+				suite.NoError(err)
+				suite.Len(claimed, 1)
+
+				// Параллельная транзакция получит только незаблокированную доставку
+				return suite.RR.Webhooks.InTransaction(func(txRepo2 webhookk.Repository) error {
+					claimed2, err := txRepo2.ClaimDue(time.Now(), 10)
+					suite.NoError(err)
+					suite.Require().Len(claimed2, 1)
+					suite.NotEqual(claimed[0].ID, claimed2[0].ID)
+					return nil
+				})
+			})
+			suite.NoError(err)
+		})
+	})
+
+	suite.Run("Upsert", func() {
+		suite.Run("перезапись сохраняет состояние попыток", func() {
+			bot := suite.upsertRndBot()
+			delivery := suite.upsertDelivery(suite.rndDelivery(bot))
+			suite.Require().NoError(delivery.MarkFailed("timeout"))
+			suite.upsertDelivery(delivery)
+			for range webhookk.MaxAttempts {
+				_ = delivery.MarkFailed("timeout")
+			}
+			suite.upsertDelivery(delivery)
+
+			err := suite.RR.Webhooks.InTransaction(func(txRepo webhookk.Repository) error {
+				claimed, err := txRepo.ClaimDue(time.Now().Add(2*webhookk.MaxBackoff), 10)
+				suite.NoError(err)
+				suite.Empty(claimed)
+				return nil
+			})
+			suite.NoError(err)
+		})
+	})
+}
+
+// upsertRndBot сохраняет случайного бота вместе с его владельцем
+func (suite *Suite) upsertRndBot() userr.User {
+	owner := suite.upsertUser(suite.rndUser())
+	bot, _, err := userr.NewBot(owner, "bot", "bot", "https://example.com/hook")
+	suite.Require().NoError(err)
+
+	return suite.upsertUser(bot)
+}
+
+// rndDelivery создает доставку события боту
+func (suite *Suite) rndDelivery(bot userr.User) webhookk.Delivery {
+	delivery, err := webhookk.NewDelivery(bot.ID, events.Event{
+		Type:      "message_created",
+		CreatedIn: time.Now(),
+		Data:      map[string]any{"text": "hello"},
+	})
+	suite.Require().NoError(err)
+
+	return delivery
+}
+
+// upsertDelivery сохраняет доставку в репозиторий
+func (suite *Suite) upsertDelivery(delivery webhookk.Delivery) webhookk.Delivery {
+	err := suite.RR.Webhooks.Upsert(delivery)
+	suite.Require().NoError(err)
+
+	return delivery
+}
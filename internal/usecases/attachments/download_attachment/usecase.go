@@ -0,0 +1,86 @@
+package downloadAttachment
+
+import (
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+var (
+	ErrInvalidSubjectID    = errors.New("некорректное значение SubjectID")
+	ErrInvalidAttachmentID = errors.New("некорректное значение AttachmentID")
+	ErrSubjectIsNotMember  = errors.New("пользователь не является участником чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID    uuid.UUID
+	AttachmentID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.AttachmentID); err != nil {
+		return errors.Join(err, ErrInvalidAttachmentID)
+	}
+
+	return nil
+}
+
+// Out результат скачивания вложения.
+// Вызывающая сторона обязана закрыть Content
+type Out struct {
+	Attachment attachmentt.Attachment
+	Content    io.ReadCloser
+}
+
+type DownloadAttachmentUsecase struct {
+	Repo      attachmentt.Repository
+	ChatsRepo chatt.Repository
+	Storage   attachmentt.Storage
+}
+
+// DownloadAttachment возвращает метаданные и содержимое вложения.
+// Скачивать вложения могут только участники чата
+func (c *DownloadAttachmentUsecase) DownloadAttachment(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти вложение
+	attachment, err := attachmentt.Find(c.Repo, attachmentt.Filter{ID: in.AttachmentID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: attachment.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Пользователь должен быть участником чата
+	if !chat.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMember
+	}
+
+	// Открыть содержимое
+	content, err := c.Storage.Get(attachment.StorageKey)
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Attachment: attachment,
+		Content:    content,
+	}, nil
+}
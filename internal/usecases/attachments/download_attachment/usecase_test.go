@@ -0,0 +1,91 @@
+package downloadAttachment
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	mockAttachmentt "github.com/nice-pea/npchat/internal/domain/attachmentt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Attachments_DownloadAttachment тестирует скачивание вложения
+func (suite *testSuite) Test_Attachments_DownloadAttachment() {
+	suite.Run("вложение должно существовать", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, _, _ := newUsecase(suite)
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		// Скачать вложение
+		out, err := usecase.DownloadAttachment(In{
+			SubjectID:    uuid.New(),
+			AttachmentID: uuid.New(),
+		})
+		suite.ErrorIs(err, attachmentt.ErrAttachmentNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("скачивать вложения могут только участники чата", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		attachment := suite.NewAttachment(chat, chat.ChiefID)
+		mockRepo.EXPECT().List(mock.Anything).Return([]attachmentt.Attachment{attachment}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		// Скачать вложение от имени постороннего пользователя
+		out, err := usecase.DownloadAttachment(In{
+			SubjectID:    uuid.New(),
+			AttachmentID: attachment.ID,
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("любой участник чата получит содержимое вложения", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockStorage := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		attachment := suite.NewAttachment(chat, chat.ChiefID)
+		mockRepo.EXPECT().List(attachmentt.Filter{ID: attachment.ID}).Return([]attachmentt.Attachment{attachment}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		mockStorage.EXPECT().Get(attachment.StorageKey).Return(io.NopCloser(strings.NewReader("content")), nil).Once()
+		// Скачать вложение
+		out, err := usecase.DownloadAttachment(In{
+			SubjectID:    p.UserID,
+			AttachmentID: attachment.ID,
+		})
+		suite.Require().NoError(err)
+		defer func() { suite.NoError(out.Content.Close()) }()
+		suite.Equal(attachment, out.Attachment)
+		content, err := io.ReadAll(out.Content)
+		suite.Require().NoError(err)
+		suite.Equal("content", string(content))
+	})
+}
+
+func newUsecase(suite *testSuite) (*DownloadAttachmentUsecase, *mockAttachmentt.Repository, *mockChatt.Repository, *mockAttachmentt.Storage) {
+	uc := &DownloadAttachmentUsecase{
+		Repo:      suite.RR.Attachments,
+		ChatsRepo: suite.RR.Chats,
+		Storage:   suite.Adapters.BlobStorage,
+	}
+	mockRepo := uc.Repo.(*mockAttachmentt.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockStorage := uc.Storage.(*mockAttachmentt.Storage)
+	return uc, mockRepo, mockChatsRepo, mockStorage
+}
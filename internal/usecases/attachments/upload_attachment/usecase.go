@@ -0,0 +1,124 @@
+package uploadAttachment
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+)
+
+var (
+	ErrInvalidSubjectID   = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID      = errors.New("некорректное значение ChatID")
+	ErrInvalidName        = errors.New("некорректное значение Name")
+	ErrInvalidMimeType    = errors.New("некорректное значение MimeType")
+	ErrInvalidContent     = errors.New("некорректное значение Content")
+	ErrSubjectIsNotMember = errors.New("пользователь не является участником чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	Name      string
+	MimeType  string
+	Content   io.Reader
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := attachmentt.ValidateName(in.Name); err != nil {
+		return errors.Join(err, ErrInvalidName)
+	}
+	if err := attachmentt.ValidateMimeType(in.MimeType); err != nil {
+		return errors.Join(err, ErrInvalidMimeType)
+	}
+	if in.Content == nil {
+		return ErrInvalidContent
+	}
+
+	return nil
+}
+
+// Out результат загрузки вложения
+type Out struct {
+	Attachment attachmentt.Attachment
+}
+
+type UploadAttachmentUsecase struct {
+	Repo      attachmentt.Repository
+	ChatsRepo chatt.Repository
+	Storage   attachmentt.Storage
+}
+
+// UploadAttachment загружает файл в чат.
+// Содержимое сохраняется в хранилище, а метаданные - в репозиторий
+func (c *UploadAttachmentUsecase) UploadAttachment(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Пользователь должен быть участником чата
+	if !chat.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMember
+	}
+
+	// Проверить право загружать вложения до записи содержимого в хранилище
+	if err = attachmentt.ValidateUploader(chat, in.SubjectID); err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить содержимое, попутно посчитав размер и контрольную сумму.
+	// Читается на байт больше лимита, чтобы распознать слишком большой файл
+	key := uuid.NewString()
+	hash := sha256.New()
+	counter := &byteCounter{}
+	content := io.TeeReader(io.LimitReader(in.Content, attachmentt.MaxSize+1), io.MultiWriter(hash, counter))
+	if err = c.Storage.Put(key, content); err != nil {
+		return Out{}, err
+	}
+
+	// Создать вложение
+	attachment, err := attachmentt.NewAttachment(chat, in.SubjectID, in.Name, in.MimeType, counter.n, hex.EncodeToString(hash.Sum(nil)), key)
+	if err != nil {
+		return Out{}, errors.Join(err, c.Storage.Delete(key))
+	}
+
+	// Сохранить вложение в репозиторий
+	if err = c.Repo.Upsert(attachment); err != nil {
+		return Out{}, errors.Join(err, c.Storage.Delete(key))
+	}
+
+	return Out{
+		Attachment: attachment,
+	}, nil
+}
+
+// byteCounter считает количество записанных в него байт
+type byteCounter struct {
+	n int64
+}
+
+func (b *byteCounter) Write(p []byte) (int, error) {
+	b.n += int64(len(p))
+	return len(p), nil
+}
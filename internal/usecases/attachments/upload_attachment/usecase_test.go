@@ -0,0 +1,217 @@
+package uploadAttachment
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	mockAttachmentt "github.com/nice-pea/npchat/internal/domain/attachmentt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Attachments_UploadAttachment тестирует загрузку вложения
+func (suite *testSuite) Test_Attachments_UploadAttachment() {
+	suite.Run("загружать вложения могут только участники чата", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Загрузить вложение от имени постороннего пользователя
+		out, err := usecase.UploadAttachment(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+			Name:      "file.txt",
+			MimeType:  "text/plain",
+			Content:   strings.NewReader("content"),
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("в архивный чат нельзя загружать вложения", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		suite.Require().NoError(chat.Archive(chat.ChiefID, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Загрузить вложение, содержимое не попадет в хранилище
+		out, err := usecase.UploadAttachment(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Name:      "file.txt",
+			MimeType:  "text/plain",
+			Content:   strings.NewReader("content"),
+		})
+		suite.ErrorIs(err, chatt.ErrChatIsArchived)
+		suite.Zero(out)
+	})
+
+	suite.Run("загружать вложения могут только участники с правом отправлять сообщения", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.Require().NoError(chat.SetParticipantRole(chat.ChiefID, p.UserID, chatt.RoleReadOnly, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Загрузить вложение от имени участника только для чтения
+		out, err := usecase.UploadAttachment(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			Name:      "file.txt",
+			MimeType:  "text/plain",
+			Content:   strings.NewReader("content"),
+		})
+		suite.ErrorIs(err, attachmentt.ErrUploaderCannotSend)
+		suite.Zero(out)
+	})
+
+	suite.Run("вложение сохранится с посчитанными размером и контрольной суммой", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockStorage := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		content := []byte("содержимое файла")
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		var storedKey string
+		var stored []byte
+		mockStorage.EXPECT().Put(mock.Anything, mock.Anything).RunAndReturn(func(key string, r io.Reader) error {
+			storedKey = key
+			var err error
+			stored, err = io.ReadAll(r)
+			return err
+		}).Once()
+		var saved attachmentt.Attachment
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(a attachmentt.Attachment) {
+			saved = a
+		}).Return(nil).Once()
+		// Загрузить вложение
+		out, err := usecase.UploadAttachment(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			Name:      "file.txt",
+			MimeType:  "text/plain",
+			Content:   bytes.NewReader(content),
+		})
+		suite.Require().NoError(err)
+		// Результат совпадает с сохраненным вложением
+		sum := sha256.Sum256(content)
+		suite.Equal(saved, out.Attachment)
+		suite.Equal(content, stored)
+		suite.Equal(storedKey, out.Attachment.StorageKey)
+		suite.Equal(int64(len(content)), out.Attachment.Size)
+		suite.Equal(hex.EncodeToString(sum[:]), out.Attachment.Checksum)
+		suite.Equal(p.UserID, out.Attachment.UploaderID)
+		suite.Equal(chat.ID, out.Attachment.ChatID)
+	})
+
+	suite.Run("пустой файл удаляется из хранилища", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, mockStorage := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		var storedKey string
+		mockStorage.EXPECT().Put(mock.Anything, mock.Anything).Run(func(key string, _ io.Reader) {
+			storedKey = key
+		}).Return(nil).Once()
+		mockStorage.EXPECT().Delete(mock.Anything).Run(func(key string) {
+			suite.Equal(storedKey, key)
+		}).Return(nil).Once()
+		// Загрузить пустой файл
+		out, err := usecase.UploadAttachment(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Name:      "empty.txt",
+			MimeType:  "text/plain",
+			Content:   strings.NewReader(""),
+		})
+		suite.ErrorIs(err, attachmentt.ErrEmptyFile)
+		suite.Zero(out)
+	})
+
+	suite.Run("при ошибке сохранения метаданных содержимое удаляется из хранилища", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockStorage := newUsecase(suite)
+		chat := suite.RndChat()
+		errUpsert := errors.New("upsert error")
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockStorage.EXPECT().Put(mock.Anything, mock.Anything).RunAndReturn(func(_ string, r io.Reader) error {
+			_, err := io.ReadAll(r)
+			return err
+		}).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(errUpsert).Once()
+		mockStorage.EXPECT().Delete(mock.Anything).Return(nil).Once()
+		// Загрузить вложение
+		out, err := usecase.UploadAttachment(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Name:      "file.txt",
+			MimeType:  "text/plain",
+			Content:   strings.NewReader("content"),
+		})
+		suite.ErrorIs(err, errUpsert)
+		suite.Zero(out)
+	})
+}
+
+// Test_UploadAttachmentInput_Validate тестирует входящие параметры загрузки вложения
+func Test_UploadAttachmentInput_Validate(t *testing.T) {
+	valid := func() In {
+		return In{
+			SubjectID: uuid.New(),
+			ChatID:    uuid.New(),
+			Name:      "file.txt",
+			MimeType:  "text/plain",
+			Content:   strings.NewReader("content"),
+		}
+	}
+	t.Run("корректные параметры", func(t *testing.T) {
+		assert.NoError(t, valid().Validate())
+	})
+	t.Run("пустое имя файла", func(t *testing.T) {
+		in := valid()
+		in.Name = ""
+		assert.ErrorIs(t, in.Validate(), ErrInvalidName)
+	})
+	t.Run("некорректный MIME тип", func(t *testing.T) {
+		in := valid()
+		in.MimeType = "text"
+		assert.ErrorIs(t, in.Validate(), ErrInvalidMimeType)
+	})
+	t.Run("отсутствует содержимое", func(t *testing.T) {
+		in := valid()
+		in.Content = nil
+		assert.ErrorIs(t, in.Validate(), ErrInvalidContent)
+	})
+}
+
+func newUsecase(suite *testSuite) (*UploadAttachmentUsecase, *mockAttachmentt.Repository, *mockChatt.Repository, *mockAttachmentt.Storage) {
+	uc := &UploadAttachmentUsecase{
+		Repo:      suite.RR.Attachments,
+		ChatsRepo: suite.RR.Chats,
+		Storage:   suite.Adapters.BlobStorage,
+	}
+	mockRepo := uc.Repo.(*mockAttachmentt.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockStorage := uc.Storage.(*mockAttachmentt.Storage)
+	return uc, mockRepo, mockChatsRepo, mockStorage
+}
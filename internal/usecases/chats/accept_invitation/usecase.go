@@ -2,6 +2,7 @@ package acceptInvitation
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -56,6 +57,15 @@ func (c *AcceptInvitationUsecase) AcceptInvitation(in In) (Out, error) {
 		return Out{}, err
 	}
 
+	// Истекшее приглашение принять нельзя
+	invitation, err := chat.Invitation(in.InvitationID)
+	if err != nil {
+		return Out{}, err
+	}
+	if invitation.IsExpired(time.Now()) {
+		return Out{}, chatt.ErrInvitationIsExpired
+	}
+
 	// Инициализировать буфер событий
 	eventsBuf := new(events.Buffer)
 
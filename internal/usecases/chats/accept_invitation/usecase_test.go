@@ -2,6 +2,7 @@ package acceptInvitation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -71,6 +72,30 @@ func (suite *testSuite) Test_Invitations_AcceptInvitation() {
 		suite.Require().NoError(err)
 	})
 
+	suite.Run("истекшее приглашение принять нельзя", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, _ := newUsecase(suite)
+		// Создать чат
+		chat := suite.RndChat()
+		// Создать участника
+		p := suite.AddRndParticipant(&chat)
+		// Создать истекшее приглашение
+		invitation := suite.NewInvitation(p.UserID, uuid.New())
+		invitation.ExpiresAt = time.Now().Add(-time.Minute)
+		suite.AddInvitation(&chat, invitation)
+		// Принять приглашение
+		input := In{
+			SubjectID:    invitation.RecipientID,
+			InvitationID: invitation.ID,
+		}
+		mockRepo.EXPECT().List(chatt.Filter{
+			InvitationID: input.InvitationID,
+		}).Return([]chatt.Chat{chat}, nil).Once()
+		out, err := usecase.AcceptInvitation(input)
+		suite.ErrorIs(err, chatt.ErrInvitationIsExpired)
+		suite.Zero(out)
+	})
+
 	suite.Run("после завершения операции, будут созданы события", func() {
 		// Создать usecase и моки
 		usecase, _, mockEventsConsumer := newUsecase(suite)
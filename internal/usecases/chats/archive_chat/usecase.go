@@ -0,0 +1,79 @@
+package archiveChat
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат архивации чата
+type Out struct {
+	Chat chatt.Chat
+}
+
+type ArchiveChatUsecase struct {
+	Repo          chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// ArchiveChat переносит чат в архив, после чего он доступен участникам только для чтения.
+// Доступно только главному администратору чата
+func (c *ArchiveChatUsecase) ArchiveChat(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.Repo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Перенести чат в архив
+	if err = chat.Archive(in.SubjectID, eventsBuf); err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить чат в репозиторий
+	if err = c.Repo.Upsert(chat); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Chat: chat,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package archiveChat
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_ArchiveChat тестирует перенос чата в архив
+func (suite *testSuite) Test_Chats_ArchiveChat() {
+	newUsecase := func() (*ArchiveChatUsecase, *mockEvents.Consumer) {
+		uc := &ArchiveChatUsecase{
+			Repo:          suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.ArchiveChat(In{ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.ArchiveChat(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+	})
+
+	suite.Run("чат должен существовать", func() {
+		usecase, _ := newUsecase()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.ArchiveChat(In{SubjectID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrChatNotExists)
+	})
+
+	suite.Run("архивировать чат может только главный администратор", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		participant := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.ArchiveChat(In{SubjectID: participant.UserID, ChatID: chat.ID})
+		suite.ErrorIs(err, chatt.ErrSubjectIsNotChief)
+	})
+
+	suite.Run("после архивации чат сохранится и участники получат событие", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.True(c.IsArchived())
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		out, err := usecase.ArchiveChat(In{SubjectID: chat.ChiefID, ChatID: chat.ID})
+		suite.Require().NoError(err)
+		suite.True(out.Chat.IsArchived())
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventChatUpdated)
+	})
+}
@@ -2,7 +2,6 @@ package cancelInvitation
 
 import (
 	"errors"
-	"slices"
 
 	"github.com/google/uuid"
 
@@ -72,14 +71,10 @@ func (c *CancelInvitationUsecase) CancelInvitation(in In) (Out, error) {
 		}
 	}
 
-	// Список тех, кто может отменять приглашение
-	allowedSubjects := []uuid.UUID{
-		chat.ChiefID,           // Главный администратор
-		invitation.SubjectID,   // Пригласивший
-		invitation.RecipientID, // Приглашаемый
-	}
-	// Проверить, может ли пользователь отменить приглашение
-	if !slices.Contains(allowedSubjects, in.SubjectID) {
+	// Проверить, может ли пользователь отменить приглашение:
+	// пригласивший, приглашаемый или участник с правом управления приглашениями
+	isParty := in.SubjectID == invitation.SubjectID || in.SubjectID == invitation.RecipientID
+	if !isParty && !chat.Can(in.SubjectID, chatt.PermissionManageInvitations) {
 		return Out{}, ErrSubjectUserNotAllowed
 	}
 
@@ -43,7 +43,7 @@ type ChatInvitationsUsecase struct {
 }
 
 // ChatInvitations возвращает список приглашений в конкретный чат.
-// Если SubjectID является администратором, то возвращается все приглашения в данный чат,
+// Если у SubjectID есть право управления приглашениями, то возвращается все приглашения в данный чат,
 // иначе только те приглашения, которые отправил именно пользователь.
 func (c *ChatInvitationsUsecase) ChatInvitations(in In) (Out, error) {
 	// Валидировать параметры
@@ -65,9 +65,9 @@ func (c *ChatInvitationsUsecase) ChatInvitations(in In) (Out, error) {
 	// Сохранить сначала все приглашения
 	invitations := chat.Invitations
 
-	// Если пользователь не является администратором,
+	// Если у пользователя нет права управления приглашениями,
 	// то оставить только те приглашения, которые отправил именно пользователь.
-	if chat.ChiefID != in.SubjectID {
+	if !chat.Can(in.SubjectID, chatt.PermissionManageInvitations) {
 		invitations = chat.SubjectInvitations(in.SubjectID)
 	}
 
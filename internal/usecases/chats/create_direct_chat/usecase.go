@@ -0,0 +1,111 @@
+package createDirectChat
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidUserID    = errors.New("некорректное значение UserID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	UserID    uuid.UUID // Собеседник
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.UserID); err != nil {
+		return errors.Join(err, ErrInvalidUserID)
+	}
+
+	return nil
+}
+
+// Out результат создания личного чата
+type Out struct {
+	Chat    chatt.Chat
+	Created bool // Чат создан этим запросом, а не найден среди существующих
+}
+
+type CreateDirectChatUsecase struct {
+	Repo          chatt.Repository
+	UsersRepo     userr.Repository
+	EventConsumer events.Consumer
+}
+
+// CreateDirectChat создает личный чат с пользователем.
+// Операция идемпотентна: если у пары пользователей уже есть личный чат, возвращается он
+func (c *CreateDirectChatUsecase) CreateDirectChat(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Личный чат возможен только с другим пользователем
+	if in.SubjectID == in.UserID {
+		return Out{}, chatt.ErrDirectChatWithSelf
+	}
+
+	// Вернуть существующий личный чат
+	if chat, ok, err := c.findDirectChat(in.SubjectID, in.UserID); err != nil {
+		return Out{}, err
+	} else if ok {
+		return Out{Chat: chat}, nil
+	}
+
+	// Убедиться, что собеседник существует
+	if _, err := userr.Find(c.UsersRepo, userr.Filter{ID: in.UserID}); err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Создать личный чат
+	chat, err := chatt.NewDirectChat(in.SubjectID, in.UserID, eventsBuf)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить чат в репозиторий
+	if err = c.Repo.Upsert(chat); err != nil {
+		// Чат мог быть создан параллельным запросом
+		if existing, ok, findErr := c.findDirectChat(in.SubjectID, in.UserID); findErr == nil && ok {
+			return Out{Chat: existing}, nil
+		}
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Chat:    chat,
+		Created: true,
+	}, nil
+}
+
+// findDirectChat ищет личный чат пары пользователей
+func (c *CreateDirectChatUsecase) findDirectChat(subjectID, userID uuid.UUID) (chatt.Chat, bool, error) {
+	chats, err := c.Repo.List(chatt.Filter{
+		DirectPair: [2]uuid.UUID{subjectID, userID},
+	})
+	if err != nil || len(chats) == 0 {
+		return chatt.Chat{}, false, err
+	}
+
+	return chats[0], true, nil
+}
@@ -0,0 +1,113 @@
+package createDirectChat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_CreateDirectChat тестирует создание личного чата
+func (suite *testSuite) Test_Chats_CreateDirectChat() {
+	newUsecase := func() (*CreateDirectChatUsecase, *mockEvents.Consumer) {
+		uc := &CreateDirectChatUsecase{
+			Repo:          suite.RR.Chats,
+			UsersRepo:     suite.RR.Users,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.CreateDirectChat(In{UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.CreateDirectChat(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidUserID)
+	})
+
+	suite.Run("нельзя создать личный чат с самим собой", func() {
+		usecase, _ := newUsecase()
+		userID := uuid.New()
+		_, err := usecase.CreateDirectChat(In{SubjectID: userID, UserID: userID})
+		suite.ErrorIs(err, chatt.ErrDirectChatWithSelf)
+	})
+
+	suite.Run("собеседник должен существовать", func() {
+		usecase, _ := newUsecase()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		suite.RR.Users.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.CreateDirectChat(In{SubjectID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, userr.ErrUserNotExists)
+	})
+
+	suite.Run("повторный запрос вернет существующий чат", func() {
+		usecase, _ := newUsecase()
+		subjectID, userID := uuid.New(), uuid.New()
+		existing, err := chatt.NewDirectChat(userID, subjectID, nil)
+		suite.Require().NoError(err)
+		suite.RR.Chats.EXPECT().List(chatt.Filter{DirectPair: [2]uuid.UUID{subjectID, userID}}).
+			Return([]chatt.Chat{existing}, nil).Once()
+
+		out, err := usecase.CreateDirectChat(In{SubjectID: subjectID, UserID: userID})
+		suite.Require().NoError(err)
+		suite.Equal(existing, out.Chat)
+		suite.False(out.Created)
+	})
+
+	suite.Run("если чат создан параллельно, вернется он", func() {
+		usecase, _ := newUsecase()
+		subjectID, userID := uuid.New(), uuid.New()
+		existing, err := chatt.NewDirectChat(userID, subjectID, nil)
+		suite.Require().NoError(err)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		suite.RR.Users.EXPECT().List(mock.Anything).Return([]userr.User{{ID: userID}}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Return(errors.New("duplicate key")).Once()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{existing}, nil).Once()
+
+		out, err := usecase.CreateDirectChat(In{SubjectID: subjectID, UserID: userID})
+		suite.Require().NoError(err)
+		suite.Equal(existing, out.Chat)
+		suite.False(out.Created)
+	})
+
+	suite.Run("новый чат сохранится и оба участника получат событие", func() {
+		usecase, mockEventConsumer := newUsecase()
+		subjectID, userID := uuid.New(), uuid.New()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		suite.RR.Users.EXPECT().List(userr.Filter{ID: userID}).Return([]userr.User{{ID: userID}}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.True(c.IsDirect())
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		out, err := usecase.CreateDirectChat(In{SubjectID: subjectID, UserID: userID})
+		suite.Require().NoError(err)
+		suite.True(out.Created)
+		suite.ElementsMatch([]uuid.UUID{subjectID, userID}, out.Chat.ParticipantIDs())
+
+		// Проверить список опубликованных событий
+		suite.Require().Len(consumedEvents, 1)
+		suite.Equal(chatt.EventChatCreated, consumedEvents[0].Type)
+		suite.ElementsMatch([]uuid.UUID{subjectID, userID}, consumedEvents[0].Recipients)
+	})
+}
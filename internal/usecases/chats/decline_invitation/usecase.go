@@ -0,0 +1,79 @@
+package declineInvitation
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID    = errors.New("некорректное значение SubjectID")
+	ErrInvalidInvitationID = errors.New("некорректное значение InvitationID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID    uuid.UUID
+	InvitationID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.InvitationID); err != nil {
+		return errors.Join(err, ErrInvalidInvitationID)
+	}
+
+	return nil
+}
+
+// Out результат отклонения приглашения
+type Out struct{}
+
+type DeclineInvitationUsecase struct {
+	Repo          chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// DeclineInvitation отклоняет приглашение от лица приглашенного пользователя.
+// В отличие от отмены приглашения, участники получают отдельное событие об отказе
+func (c *DeclineInvitationUsecase) DeclineInvitation(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.Repo, chatt.Filter{
+		InvitationID: in.InvitationID,
+	})
+	if errors.Is(err, chatt.ErrChatNotExists) {
+		return Out{}, chatt.ErrInvitationNotExists
+	} else if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Отклонить приглашение
+	if err = chat.DeclineInvitation(in.InvitationID, in.SubjectID, eventsBuf); err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить чат в репозиторий
+	if err = c.Repo.Upsert(chat); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{}, nil
+}
@@ -0,0 +1,76 @@
+package declineInvitation
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Invitations_DeclineInvitation тестирует отклонение приглашения
+func (suite *testSuite) Test_Invitations_DeclineInvitation() {
+	newUsecase := func() (*DeclineInvitationUsecase, *mockEvents.Consumer) {
+		uc := &DeclineInvitationUsecase{
+			Repo:          suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.DeclineInvitation(In{InvitationID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.DeclineInvitation(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidInvitationID)
+	})
+
+	suite.Run("приглашение должно существовать", func() {
+		usecase, _ := newUsecase()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.DeclineInvitation(In{SubjectID: uuid.New(), InvitationID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrInvitationNotExists)
+	})
+
+	suite.Run("отклонить приглашение может только приглашенный", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		invitation := suite.NewInvitation(chat.ChiefID, uuid.New())
+		suite.AddInvitation(&chat, invitation)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.DeclineInvitation(In{SubjectID: chat.ChiefID, InvitationID: invitation.ID})
+		suite.ErrorIs(err, chatt.ErrSubjectIsNotRecipient)
+	})
+
+	suite.Run("отклоненное приглашение удалится и будет отправлено событие", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		invitation := suite.NewInvitation(chat.ChiefID, uuid.New())
+		suite.AddInvitation(&chat, invitation)
+		suite.RR.Chats.EXPECT().List(chatt.Filter{InvitationID: invitation.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.False(c.HasInvitation(invitation.ID))
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		_, err := usecase.DeclineInvitation(In{SubjectID: invitation.RecipientID, InvitationID: invitation.ID})
+		suite.Require().NoError(err)
+		suite.AssertHasEventType(consumedEvents, chatt.EventInvitationDeclined)
+	})
+}
@@ -0,0 +1,90 @@
+package deleteChat
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат удаления чата
+type Out struct{}
+
+type DeleteChatUsecase struct {
+	Repo            chatt.Repository
+	AttachmentsRepo attachmentt.Repository
+	Storage         attachmentt.Storage
+	EventConsumer   events.Consumer
+}
+
+// DeleteChat удаляет чат вместе с участниками, приглашениями и всем содержимым.
+// Доступно только главному администратору чата. Все бывшие участники получают событие удаления
+func (c *DeleteChatUsecase) DeleteChat(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.Repo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Проверить право на удаление и подготовить события
+	if err = chat.Delete(in.SubjectID, eventsBuf); err != nil {
+		return Out{}, err
+	}
+
+	// Запомнить вложения чата, чтобы после удаления записей очистить хранилище
+	attachments, err := c.AttachmentsRepo.List(attachmentt.Filter{ChatID: chat.ID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Удалить чат и его содержимое в одной транзакции
+	if err = c.Repo.Delete(chat.ID); err != nil {
+		return Out{}, err
+	}
+
+	// Удалить содержимое вложений из хранилища.
+	// Чат уже удален, поэтому ошибки хранилища не отменяют операцию, а оставляют содержимое без ссылок
+	for _, attachment := range attachments {
+		_ = c.Storage.Delete(attachment.StorageKey)
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{}, nil
+}
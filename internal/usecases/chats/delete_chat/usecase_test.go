@@ -0,0 +1,96 @@
+package deleteChat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_DeleteChat тестирует удаление чата
+func (suite *testSuite) Test_Chats_DeleteChat() {
+	newUsecase := func() (*DeleteChatUsecase, *mockEvents.Consumer) {
+		uc := &DeleteChatUsecase{
+			Repo:            suite.RR.Chats,
+			AttachmentsRepo: suite.RR.Attachments,
+			Storage:         suite.Adapters.BlobStorage,
+			EventConsumer:   mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.DeleteChat(In{ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.DeleteChat(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+	})
+
+	suite.Run("чат должен существовать", func() {
+		usecase, _ := newUsecase()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.DeleteChat(In{SubjectID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrChatNotExists)
+	})
+
+	suite.Run("удалить чат может только главный администратор", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		participant := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.DeleteChat(In{SubjectID: participant.UserID, ChatID: chat.ID})
+		suite.ErrorIs(err, chatt.ErrSubjectIsNotChief)
+	})
+
+	suite.Run("при ошибке удаления события не отправляются", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Attachments.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		errDelete := errors.New("delete failed")
+		suite.RR.Chats.EXPECT().Delete(chat.ID).Return(errDelete).Once()
+		_, err := usecase.DeleteChat(In{SubjectID: chat.ChiefID, ChatID: chat.ID})
+		suite.ErrorIs(err, errDelete)
+	})
+
+	suite.Run("чат удаляется вместе с вложениями и бывшие участники получают событие", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		participant := suite.AddRndParticipant(&chat)
+		attachment := suite.NewAttachment(chat, chat.ChiefID)
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Attachments.EXPECT().List(attachmentt.Filter{ChatID: chat.ID}).
+			Return([]attachmentt.Attachment{attachment}, nil).Once()
+		suite.RR.Chats.EXPECT().Delete(chat.ID).Return(nil).Once()
+		suite.Adapters.BlobStorage.EXPECT().Delete(attachment.StorageKey).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		_, err := usecase.DeleteChat(In{SubjectID: chat.ChiefID, ChatID: chat.ID})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.Require().Len(consumedEvents, 1)
+		suite.Equal(chatt.EventChatDeleted, consumedEvents[0].Type)
+		suite.ElementsMatch([]uuid.UUID{chat.ChiefID, participant.UserID}, consumedEvents[0].Recipients)
+	})
+}
@@ -15,7 +15,6 @@ var (
 	ErrInvalidChatID             = errors.New("некорректное значение ChatID")
 	ErrInvalidUserID             = errors.New("некорректное значение UserID")
 	ErrMemberCannotDeleteHimself = errors.New("участник не может удалить самого себя")
-	ErrSubjectUserIsNotChief     = errors.New("пользователь не является главным администратором чата")
 )
 
 // In входящие параметры
@@ -66,16 +65,11 @@ func (c *DeleteMemberUsecase) DeleteMember(in In) (Out, error) {
 		return Out{}, err
 	}
 
-	// Subject должен быть главным администратором
-	if chat.ChiefID != in.SubjectID {
-		return Out{}, ErrSubjectUserIsNotChief
-	}
-
 	// Инициализировать буфер событий
 	eventsBuf := new(events.Buffer)
 
-	// Удалить пользователя из чата
-	if err = chat.RemoveParticipant(in.UserID, eventsBuf); err != nil {
+	// Удалить пользователя из чата, если у subject есть право и его роль старше
+	if err = chat.KickParticipant(in.SubjectID, in.UserID, eventsBuf); err != nil {
 		return Out{}, err
 	}
 
@@ -70,7 +70,7 @@ func (suite *testSuite) Test_Members_DeleteMember() {
 	//	suite.ErrorIs(err, ErrSubjectIsNotMember)
 	//})
 
-	suite.Run("subject должен быть главным администратором чата", func() {
+	suite.Run("subject должен иметь право удалять участников", func() {
 		// Создать usecase и моки
 		usecase, mockRepo, _ := newUsecase(suite)
 		// Создать чат
@@ -81,12 +81,12 @@ func (suite *testSuite) Test_Members_DeleteMember() {
 		input := In{
 			SubjectID: participant.UserID,
 			ChatID:    chat.ID,
-			UserID:    uuid.New(),
+			UserID:    chat.ChiefID,
 		}
 		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil)
 		out, err := usecase.DeleteMember(input)
-		// Вернется ошибка, потому что участник не главный администратор
-		suite.ErrorIs(err, ErrSubjectUserIsNotChief)
+		// Вернется ошибка, потому что у обычного участника нет такого права
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
 		suite.Zero(out)
 	})
 
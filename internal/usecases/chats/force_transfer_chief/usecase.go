@@ -0,0 +1,105 @@
+package forceTransferChief
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidUserID = errors.New("некорректное значение UserID")
+)
+
+// In входящие параметры
+type In struct {
+	UserID uuid.UUID // Пользователь, утративший возможность управлять своими чатами
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.UserID); err != nil {
+		return errors.Join(err, ErrInvalidUserID)
+	}
+
+	return nil
+}
+
+// Out результат принудительной передачи владения
+type Out struct {
+	Chats    []chatt.Chat // Чаты, в которых владение передано преемнику
+	Orphaned []uuid.UUID  // Чаты без других участников, владение в которых передать некому
+}
+
+type ForceTransferChiefUsecase struct {
+	Repo          chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// ForceTransferChief принудительно передает владение всеми чатами пользователя их старшим участникам.
+// Административная операция без проверки прав, предназначена для случаев, когда главный администратор
+// больше не может управлять чатами, например при удалении его аккаунта
+func (c *ForceTransferChiefUsecase) ForceTransferChief(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чаты пользователя
+	chats, err := c.Repo.List(chatt.Filter{ParticipantID: in.UserID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	var out Out
+	for _, chat := range chats {
+		if chat.ChiefID != in.UserID {
+			continue
+		}
+
+		err = c.Repo.InTransaction(func(txRepo chatt.Repository) error {
+			// Перечитать чат с блокировкой
+			lockedChat, err := chatt.Find(txRepo, chatt.Filter{ID: chat.ID})
+			if errors.Is(err, chatt.ErrChatNotExists) {
+				// Чат удален, пока обрабатывались предыдущие
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if lockedChat.ChiefID != in.UserID {
+				// Владение уже передано
+				return nil
+			}
+
+			// Передать владение преемнику
+			if _, err = lockedChat.ForceTransferChief(eventsBuf); errors.Is(err, chatt.ErrNoChiefSuccessor) {
+				out.Orphaned = append(out.Orphaned, lockedChat.ID)
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			// Сохранить чат в репозиторий
+			if err = txRepo.Upsert(lockedChat); err != nil {
+				return err
+			}
+			out.Chats = append(out.Chats, lockedChat)
+
+			return nil
+		})
+		if err != nil {
+			return Out{}, err
+		}
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return out, nil
+}
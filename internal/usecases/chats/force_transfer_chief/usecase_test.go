@@ -0,0 +1,78 @@
+package forceTransferChief
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_ForceTransferChief тестирует принудительную передачу владения чатами
+func (suite *testSuite) Test_Chats_ForceTransferChief() {
+	newUsecase := func() (*ForceTransferChiefUsecase, *mockEvents.Consumer) {
+		uc := &ForceTransferChiefUsecase{
+			Repo:          suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		suite.RR.Chats.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(chatt.Repository) error) error {
+			return fn(suite.RR.Chats)
+		}).Maybe()
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.ForceTransferChief(In{})
+		suite.ErrorIs(err, ErrInvalidUserID)
+	})
+
+	suite.Run("владение передается только в чатах пользователя", func() {
+		usecase, mockEventConsumer := newUsecase()
+		// Чат, в котором пользователь главный администратор
+		owned := suite.RndChat()
+		userID := owned.ChiefID
+		successor := suite.AddRndParticipant(&owned)
+		// Чат, в котором пользователь обычный участник
+		other := suite.RndChat()
+		suite.AddParticipant(&other, suite.NewParticipant(userID))
+		// Чат, в котором пользователь единственный участник
+		alone, err := chatt.NewChat("alone", userID, nil)
+		suite.Require().NoError(err)
+
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ParticipantID: userID}).
+			Return([]chatt.Chat{owned, other, alone}, nil).Once()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: owned.ID}).Return([]chatt.Chat{owned}, nil).Once()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: alone.ID}).Return([]chatt.Chat{alone}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.Equal(owned.ID, c.ID)
+			suite.Equal(successor.UserID, c.ChiefID)
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		out, err := usecase.ForceTransferChief(In{UserID: userID})
+		suite.Require().NoError(err)
+		suite.Require().Len(out.Chats, 1)
+		suite.Equal(successor.UserID, out.Chats[0].ChiefID)
+		suite.Equal([]uuid.UUID{alone.ID}, out.Orphaned)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventChatUpdated)
+	})
+}
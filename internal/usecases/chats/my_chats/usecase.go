@@ -8,18 +8,23 @@ import (
 
 	"github.com/nice-pea/npchat/internal/domain"
 	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
 )
 
 var (
 	ErrInvalidSubjectID      = errors.New("некорректное значение SubjectID")
 	ErrInvalidUserID         = errors.New("некорректное значение UserID")
+	ErrInvalidKind           = errors.New("некорректное значение Kind")
 	ErrUnauthorizedChatsView = errors.New("нельзя просматривать чужой список чатов")
 )
 
 // In входящие параметры
 type In struct {
 	SubjectID uuid.UUID
-	UserID    uuid.UUID // TODO: удалить
+	UserID    uuid.UUID  // TODO: удалить
+	Archived  bool       // Вернуть архивные чаты вместо активных
+	Kind      chatt.Kind // Вернуть чаты только этого вида. Пустое значение - чаты всех видов
 	Keyset    Keyset
 }
 
@@ -31,14 +36,21 @@ func (in In) Validate() error {
 	if err := domain.ValidateID(in.UserID); err != nil {
 		return errors.Join(err, ErrInvalidUserID)
 	}
+	if in.Kind != "" {
+		if err := chatt.ValidateKind(in.Kind); err != nil {
+			return errors.Join(err, ErrInvalidKind)
+		}
+	}
 
 	return nil
 }
 
 // Out результат запроса чатов
 type Out struct {
-	Chats      []chatt.Chat
-	NextKeyset Keyset
+	Chats        []chatt.Chat
+	UnreadCounts map[uuid.UUID]int          // Количество непрочитанных сообщений по ID чата
+	Drafts       map[uuid.UUID]draftt.Draft // Черновики пользователя по ID чата
+	NextKeyset   Keyset
 }
 
 type Keyset struct {
@@ -46,12 +58,15 @@ type Keyset struct {
 }
 
 type MyChatsUsecase struct {
-	Repo chatt.Repository
+	Repo         chatt.Repository
+	MessagesRepo messagee.Repository
+	DraftsRepo   draftt.Repository
 }
 
 const defaultPageSize = 50
 
-// MyChats возвращает список чатов, в которых участвует пользователь
+// MyChats возвращает список чатов, в которых участвует пользователь.
+// По умолчанию архивные чаты скрыты
 func (c *MyChatsUsecase) MyChats(in In) (Out, error) {
 	// Валидировать параметры
 	var err error
@@ -64,22 +79,87 @@ func (c *MyChatsUsecase) MyChats(in In) (Out, error) {
 		return Out{}, ErrUnauthorizedChatsView
 	}
 
-	// Получить список участников с фильтром по пользователю
+	// Получить список чатов с фильтром по пользователю.
+	// Архивные чаты возвращаются только по отдельному запросу
 	chats, err := c.Repo.List(chatt.Filter{
-		ParticipantID: in.UserID,
-		ActiveBefore:  in.Keyset.ActiveBefore,
-		Limit:         defaultPageSize,
+		ParticipantID:   in.UserID,
+		Kind:            in.Kind,
+		ActiveBefore:    in.Keyset.ActiveBefore,
+		ArchivedOnly:    in.Archived,
+		ExcludeArchived: !in.Archived,
+		Limit:           defaultPageSize,
 	})
 	if err != nil {
 		return Out{}, err
 	}
 
+	// Посчитать непрочитанные сообщения в каждом чате
+	unreadCounts, err := c.countUnread(in.SubjectID, chats)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Собрать черновики пользователя в этих чатах
+	drafts, err := c.userDrafts(in.SubjectID, chats)
+	if err != nil {
+		return Out{}, err
+	}
+
 	return Out{
-		Chats:      chats,
-		NextKeyset: nextKeyset(chats, defaultPageSize),
+		Chats:        chats,
+		UnreadCounts: unreadCounts,
+		Drafts:       drafts,
+		NextKeyset:   nextKeyset(chats, defaultPageSize),
 	}, err
 }
 
+// countUnread возвращает количество непрочитанных пользователем сообщений в каждом из чатов
+func (c *MyChatsUsecase) countUnread(userID uuid.UUID, chats []chatt.Chat) (map[uuid.UUID]int, error) {
+	// Собрать отметки прочтения пользователя
+	markers := make([]messagee.ReadMarker, 0, len(chats))
+	for _, chat := range chats {
+		p, err := chat.Participant(userID)
+		if err != nil {
+			continue
+		}
+		markers = append(markers, messagee.ReadMarker{
+			ChatID: chat.ID,
+			ReadAt: p.LastReadAt,
+		})
+	}
+	if len(markers) == 0 {
+		return map[uuid.UUID]int{}, nil
+	}
+
+	return c.MessagesRepo.CountUnread(userID, markers)
+}
+
+// userDrafts возвращает черновики пользователя в каждом из чатов
+func (c *MyChatsUsecase) userDrafts(userID uuid.UUID, chats []chatt.Chat) (map[uuid.UUID]draftt.Draft, error) {
+	if len(chats) == 0 {
+		return map[uuid.UUID]draftt.Draft{}, nil
+	}
+
+	chatIDs := make([]uuid.UUID, len(chats))
+	for i, chat := range chats {
+		chatIDs[i] = chat.ID
+	}
+	drafts, err := c.DraftsRepo.List(draftt.Filter{
+		UserID:  userID,
+		ChatIDs: chatIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byChatID := make(map[uuid.UUID]draftt.Draft, len(drafts))
+	for _, draft := range drafts {
+		byChatID[draft.ChatID] = draft
+	}
+
+	return byChatID, nil
+}
+
 func nextKeyset(chats []chatt.Chat, pageSize int) Keyset {
 	if len(chats) < pageSize {
 		return Keyset{}
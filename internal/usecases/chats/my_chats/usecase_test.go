@@ -5,10 +5,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	testifySuite "github.com/stretchr/testify/suite"
 
 	"github.com/nice-pea/npchat/internal/domain/chatt"
 	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
 	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
 )
 
@@ -39,19 +43,22 @@ func (suite *testSuite) Test_Chats_MyChats() {
 
 		input := suite.newUserChatsInput(uuid.New())
 		mockRepo.EXPECT().List(chatt.Filter{
-			ParticipantID: input.UserID,
-			ActiveBefore:  input.Keyset.ActiveBefore,
-			Limit:         defaultPageSize,
+			ParticipantID:   input.UserID,
+			ActiveBefore:    input.Keyset.ActiveBefore,
+			ExcludeArchived: true,
+			Limit:           defaultPageSize,
 		}).Return([]chatt.Chat{}, nil).Once()
 
 		out, err := usecase.MyChats(input)
 		suite.NoError(err)
 		suite.Empty(out.Chats)
+		suite.Empty(out.UnreadCounts)
 		suite.True(out.NextKeyset.ActiveBefore.IsZero())
 	})
 
 	suite.Run("возвращает чаты пользователя", func() {
 		usecase, mockRepo := newUsecase(suite)
+		mockMessagesRepo := usecase.MessagesRepo.(*mockMessagee.Repository)
 
 		userID := uuid.New()
 		now := time.Now().UTC().Truncate(time.Millisecond)
@@ -66,16 +73,76 @@ func (suite *testSuite) Test_Chats_MyChats() {
 
 		input := suite.newUserChatsInput(userID)
 		mockRepo.EXPECT().List(chatt.Filter{
-			ParticipantID: userID,
-			ActiveBefore:  input.Keyset.ActiveBefore,
-			Limit:         defaultPageSize,
+			ParticipantID:   userID,
+			ActiveBefore:    input.Keyset.ActiveBefore,
+			ExcludeArchived: true,
+			Limit:           defaultPageSize,
 		}).Return(expectedChats, nil).Once()
+		mockMessagesRepo.EXPECT().CountUnread(userID, mock.Anything).Return(map[uuid.UUID]int{}, nil).Once()
+		suite.RR.Drafts.EXPECT().List(mock.Anything).Return(nil, nil).Once()
 
 		out, err := usecase.MyChats(input)
 		suite.NoError(err)
 		suite.Equal(expectedChats, out.Chats)
 	})
 
+	suite.Run("возвращает количество непрочитанных сообщений по отметкам прочтения", func() {
+		usecase, mockRepo := newUsecase(suite)
+		mockMessagesRepo := usecase.MessagesRepo.(*mockMessagee.Repository)
+
+		userID := uuid.New()
+		readAt := time.Now().UTC().Truncate(time.Microsecond)
+		// Чат без отметки прочтения
+		chatUnread := suite.RndChat()
+		chatUnread.Participants = append(chatUnread.Participants, suite.NewParticipant(userID))
+		// Чат с отметкой прочтения
+		chatRead := suite.RndChat()
+		participant := suite.NewParticipant(userID)
+		participant.LastReadMessageID = uuid.New()
+		participant.LastReadAt = readAt
+		chatRead.Participants = append(chatRead.Participants, participant)
+
+		input := suite.newUserChatsInput(userID)
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chatUnread, chatRead}, nil).Once()
+		expectedCounts := map[uuid.UUID]int{
+			chatUnread.ID: 7,
+			chatRead.ID:   2,
+		}
+		mockMessagesRepo.EXPECT().CountUnread(userID, []messagee.ReadMarker{
+			{ChatID: chatUnread.ID},
+			{ChatID: chatRead.ID, ReadAt: readAt},
+		}).Return(expectedCounts, nil).Once()
+		suite.RR.Drafts.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+
+		out, err := usecase.MyChats(input)
+		suite.NoError(err)
+		suite.Equal(expectedCounts, out.UnreadCounts)
+	})
+
+	suite.Run("возвращает черновики пользователя по ID чата", func() {
+		usecase, mockRepo := newUsecase(suite)
+		mockMessagesRepo := usecase.MessagesRepo.(*mockMessagee.Repository)
+
+		userID := uuid.New()
+		chatWithDraft := suite.RndChat()
+		suite.AddParticipant(&chatWithDraft, suite.NewParticipant(userID))
+		chatWithoutDraft := suite.RndChat()
+		suite.AddParticipant(&chatWithoutDraft, suite.NewParticipant(userID))
+		draft := suite.NewDraft(chatWithDraft, userID)
+
+		input := suite.newUserChatsInput(userID)
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chatWithDraft, chatWithoutDraft}, nil).Once()
+		mockMessagesRepo.EXPECT().CountUnread(userID, mock.Anything).Return(map[uuid.UUID]int{}, nil).Once()
+		suite.RR.Drafts.EXPECT().List(draftt.Filter{
+			UserID:  userID,
+			ChatIDs: []uuid.UUID{chatWithDraft.ID, chatWithoutDraft.ID},
+		}).Return([]draftt.Draft{draft}, nil).Once()
+
+		out, err := usecase.MyChats(input)
+		suite.NoError(err)
+		suite.Equal(map[uuid.UUID]draftt.Draft{chatWithDraft.ID: draft}, out.Drafts)
+	})
+
 	suite.Run("не возвращает keyset если элементов меньше лимита", func() {
 		usecase, mockRepo := newUsecase(suite)
 
@@ -89,10 +156,12 @@ func (suite *testSuite) Test_Chats_MyChats() {
 
 		input := suite.newUserChatsInput(userID)
 		mockRepo.EXPECT().List(chatt.Filter{
-			ParticipantID: userID,
-			ActiveBefore:  input.Keyset.ActiveBefore,
-			Limit:         defaultPageSize,
+			ParticipantID:   userID,
+			ActiveBefore:    input.Keyset.ActiveBefore,
+			ExcludeArchived: true,
+			Limit:           defaultPageSize,
 		}).Return(chats, nil).Once()
+		suite.RR.Drafts.EXPECT().List(mock.Anything).Return(nil, nil).Once()
 
 		out, err := usecase.MyChats(input)
 		suite.NoError(err)
@@ -118,16 +187,72 @@ func (suite *testSuite) Test_Chats_MyChats() {
 			},
 		}
 		mockRepo.EXPECT().List(chatt.Filter{
-			ParticipantID: userID,
-			ActiveBefore:  activeBefore,
-			Limit:         defaultPageSize,
+			ParticipantID:   userID,
+			ActiveBefore:    activeBefore,
+			ExcludeArchived: true,
+			Limit:           defaultPageSize,
 		}).Return(expectedChats, nil).Once()
+		suite.RR.Drafts.EXPECT().List(mock.Anything).Return(nil, nil).Once()
 
 		out, err := usecase.MyChats(input)
 		suite.NoError(err)
 		suite.Equal(expectedChats, out.Chats)
 		suite.Zero(out.NextKeyset)
 	})
+
+	suite.Run("вид чатов должен быть корректным", func() {
+		usecase, _ := newUsecase(suite)
+		input := suite.newUserChatsInput(uuid.New())
+		input.Kind = "channel"
+		_, err := usecase.MyChats(input)
+		suite.ErrorIs(err, ErrInvalidKind)
+	})
+
+	suite.Run("учитывает фильтрацию по виду чата", func() {
+		usecase, mockRepo := newUsecase(suite)
+
+		userID := uuid.New()
+		direct, err := chatt.NewDirectChat(userID, uuid.New(), nil)
+		suite.Require().NoError(err)
+
+		input := suite.newUserChatsInput(userID)
+		input.Kind = chatt.KindDirect
+		mockRepo.EXPECT().List(chatt.Filter{
+			ParticipantID:   userID,
+			Kind:            chatt.KindDirect,
+			ActiveBefore:    input.Keyset.ActiveBefore,
+			ExcludeArchived: true,
+			Limit:           defaultPageSize,
+		}).Return([]chatt.Chat{direct}, nil).Once()
+		suite.RR.Messages.EXPECT().CountUnread(userID, mock.Anything).Return(map[uuid.UUID]int{}, nil).Once()
+		suite.RR.Drafts.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+
+		out, err := usecase.MyChats(input)
+		suite.NoError(err)
+		suite.Equal([]chatt.Chat{direct}, out.Chats)
+	})
+
+	suite.Run("архивные чаты возвращаются только по запросу", func() {
+		usecase, mockRepo := newUsecase(suite)
+
+		userID := uuid.New()
+		archived := suite.RndChat()
+		suite.Require().NoError(archived.Archive(archived.ChiefID, nil))
+
+		input := suite.newUserChatsInput(userID)
+		input.Archived = true
+		mockRepo.EXPECT().List(chatt.Filter{
+			ParticipantID: userID,
+			ActiveBefore:  input.Keyset.ActiveBefore,
+			ArchivedOnly:  true,
+			Limit:         defaultPageSize,
+		}).Return([]chatt.Chat{archived}, nil).Once()
+		suite.RR.Drafts.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+
+		out, err := usecase.MyChats(input)
+		suite.NoError(err)
+		suite.Equal([]chatt.Chat{archived}, out.Chats)
+	})
 }
 
 func (suite *testSuite) newUserChatsInput(userID uuid.UUID) In {
@@ -139,7 +264,9 @@ func (suite *testSuite) newUserChatsInput(userID uuid.UUID) In {
 
 func newUsecase(suite *testSuite) (*MyChatsUsecase, *mockChatt.Repository) {
 	uc := &MyChatsUsecase{
-		Repo: suite.RR.Chats,
+		Repo:         suite.RR.Chats,
+		MessagesRepo: suite.RR.Messages,
+		DraftsRepo:   suite.RR.Drafts,
 	}
 	mockRepo := uc.Repo.(*mockChatt.Repository)
 	return uc, mockRepo
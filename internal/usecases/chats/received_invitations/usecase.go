@@ -2,6 +2,7 @@ package receivedInvitations
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -55,10 +56,16 @@ func (c *ReceivedInvitationsUsecase) ReceivedInvitations(in In) (Out, error) {
 		return Out{}, nil
 	}
 
-	// Собрать приглашения, полученные пользователем
+	// Собрать приглашения, полученные пользователем.
+	// Истекшие, но еще не удаленные приглашения не возвращаются
+	now := time.Now()
 	invitations := make(map[uuid.UUID]chatt.Invitation, len(chats))
 	for _, chat := range chats {
-		invitations[chat.ID], _ = chat.RecipientInvitation(in.SubjectID)
+		invitation, _ := chat.RecipientInvitation(in.SubjectID)
+		if invitation.IsExpired(now) {
+			continue
+		}
+		invitations[chat.ID] = invitation
 	}
 
 	return Out{
@@ -2,6 +2,7 @@ package receivedInvitations
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -74,6 +75,26 @@ func (suite *testSuite) Test_Invitations_ReceivedInvitations() {
 		}
 	})
 
+	suite.Run("истекшие приглашения не вернутся", func() {
+		usecase, mockRepo := newUsecase(suite)
+		// ID пользователя
+		userID := uuid.New()
+		// Создать чат с действующим приглашением
+		activeChat := suite.RndChat()
+		activeInvitation := suite.NewInvitation(activeChat.ChiefID, userID)
+		suite.AddInvitation(&activeChat, activeInvitation)
+		// Создать чат с истекшим приглашением
+		expiredChat := suite.RndChat()
+		expiredInvitation := suite.NewInvitation(expiredChat.ChiefID, userID)
+		expiredInvitation.ExpiresAt = time.Now().Add(-time.Minute)
+		suite.AddInvitation(&expiredChat, expiredInvitation)
+		// Получить список приглашений
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{activeChat, expiredChat}, nil).Once()
+		out, err := usecase.ReceivedInvitations(In{SubjectID: userID})
+		suite.NoError(err)
+		suite.Equal(map[uuid.UUID]chatt.Invitation{activeChat.ID: activeInvitation}, out.ChatsInvitations)
+	})
+
 }
 
 func newUsecase(suite *testSuite) (*ReceivedInvitationsUsecase, *mockChatt.Repository) {
@@ -0,0 +1,100 @@
+package removeExpiredInvitations
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// DefaultLimit количество чатов, обрабатываемых за один вызов, если лимит не указан
+const DefaultLimit = 100
+
+var ErrInvalidNow = errors.New("некорректное значение Now")
+
+// In входящие параметры
+type In struct {
+	Now   time.Time // Момент, на который проверяется срок действия приглашений
+	Limit int       // Максимальное количество чатов за вызов
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if in.Now.IsZero() {
+		return ErrInvalidNow
+	}
+
+	return nil
+}
+
+// Out результат удаления истекших приглашений
+type Out struct {
+	Removed int // Количество удаленных приглашений
+}
+
+type RemoveExpiredInvitationsUsecase struct {
+	Repo          chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// RemoveExpiredInvitations удаляет приглашения, срок действия которых истек.
+// Участники чата и приглашенные получают событие об удалении приглашения.
+// Каждый чат перечитывается с блокировкой в отдельной транзакции,
+// поэтому параллельные изменения чата не будут затерты
+func (c *RemoveExpiredInvitationsUsecase) RemoveExpiredInvitations(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+	if in.Limit <= 0 {
+		in.Limit = DefaultLimit
+	}
+
+	// Найти чаты с истекшими приглашениями
+	chats, err := c.Repo.List(chatt.Filter{
+		InvitationExpiresBefore: in.Now,
+		Limit:                   in.Limit,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	var out Out
+	for _, chat := range chats {
+		// Инициализировать буфер событий
+		eventsBuf := new(events.Buffer)
+
+		var removed int
+		err = c.Repo.InTransaction(func(txRepo chatt.Repository) error {
+			// Перечитать чат с блокировкой
+			lockedChat, err := chatt.Find(txRepo, chatt.Filter{ID: chat.ID})
+			if errors.Is(err, chatt.ErrChatNotExists) {
+				// Чат удален, пока обрабатывались предыдущие
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			// Удалить истекшие приглашения
+			removed = lockedChat.RemoveExpiredInvitations(in.Now, eventsBuf)
+			if removed == 0 {
+				return nil
+			}
+
+			// Сохранить чат в репозиторий
+			return txRepo.Upsert(lockedChat)
+		})
+		if err != nil {
+			return out, err
+		}
+		out.Removed += removed
+
+		// Отправить собранные события
+		if removed > 0 {
+			c.EventConsumer.Consume(eventsBuf.Events())
+		}
+	}
+
+	return out, nil
+}
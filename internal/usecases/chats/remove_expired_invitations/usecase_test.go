@@ -0,0 +1,118 @@
+package removeExpiredInvitations
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Invitations_RemoveExpiredInvitations тестирует удаление истекших приглашений
+func (suite *testSuite) Test_Invitations_RemoveExpiredInvitations() {
+	newUsecase := func() (*RemoveExpiredInvitationsUsecase, *mockEvents.Consumer) {
+		uc := &RemoveExpiredInvitationsUsecase{
+			Repo:          suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		suite.RR.Chats.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(chatt.Repository) error) error {
+			return fn(suite.RR.Chats)
+		}).Maybe()
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.RemoveExpiredInvitations(In{})
+		suite.ErrorIs(err, ErrInvalidNow)
+	})
+
+	suite.Run("без истекших приглашений ничего не изменится", func() {
+		usecase, _ := newUsecase()
+		now := time.Now()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{
+			InvitationExpiresBefore: now,
+			Limit:                   DefaultLimit,
+		}).Return(nil, nil).Once()
+		out, err := usecase.RemoveExpiredInvitations(In{Now: now})
+		suite.NoError(err)
+		suite.Zero(out.Removed)
+	})
+
+	suite.Run("истекшие приглашения удалятся, а действующие останутся", func() {
+		usecase, mockEventConsumer := newUsecase()
+		now := time.Now()
+		chat := suite.RndChat()
+		active := suite.NewInvitation(chat.ChiefID, uuid.New())
+		suite.AddInvitation(&chat, active)
+		expired := suite.NewInvitation(chat.ChiefID, uuid.New())
+		expired.ExpiresAt = now.Add(-time.Minute)
+		suite.AddInvitation(&chat, expired)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.Equal([]chatt.Invitation{active}, c.Invitations)
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		out, err := usecase.RemoveExpiredInvitations(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Equal(1, out.Removed)
+		suite.AssertHasEventType(consumedEvents, chatt.EventInvitationRemoved)
+	})
+
+	suite.Run("изменения, сделанные после поиска чатов, не затираются", func() {
+		usecase, mockEventConsumer := newUsecase()
+		now := time.Now()
+		chat := suite.RndChat()
+		expired := suite.NewInvitation(chat.ChiefID, uuid.New())
+		expired.ExpiresAt = now.Add(-time.Minute)
+		suite.AddInvitation(&chat, expired)
+		// Пока выполнялся поиск, в чат вступил новый участник
+		fresh := chat
+		fresh.Participants = slices.Clone(chat.Participants)
+		joined := suite.AddRndParticipant(&fresh)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{fresh}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.True(c.HasParticipant(joined.UserID))
+			suite.Empty(c.Invitations)
+		}).Return(nil).Once()
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		out, err := usecase.RemoveExpiredInvitations(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Equal(1, out.Removed)
+	})
+
+	suite.Run("если истекшие приглашения уже удалены, чат не сохраняется", func() {
+		usecase, _ := newUsecase()
+		now := time.Now()
+		chat := suite.RndChat()
+		expired := suite.NewInvitation(chat.ChiefID, uuid.New())
+		expired.ExpiresAt = now.Add(-time.Minute)
+		fresh := chat
+		suite.AddInvitation(&chat, expired)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{fresh}, nil).Once()
+		out, err := usecase.RemoveExpiredInvitations(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Zero(out.Removed)
+	})
+}
@@ -2,6 +2,7 @@ package sendInvitation
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -20,6 +21,8 @@ type In struct {
 	SubjectID uuid.UUID
 	ChatID    uuid.UUID
 	UserID    uuid.UUID
+	Note      string    // Сообщение к приглашению
+	ExpiresAt time.Time // Нулевое значение - приглашение бессрочное
 }
 
 func (in In) Validate() error {
@@ -58,7 +61,7 @@ func (c *SendInvitationUsecase) SendInvitation(in In) (Out, error) {
 	}
 
 	// Создать приглашение
-	inv, err := chatt.NewInvitation(in.SubjectID, in.UserID)
+	inv, err := chatt.NewInvitation(in.SubjectID, in.UserID, in.Note, in.ExpiresAt)
 	if err != nil {
 		return Out{}, err
 	}
@@ -2,6 +2,7 @@ package sendInvitation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -164,6 +165,46 @@ func (suite *testSuite) Test_Invitations_SendChatInvitation() {
 		}
 	})
 
+	suite.Run("сообщение и срок действия сохраняются в приглашении", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockEventsConsumer := newUsecase(suite)
+		mockEventsConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		// Создать чат
+		chat := suite.RndChat()
+		// Отправить приглашение
+		input := In{
+			ChatID:    chat.ID,
+			SubjectID: chat.ChiefID,
+			UserID:    uuid.New(),
+			Note:      "Присоединяйся",
+			ExpiresAt: time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond),
+		}
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		out, err := usecase.SendInvitation(input)
+		suite.Require().NoError(err)
+		suite.Equal(input.Note, out.Invitation.Note)
+		suite.Equal(input.ExpiresAt, out.Invitation.ExpiresAt)
+	})
+
+	suite.Run("срок действия не может заканчиваться в прошлом", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, _ := newUsecase(suite)
+		// Создать чат
+		chat := suite.RndChat()
+		// Отправить приглашение
+		input := In{
+			ChatID:    chat.ID,
+			SubjectID: chat.ChiefID,
+			UserID:    uuid.New(),
+			ExpiresAt: time.Now().Add(-time.Hour),
+		}
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		out, err := usecase.SendInvitation(input)
+		suite.ErrorIs(err, chatt.ErrInvalidInvitationExpiresAt)
+		suite.Zero(out)
+	})
+
 	suite.Run("после завершения операции, будут созданы события", func() {
 		// Создать usecase и моки
 		usecase, mockRepo, mockEventsConsumer := newUsecase(suite)
@@ -0,0 +1,94 @@
+package setParticipantRole
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidUserID    = errors.New("некорректное значение UserID")
+	ErrInvalidRole      = errors.New("некорректное значение Role")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	UserID    uuid.UUID
+	Role      chatt.Role
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.UserID); err != nil {
+		return errors.Join(err, ErrInvalidUserID)
+	}
+	if err := chatt.ValidateRole(in.Role); err != nil {
+		return errors.Join(err, ErrInvalidRole)
+	}
+
+	return nil
+}
+
+// Out результат изменения роли участника
+type Out struct {
+	Participant chatt.Participant
+}
+
+type SetParticipantRoleUsecase struct {
+	Repo          chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// SetParticipantRole изменяет роль участника чата.
+// Доступно только участникам с правом изменять роли и только для участников с ролью младше своей
+func (c *SetParticipantRoleUsecase) SetParticipantRole(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.Repo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Изменить роль участника
+	if err = chat.SetParticipantRole(in.SubjectID, in.UserID, in.Role, eventsBuf); err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить чат в репозиторий
+	if err = c.Repo.Upsert(chat); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	participant, err := chat.Participant(in.UserID)
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Participant: participant,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package setParticipantRole
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_SetParticipantRole тестирует изменение роли участника
+func (suite *testSuite) Test_Chats_SetParticipantRole() {
+	newUsecase := func() (*SetParticipantRoleUsecase, *mockEvents.Consumer) {
+		uc := &SetParticipantRoleUsecase{
+			Repo:          suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.SetParticipantRole(In{ChatID: uuid.New(), UserID: uuid.New(), Role: chatt.RoleAdmin})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.SetParticipantRole(In{SubjectID: uuid.New(), UserID: uuid.New(), Role: chatt.RoleAdmin})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.SetParticipantRole(In{SubjectID: uuid.New(), ChatID: uuid.New(), Role: chatt.RoleAdmin})
+		suite.ErrorIs(err, ErrInvalidUserID)
+		_, err = usecase.SetParticipantRole(In{SubjectID: uuid.New(), ChatID: uuid.New(), UserID: uuid.New(), Role: "root"})
+		suite.ErrorIs(err, ErrInvalidRole)
+	})
+
+	suite.Run("обычный участник не может изменять роли", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		subject := suite.AddRndParticipant(&chat)
+		user := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.SetParticipantRole(In{SubjectID: subject.UserID, ChatID: chat.ID, UserID: user.UserID, Role: chatt.RoleReadOnly})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+	})
+
+	suite.Run("после изменения роль сохранится и участники получат событие", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		user := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			p, err := c.Participant(user.UserID)
+			suite.Require().NoError(err)
+			suite.Equal(chatt.RoleModerator, p.Role)
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		out, err := usecase.SetParticipantRole(In{SubjectID: chat.ChiefID, ChatID: chat.ID, UserID: user.UserID, Role: chatt.RoleModerator})
+		suite.Require().NoError(err)
+		suite.Equal(chatt.RoleModerator, out.Participant.Role)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventParticipantRoleChanged)
+	})
+}
@@ -0,0 +1,87 @@
+package setRetention
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidRetention = errors.New("некорректное значение Retention")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	Retention chatt.Retention
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := chatt.ValidateRetention(in.Retention); err != nil {
+		return errors.Join(err, ErrInvalidRetention)
+	}
+
+	return nil
+}
+
+// Out результат изменения политики хранения сообщений
+type Out struct {
+	Chat chatt.Chat
+}
+
+type SetRetentionUsecase struct {
+	Repo          chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// SetRetention устанавливает политику хранения сообщений чата.
+// Доступно только участникам с правом изменять настройки чата
+func (c *SetRetentionUsecase) SetRetention(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.Repo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить доступ пользователя к этому действию
+	if !chat.Can(in.SubjectID, chatt.PermissionManageChat) {
+		return Out{}, chatt.ErrPermissionDenied
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Перезаписать с новым значением
+	if err = chat.SetRetention(in.Retention, eventsBuf); err != nil {
+		return Out{}, err
+	}
+	if err = c.Repo.Upsert(chat); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Chat: chat,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package setRetention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_SetRetention тестирует изменение политики хранения сообщений
+func (suite *testSuite) Test_Chats_SetRetention() {
+	suite.Run("политика хранения должна быть валидной", func() {
+		// Создать usecase и моки
+		usecase, _, _ := newUsecase(suite)
+		// Установить политику
+		out, err := usecase.SetRetention(In{
+			SubjectID: uuid.New(),
+			ChatID:    uuid.New(),
+			Retention: chatt.Retention{MaxAge: time.Hour},
+		})
+		suite.ErrorIs(err, ErrInvalidRetention)
+		suite.Zero(out)
+	})
+
+	suite.Run("изменять политику могут только участники с соответствующим правом", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Установить политику от имени участника
+		out, err := usecase.SetRetention(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			Retention: chatt.Retention{MaxAge: 24 * time.Hour},
+		})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+		suite.Zero(out)
+	})
+
+	suite.Run("политика будет сохранена и будут созданы события", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		retention := chatt.Retention{
+			MaxAge:       30 * 24 * time.Hour,
+			SelfDestruct: time.Hour,
+		}
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(chat chatt.Chat) {
+			suite.Equal(retention, chat.Retention)
+		}).Return(nil).Once()
+		// Установить политику
+		out, err := usecase.SetRetention(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Retention: retention,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(retention, out.Chat.Retention)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventChatUpdated)
+	})
+}
+
+func newUsecase(suite *testSuite) (*SetRetentionUsecase, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &SetRetentionUsecase{
+		Repo:          suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockEventsConsumer
+}
@@ -0,0 +1,84 @@
+package transferChief
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidUserID    = errors.New("некорректное значение UserID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	UserID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.UserID); err != nil {
+		return errors.Join(err, ErrInvalidUserID)
+	}
+
+	return nil
+}
+
+// Out результат передачи владения чатом
+type Out struct {
+	Chat chatt.Chat
+}
+
+type TransferChiefUsecase struct {
+	Repo          chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// TransferChief передает владение чатом другому участнику.
+// Доступно только текущему главному администратору чата
+func (c *TransferChiefUsecase) TransferChief(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.Repo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Передать владение
+	if err = chat.TransferChief(in.SubjectID, in.UserID, eventsBuf); err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить чат в репозиторий
+	if err = c.Repo.Upsert(chat); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Chat: chat,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package transferChief
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_TransferChief тестирует передачу владения чатом
+func (suite *testSuite) Test_Chats_TransferChief() {
+	newUsecase := func() (*TransferChiefUsecase, *mockEvents.Consumer) {
+		uc := &TransferChiefUsecase{
+			Repo:          suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.TransferChief(In{ChatID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.TransferChief(In{SubjectID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.TransferChief(In{SubjectID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidUserID)
+	})
+
+	suite.Run("чат должен существовать", func() {
+		usecase, _ := newUsecase()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.TransferChief(In{SubjectID: uuid.New(), ChatID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrChatNotExists)
+	})
+
+	suite.Run("передать владение может только главный администратор", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		subject := suite.AddRndParticipant(&chat)
+		user := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.TransferChief(In{SubjectID: subject.UserID, ChatID: chat.ID, UserID: user.UserID})
+		suite.ErrorIs(err, chatt.ErrSubjectIsNotChief)
+	})
+
+	suite.Run("новый главный администратор должен быть участником", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.TransferChief(In{SubjectID: chat.ChiefID, ChatID: chat.ID, UserID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrParticipantNotExists)
+	})
+
+	suite.Run("после передачи владение сохранится и участники получат событие", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		formerChiefID := chat.ChiefID
+		user := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.Equal(user.UserID, c.ChiefID)
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		out, err := usecase.TransferChief(In{SubjectID: formerChiefID, ChatID: chat.ID, UserID: user.UserID})
+		suite.Require().NoError(err)
+		suite.Equal(user.UserID, out.Chat.ChiefID)
+		former, err := out.Chat.Participant(formerChiefID)
+		suite.Require().NoError(err)
+		suite.Equal(chatt.RoleAdmin, former.Role)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventChatUpdated)
+	})
+}
@@ -0,0 +1,103 @@
+package typing
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidSessionID = errors.New("некорректное значение SessionID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrTooManyRequests  = errors.New("слишком частые уведомления о наборе текста")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	SessionID uuid.UUID
+	ChatID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.SessionID); err != nil {
+		return errors.Join(err, ErrInvalidSessionID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат уведомления о наборе текста
+type Out struct {
+	ExpiresAt time.Time // Время, до которого индикатор считается актуальным
+}
+
+// RateLimiter ограничивает частоту операций по ключу
+type RateLimiter interface {
+	// Allow сообщает, разрешена ли операция по ключу в данный момент
+	Allow(key uuid.UUID) bool
+}
+
+type TypingUsecase struct {
+	Repo          chatt.Repository
+	RateLimiter   RateLimiter
+	EventConsumer events.Consumer
+}
+
+// Typing сообщает остальным участникам чата, что пользователь набирает текст.
+// Событие не сохраняется, а частота уведомлений ограничена для каждой сессии в каждом чате
+func (c *TypingUsecase) Typing(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.Repo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Посторонние не должны расходовать лимит сессии
+	if !chat.HasParticipant(in.SubjectID) {
+		return Out{}, chatt.ErrParticipantNotExists
+	}
+
+	// Ограничить частоту уведомлений от сессии в этом чате
+	if !c.RateLimiter.Allow(rateLimitKey(in.SessionID, in.ChatID)) {
+		return Out{}, ErrTooManyRequests
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Создать событие набора текста
+	if err = chat.Typing(in.SubjectID, eventsBuf); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		ExpiresAt: time.Now().Add(chatt.TypingTTL),
+	}, nil
+}
+
+// rateLimitKey возвращает ключ ограничения частоты для пары сессии и чата
+func rateLimitKey(sessionID, chatID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(sessionID, chatID[:])
+}
@@ -0,0 +1,121 @@
+package typing
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	mockTyping "github.com/nice-pea/npchat/internal/usecases/chats/typing/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_Typing тестирует уведомление о наборе текста
+func (suite *testSuite) Test_Chats_Typing() {
+	suite.Run("SessionID должен быть валидным", func() {
+		usecase, _, _, _ := newUsecase(suite)
+		out, err := usecase.Typing(In{
+			SubjectID: uuid.New(),
+			ChatID:    uuid.New(),
+		})
+		suite.ErrorIs(err, ErrInvalidSessionID)
+		suite.Zero(out)
+	})
+
+	suite.Run("частота уведомлений от сессии в чате ограничена", func() {
+		usecase, mockRepo, mockRateLimiter, _ := newUsecase(suite)
+		sessionID := uuid.New()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRateLimiter.EXPECT().Allow(rateLimitKey(sessionID, chat.ID)).Return(false).Once()
+		out, err := usecase.Typing(In{
+			SubjectID: p.UserID,
+			SessionID: sessionID,
+			ChatID:    chat.ID,
+		})
+		suite.ErrorIs(err, ErrTooManyRequests)
+		suite.Zero(out)
+	})
+
+	suite.Run("лимит сессии раздельный для разных чатов", func() {
+		sessionID := uuid.New()
+		suite.NotEqual(rateLimitKey(sessionID, uuid.New()), rateLimitKey(sessionID, uuid.New()))
+		chatID := uuid.New()
+		suite.Equal(rateLimitKey(sessionID, chatID), rateLimitKey(sessionID, chatID))
+	})
+
+	suite.Run("чат должен существовать", func() {
+		usecase, mockRepo, _, _ := newUsecase(suite)
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		out, err := usecase.Typing(In{
+			SubjectID: uuid.New(),
+			SessionID: uuid.New(),
+			ChatID:    uuid.New(),
+		})
+		suite.ErrorIs(err, chatt.ErrChatNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("сообщать о наборе текста могут только участники чата, не расходуя лимит", func() {
+		usecase, mockRepo, _, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		out, err := usecase.Typing(In{
+			SubjectID: uuid.New(),
+			SessionID: uuid.New(),
+			ChatID:    chat.ID,
+		})
+		suite.ErrorIs(err, chatt.ErrParticipantNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("остальные участники получат событие набора текста", func() {
+		usecase, mockRepo, mockRateLimiter, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		mockRateLimiter.EXPECT().Allow(mock.Anything).Return(true).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		out, err := usecase.Typing(In{
+			SubjectID: p.UserID,
+			SessionID: uuid.New(),
+			ChatID:    chat.ID,
+		})
+		suite.Require().NoError(err)
+		suite.NotZero(out.ExpiresAt)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventTyping)
+		suite.Require().Len(consumedEvents, 1)
+		suite.NotContains(consumedEvents[0].Recipients, p.UserID)
+	})
+}
+
+func newUsecase(suite *testSuite) (*TypingUsecase, *mockChatt.Repository, *mockTyping.RateLimiter, *mockEvents.Consumer) {
+	uc := &TypingUsecase{
+		Repo:          suite.RR.Chats,
+		RateLimiter:   mockTyping.NewRateLimiter(suite.T()),
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockChatt.Repository)
+	mockRateLimiter := uc.RateLimiter.(*mockTyping.RateLimiter)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockRateLimiter, mockEventsConsumer
+}
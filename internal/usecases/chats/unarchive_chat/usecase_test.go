@@ -0,0 +1,70 @@
+package unarchiveChat
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Chats_UnarchiveChat тестирует возврат чата из архива
+func (suite *testSuite) Test_Chats_UnarchiveChat() {
+	newUsecase := func() (*UnarchiveChatUsecase, *mockEvents.Consumer) {
+		uc := &UnarchiveChatUsecase{
+			Repo:          suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.UnarchiveChat(In{ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.UnarchiveChat(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+	})
+
+	suite.Run("вернуть из архива можно только архивный чат", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.UnarchiveChat(In{SubjectID: chat.ChiefID, ChatID: chat.ID})
+		suite.ErrorIs(err, chatt.ErrChatIsNotArchived)
+	})
+
+	suite.Run("после возврата из архива чат сохранится и участники получат событие", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		suite.Require().NoError(chat.Archive(chat.ChiefID, nil))
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.False(c.IsArchived())
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		out, err := usecase.UnarchiveChat(In{SubjectID: chat.ChiefID, ChatID: chat.ID})
+		suite.Require().NoError(err)
+		suite.False(out.Chat.IsArchived())
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventChatUpdated)
+	})
+}
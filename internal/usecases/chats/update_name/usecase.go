@@ -11,10 +11,9 @@ import (
 )
 
 var (
-	ErrInvalidSubjectID      = errors.New("некорректное значение SubjectID")
-	ErrInvalidChatID         = errors.New("некорректное значение ChatID")
-	ErrInvalidName           = errors.New("некорректное значение Name")
-	ErrSubjectUserIsNotChief = errors.New("пользователь не является главным администратором чата")
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidName      = errors.New("некорректное значение Name")
 )
 
 // In входящие параметры
@@ -50,7 +49,7 @@ type UpdateNameUsecase struct {
 }
 
 // UpdateName обновляет название чата.
-// Доступно только для главного администратора этого чата
+// Доступно только участникам с правом изменять название чата
 func (c *UpdateNameUsecase) UpdateName(in In) (Out, error) {
 	// Валидировать параметры
 	if err := in.Validate(); err != nil {
@@ -64,8 +63,8 @@ func (c *UpdateNameUsecase) UpdateName(in In) (Out, error) {
 	}
 
 	// Проверить доступ пользователя к этому действию
-	if in.SubjectID != chat.ChiefID {
-		return Out{}, ErrSubjectUserIsNotChief
+	if !chat.Can(in.SubjectID, chatt.PermissionRename) {
+		return Out{}, chatt.ErrPermissionDenied
 	}
 
 	// Инициализировать буфер событий
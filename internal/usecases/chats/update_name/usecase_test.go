@@ -43,7 +43,7 @@ func (suite *testSuite) Test_Chats_UpdateName() {
 		suite.Zero(chat)
 	})
 
-	suite.Run("только главный администратор может изменять название", func() {
+	suite.Run("изменять название могут только участники с соответствующим правом", func() {
 		// Создать usecase и моки
 		usecase, mockRepo, _ := newUsecase(suite)
 		// Создать чат
@@ -56,8 +56,8 @@ func (suite *testSuite) Test_Chats_UpdateName() {
 		}
 		mockRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
 		out, err := usecase.UpdateName(input)
-		// Вернется ошибка, потому что пользователь не главный администратор чата
-		suite.ErrorIs(err, ErrSubjectUserIsNotChief)
+		// Вернется ошибка, потому что у пользователя нет права изменять название
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
 		suite.Zero(out)
 	})
 
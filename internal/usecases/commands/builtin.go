@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	deleteMember "github.com/nice-pea/npchat/internal/usecases/chats/delete_member"
+	leaveChat "github.com/nice-pea/npchat/internal/usecases/chats/leave_chat"
+	sendInvitation "github.com/nice-pea/npchat/internal/usecases/chats/send_invitation"
+	updateName "github.com/nice-pea/npchat/internal/usecases/chats/update_name"
+)
+
+// Имена встроенных команд
+const (
+	CommandInvite = "invite"
+	CommandLeave  = "leave"
+	CommandRename = "rename"
+	CommandKick   = "kick"
+)
+
+// BuiltinUsecases определяет сценарии, на которые опираются встроенные команды.
+type BuiltinUsecases interface {
+	SendInvitation(sendInvitation.In) (sendInvitation.Out, error)
+	LeaveChat(leaveChat.In) (leaveChat.Out, error)
+	UpdateName(updateName.In) (updateName.Out, error)
+	DeleteMember(deleteMember.In) (deleteMember.Out, error)
+}
+
+// NewBuiltinRegistry создает реестр со встроенными командами:
+//
+//	/invite @nick - пригласить пользователя в чат
+//	/leave        - покинуть чат
+//	/rename name  - переименовать чат
+//	/kick @nick   - удалить участника из чата
+func NewBuiltinRegistry(uc BuiltinUsecases, usersRepo userr.Repository) Registry {
+	r := Registry{}
+	r.Add(CommandInvite, HandlerFunc(func(call Call) (string, error) {
+		user, err := findByNick(usersRepo, call.Args)
+		if err != nil {
+			return "", err
+		}
+		if _, err = uc.SendInvitation(sendInvitation.In{
+			SubjectID: call.SubjectID,
+			ChatID:    call.Chat.ID,
+			UserID:    user.ID,
+		}); err != nil {
+			return "", err
+		}
+		return "Приглашение отправлено @" + user.Nick, nil
+	}))
+	r.Add(CommandLeave, HandlerFunc(func(call Call) (string, error) {
+		if _, err := uc.LeaveChat(leaveChat.In{
+			SubjectID: call.SubjectID,
+			ChatID:    call.Chat.ID,
+		}); err != nil {
+			return "", err
+		}
+		return "Вы покинули чат", nil
+	}))
+	r.Add(CommandRename, HandlerFunc(func(call Call) (string, error) {
+		if _, err := uc.UpdateName(updateName.In{
+			SubjectID: call.SubjectID,
+			ChatID:    call.Chat.ID,
+			NewName:   call.Args,
+		}); err != nil {
+			return "", err
+		}
+		return "", nil
+	}))
+	r.Add(CommandKick, HandlerFunc(func(call Call) (string, error) {
+		user, err := findByNick(usersRepo, call.Args)
+		if err != nil {
+			return "", err
+		}
+		if _, err = uc.DeleteMember(deleteMember.In{
+			SubjectID: call.SubjectID,
+			ChatID:    call.Chat.ID,
+			UserID:    user.ID,
+		}); err != nil {
+			return "", err
+		}
+		return "@" + user.Nick + " удален из чата", nil
+	}))
+
+	return r
+}
+
+// findByNick находит пользователя по аргументу вида @nick
+func findByNick(usersRepo userr.Repository, args string) (userr.User, error) {
+	nick := strings.TrimPrefix(args, "@")
+	if nick == "" || strings.ContainsAny(nick, " \t\n") {
+		return userr.User{}, commandd.ErrInvalidCommandArgs
+	}
+
+	return userr.Find(usersRepo, userr.Filter{Nicks: []string{nick}})
+}
@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	deleteMember "github.com/nice-pea/npchat/internal/usecases/chats/delete_member"
+	leaveChat "github.com/nice-pea/npchat/internal/usecases/chats/leave_chat"
+	sendInvitation "github.com/nice-pea/npchat/internal/usecases/chats/send_invitation"
+	updateName "github.com/nice-pea/npchat/internal/usecases/chats/update_name"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// builtinUsecases запоминает вызовы сценариев встроенными командами
+type builtinUsecases struct {
+	invitation sendInvitation.In
+	leave      leaveChat.In
+	rename     updateName.In
+	kick       deleteMember.In
+}
+
+func (b *builtinUsecases) SendInvitation(in sendInvitation.In) (sendInvitation.Out, error) {
+	b.invitation = in
+	return sendInvitation.Out{}, nil
+}
+
+func (b *builtinUsecases) LeaveChat(in leaveChat.In) (leaveChat.Out, error) {
+	b.leave = in
+	return leaveChat.Out{}, nil
+}
+
+func (b *builtinUsecases) UpdateName(in updateName.In) (updateName.Out, error) {
+	b.rename = in
+	return updateName.Out{}, nil
+}
+
+func (b *builtinUsecases) DeleteMember(in deleteMember.In) (deleteMember.Out, error) {
+	b.kick = in
+	return deleteMember.Out{}, nil
+}
+
+// Test_Commands_Builtin тестирует встроенные команды
+func (suite *testSuite) Test_Commands_Builtin() {
+	suite.Run("регистрируются все встроенные команды", func() {
+		registry := NewBuiltinRegistry(&builtinUsecases{}, suite.RR.Users)
+		for _, name := range []string{CommandInvite, CommandLeave, CommandRename, CommandKick} {
+			_, ok := registry.Handler(name)
+			suite.True(ok, name)
+		}
+	})
+
+	suite.Run("/invite приглашает пользователя по нику", func() {
+		uc := &builtinUsecases{}
+		registry := NewBuiltinRegistry(uc, suite.RR.Users)
+		chat := suite.RndChat()
+		user := suite.NewRndUserWithBasicAuth()
+		suite.RR.Users.EXPECT().List(userr.Filter{Nicks: []string{user.Nick}}).Return([]userr.User{user}, nil).Once()
+		h, _ := registry.Handler(CommandInvite)
+		reply, err := h.Handle(Call{SubjectID: chat.ChiefID, Chat: chat, Args: "@" + user.Nick})
+		suite.Require().NoError(err)
+		suite.Contains(reply, user.Nick)
+		suite.Equal(sendInvitation.In{SubjectID: chat.ChiefID, ChatID: chat.ID, UserID: user.ID}, uc.invitation)
+	})
+
+	suite.Run("/kick удаляет участника по нику", func() {
+		uc := &builtinUsecases{}
+		registry := NewBuiltinRegistry(uc, suite.RR.Users)
+		chat := suite.RndChat()
+		user := suite.NewRndUserWithBasicAuth()
+		suite.RR.Users.EXPECT().List(mock.Anything).Return([]userr.User{user}, nil).Once()
+		h, _ := registry.Handler(CommandKick)
+		_, err := h.Handle(Call{SubjectID: chat.ChiefID, Chat: chat, Args: user.Nick})
+		suite.Require().NoError(err)
+		suite.Equal(deleteMember.In{SubjectID: chat.ChiefID, ChatID: chat.ID, UserID: user.ID}, uc.kick)
+	})
+
+	suite.Run("ник обязателен и должен быть одним словом", func() {
+		registry := NewBuiltinRegistry(&builtinUsecases{}, suite.RR.Users)
+		h, _ := registry.Handler(CommandInvite)
+		_, err := h.Handle(Call{SubjectID: uuid.New(), Chat: suite.RndChat(), Args: ""})
+		suite.ErrorIs(err, commandd.ErrInvalidCommandArgs)
+		_, err = h.Handle(Call{SubjectID: uuid.New(), Chat: suite.RndChat(), Args: "@a @b"})
+		suite.ErrorIs(err, commandd.ErrInvalidCommandArgs)
+	})
+
+	suite.Run("/rename и /leave вызывают соответствующие сценарии", func() {
+		uc := &builtinUsecases{}
+		registry := NewBuiltinRegistry(uc, suite.RR.Users)
+		chat := suite.RndChat()
+		rename, _ := registry.Handler(CommandRename)
+		_, err := rename.Handle(Call{SubjectID: chat.ChiefID, Chat: chat, Args: "New name"})
+		suite.Require().NoError(err)
+		suite.Equal(updateName.In{SubjectID: chat.ChiefID, ChatID: chat.ID, NewName: "New name"}, uc.rename)
+		leave, _ := registry.Handler(CommandLeave)
+		_, err = leave.Handle(Call{SubjectID: chat.ChiefID, Chat: chat})
+		suite.Require().NoError(err)
+		suite.Equal(leaveChat.In{SubjectID: chat.ChiefID, ChatID: chat.ID}, uc.leave)
+	})
+}
+
+// Test_Registry тестирует регистрацию команд
+func (suite *testSuite) Test_Registry() {
+	noop := HandlerFunc(func(Call) (string, error) { return "", nil })
+
+	suite.Run("некорректное имя вызывает панику", func() {
+		suite.Panics(func() { Registry{}.Add("Bad-Name", noop) })
+	})
+
+	suite.Run("повторная регистрация вызывает панику", func() {
+		r := Registry{}
+		r.Add("ping", noop)
+		suite.Panics(func() { r.Add("ping", noop) })
+	})
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+)
+
+// Call описывает вызов команды участником чата.
+type Call struct {
+	SubjectID uuid.UUID  // ID пользователя, вызвавшего команду
+	Chat      chatt.Chat // Чат, в котором вызвана команда
+	Args      string     // Аргументы команды
+}
+
+// Handler определяет интерфейс обработчика команды.
+type Handler interface {
+	// Handle выполняет команду и возвращает ответ, который увидит только вызвавший ее пользователь.
+	// Пустой ответ не отправляется
+	Handle(call Call) (string, error)
+}
+
+// HandlerFunc позволяет использовать функцию в качестве обработчика команды.
+type HandlerFunc func(call Call) (string, error)
+
+// Handle вызывает f(call)
+func (f HandlerFunc) Handle(call Call) (string, error) {
+	return f(call)
+}
+
+// Registry представляет собой карту обработчиков команд, где ключом является имя команды.
+type Registry map[string]Handler
+
+// Add регистрирует обработчик команды.
+// Вызывает панику, если имя некорректно или уже занято
+func (r Registry) Add(name string, h Handler) {
+	if err := commandd.ValidateName(name); err != nil {
+		panic(err.Error() + ": " + name)
+	}
+	if _, ok := r[name]; ok {
+		panic("команда уже зарегистрирована: " + name)
+	}
+	r[name] = h
+}
+
+// Handler возвращает обработчик команды по ее имени
+func (r Registry) Handler(name string) (Handler, bool) {
+	h, ok := r[name]
+	return h, ok
+}
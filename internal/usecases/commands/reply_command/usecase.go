@@ -0,0 +1,103 @@
+package replyCommand
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID   = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID      = errors.New("некорректное значение ChatID")
+	ErrInvalidUserID      = errors.New("некорректное значение UserID")
+	ErrInvalidCommand     = errors.New("некорректное значение Command")
+	ErrInvalidText        = errors.New("некорректное значение Text")
+	ErrSubjectIsNotBot    = errors.New("отвечать на команды могут только боты")
+	ErrSubjectIsNotMember = errors.New("бот не является участником чата")
+	ErrUserIsNotMember    = errors.New("пользователь не является участником чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID // ID бота
+	ChatID    uuid.UUID
+	UserID    uuid.UUID // ID пользователя, вызвавшего команду
+	Command   string    // Имя команды, на которую дается ответ
+	Text      string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.UserID); err != nil {
+		return errors.Join(err, ErrInvalidUserID)
+	}
+	if err := commandd.ValidateName(in.Command); err != nil {
+		return errors.Join(err, ErrInvalidCommand)
+	}
+	if err := messagee.ValidateMessageText(in.Text); err != nil {
+		return errors.Join(err, ErrInvalidText)
+	}
+
+	return nil
+}
+
+// Out результат ответа на команду
+type Out struct{}
+
+type ReplyCommandUsecase struct {
+	ChatsRepo     chatt.Repository
+	UsersRepo     userr.Repository
+	EventConsumer events.Consumer
+}
+
+// ReplyCommand отправляет ответ бота на команду.
+// Ответ видит только вызвавший команду пользователь, в чате он не сохраняется
+func (c *ReplyCommandUsecase) ReplyCommand(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Отвечать могут только боты
+	bot, err := userr.Find(c.UsersRepo, userr.Filter{ID: in.SubjectID})
+	if err != nil {
+		return Out{}, err
+	}
+	if !bot.IsBot() {
+		return Out{}, ErrSubjectIsNotBot
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+	if !chat.HasParticipant(bot.ID) {
+		return Out{}, ErrSubjectIsNotMember
+	}
+	if !chat.HasParticipant(in.UserID) {
+		return Out{}, ErrUserIsNotMember
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+	eventsBuf.Add(commandd.NewEventCommandReply(chat.ID, in.UserID, in.Command, in.Text))
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{}, nil
+}
@@ -0,0 +1,92 @@
+package replyCommand
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Commands_ReplyCommand тестирует ответ бота на команду
+func (suite *testSuite) Test_Commands_ReplyCommand() {
+	newUsecase := func() (*ReplyCommandUsecase, *mockEvents.Consumer) {
+		mockEventConsumer := mockEvents.NewConsumer(suite.T())
+		return &ReplyCommandUsecase{
+			ChatsRepo:     suite.RR.Chats,
+			UsersRepo:     suite.RR.Users,
+			EventConsumer: mockEventConsumer,
+		}, mockEventConsumer
+	}
+	validIn := func(botID, chatID, userID uuid.UUID) In {
+		return In{SubjectID: botID, ChatID: chatID, UserID: userID, Command: "deploy", Text: "готово"}
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		in := validIn(uuid.New(), uuid.New(), uuid.New())
+		in.Command = "Bad"
+		_, err := usecase.ReplyCommand(in)
+		suite.ErrorIs(err, ErrInvalidCommand)
+		in = validIn(uuid.New(), uuid.New(), uuid.New())
+		in.Text = ""
+		_, err = usecase.ReplyCommand(in)
+		suite.ErrorIs(err, ErrInvalidText)
+	})
+
+	suite.Run("отвечать могут только боты", func() {
+		usecase, _ := newUsecase()
+		user := suite.NewRndUserWithBasicAuth()
+		suite.RR.Users.EXPECT().List(mock.Anything).Return([]userr.User{user}, nil).Once()
+		_, err := usecase.ReplyCommand(validIn(user.ID, uuid.New(), uuid.New()))
+		suite.ErrorIs(err, ErrSubjectIsNotBot)
+	})
+
+	suite.Run("бот и пользователь должны быть участниками чата", func() {
+		usecase, _ := newUsecase()
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		chat := suite.RndChat()
+		suite.RR.Users.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Times(2)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.ReplyCommand(validIn(bot.ID, chat.ID, chat.ChiefID))
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+		suite.AddParticipant(&chat, suite.NewParticipant(bot.ID))
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err = usecase.ReplyCommand(validIn(bot.ID, chat.ID, uuid.New()))
+		suite.ErrorIs(err, ErrUserIsNotMember)
+	})
+
+	suite.Run("ответ получит только указанный пользователь", func() {
+		usecase, mockEventConsumer := newUsecase()
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		chat := suite.RndChat()
+		suite.AddParticipant(&chat, suite.NewParticipant(bot.ID))
+		suite.RR.Users.EXPECT().List(userr.Filter{ID: bot.ID}).Return([]userr.User{bot}, nil).Once()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(ee []events.Event) {
+			consumedEvents = append(consumedEvents, ee...)
+		}).Return().Once()
+		_, err := usecase.ReplyCommand(validIn(bot.ID, chat.ID, chat.ChiefID))
+		suite.Require().NoError(err)
+		suite.Require().Len(consumedEvents, 1)
+		suite.Equal(commandd.EventCommandReply, consumedEvents[0].Type)
+		suite.Equal([]uuid.UUID{chat.ChiefID}, consumedEvents[0].Recipients)
+		suite.Equal("готово", consumedEvents[0].Data["text"])
+	})
+}
@@ -0,0 +1,124 @@
+package runCommand
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/commands"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID   = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID      = errors.New("некорректное значение ChatID")
+	ErrInvalidText        = errors.New("текст не является командой")
+	ErrSubjectIsNotMember = errors.New("пользователь не является участником чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	Text      string // Текст сообщения, начинающийся с команды
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if _, ok := commandd.Parse(in.Text); !ok {
+		return ErrInvalidText
+	}
+
+	return nil
+}
+
+// Out результат выполнения команды
+type Out struct {
+	Command commandd.Command
+	Reply   string      // Ответ встроенной команды, который видит только вызвавший пользователь
+	BotIDs  []uuid.UUID // ID ботов, которым передан вызов команды
+}
+
+type RunCommandUsecase struct {
+	Registry      commands.Registry
+	ChatsRepo     chatt.Repository
+	UsersRepo     userr.Repository
+	EventConsumer events.Consumer
+}
+
+// RunCommand выполняет команду, вызванную участником чата.
+// Встроенные команды выполняются сразу, их ответ получает только вызвавший пользователь.
+// Остальные команды передаются ботам-участникам чата, которые их зарегистрировали.
+// Встроенные команды имеют приоритет над командами ботов
+func (c *RunCommandUsecase) RunCommand(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+	command, _ := commandd.Parse(in.Text)
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Вызывать команды могут только участники чата
+	if !chat.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMember
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	out := Out{
+		Command: command,
+	}
+	if h, ok := c.Registry.Handler(command.Name); ok {
+		// Выполнить встроенную команду
+		if out.Reply, err = h.Handle(commands.Call{
+			SubjectID: in.SubjectID,
+			Chat:      chat,
+			Args:      command.Args,
+		}); err != nil {
+			return Out{}, err
+		}
+		if out.Reply != "" {
+			eventsBuf.Add(commandd.NewEventCommandReply(chat.ID, in.SubjectID, command.Name, out.Reply))
+		}
+	} else {
+		// Найти ботов чата, которые обрабатывают команду
+		bots, err := c.UsersRepo.List(userr.Filter{
+			IDs:        chat.ParticipantIDs(),
+			Kind:       userr.KindBot,
+			BotCommand: command.Name,
+		})
+		if err != nil {
+			return Out{}, err
+		}
+		if len(bots) == 0 {
+			return Out{}, commandd.ErrUnknownCommand
+		}
+
+		// Передать вызов ботам
+		for _, bot := range bots {
+			eventsBuf.Add(commandd.NewEventCommandInvoked(chat.ID, bot.ID, in.SubjectID, command))
+			out.BotIDs = append(out.BotIDs, bot.ID)
+		}
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return out, nil
+}
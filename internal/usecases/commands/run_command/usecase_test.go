@@ -0,0 +1,126 @@
+package runCommand
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/commands"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Commands_RunCommand тестирует выполнение команд
+func (suite *testSuite) Test_Commands_RunCommand() {
+	newUsecase := func(registry commands.Registry) (*RunCommandUsecase, *mockEvents.Consumer) {
+		mockEventConsumer := mockEvents.NewConsumer(suite.T())
+		return &RunCommandUsecase{
+			Registry:      registry,
+			ChatsRepo:     suite.RR.Chats,
+			UsersRepo:     suite.RR.Users,
+			EventConsumer: mockEventConsumer,
+		}, mockEventConsumer
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase(nil)
+		_, err := usecase.RunCommand(In{ChatID: uuid.New(), Text: "/ping"})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.RunCommand(In{SubjectID: uuid.New(), Text: "/ping"})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.RunCommand(In{SubjectID: uuid.New(), ChatID: uuid.New(), Text: "ping"})
+		suite.ErrorIs(err, ErrInvalidText)
+	})
+
+	suite.Run("вызывать команды могут только участники чата", func() {
+		usecase, _ := newUsecase(nil)
+		chat := suite.RndChat()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.RunCommand(In{SubjectID: uuid.New(), ChatID: chat.ID, Text: "/ping"})
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+	})
+
+	suite.Run("ответ встроенной команды получит только вызвавший пользователь", func() {
+		usecase, mockEventConsumer := newUsecase(commands.Registry{
+			"ping": commands.HandlerFunc(func(call commands.Call) (string, error) {
+				return "pong " + call.Args, nil
+			}),
+		})
+		chat := suite.RndChat()
+		suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(ee []events.Event) {
+			consumedEvents = append(consumedEvents, ee...)
+		}).Return().Once()
+		out, err := usecase.RunCommand(In{SubjectID: chat.ChiefID, ChatID: chat.ID, Text: "/PING 1"})
+		suite.Require().NoError(err)
+		suite.Equal(commandd.Command{Name: "ping", Args: "1"}, out.Command)
+		suite.Equal("pong 1", out.Reply)
+		suite.Require().Len(consumedEvents, 1)
+		suite.Equal(commandd.EventCommandReply, consumedEvents[0].Type)
+		suite.Equal([]uuid.UUID{chat.ChiefID}, consumedEvents[0].Recipients)
+	})
+
+	suite.Run("ошибка встроенной команды возвращается", func() {
+		errCommand := errors.New("command error")
+		usecase, _ := newUsecase(commands.Registry{
+			"ping": commands.HandlerFunc(func(commands.Call) (string, error) {
+				return "", errCommand
+			}),
+		})
+		chat := suite.RndChat()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.RunCommand(In{SubjectID: chat.ChiefID, ChatID: chat.ID, Text: "/ping"})
+		suite.ErrorIs(err, errCommand)
+	})
+
+	suite.Run("команда бота передается ботам-участникам чата", func() {
+		usecase, mockEventConsumer := newUsecase(commands.Registry{})
+		chat := suite.RndChat()
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		suite.AddParticipant(&chat, suite.NewParticipant(bot.ID))
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Users.EXPECT().List(userr.Filter{
+			IDs:        chat.ParticipantIDs(),
+			Kind:       userr.KindBot,
+			BotCommand: "deploy",
+		}).Return([]userr.User{bot}, nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(ee []events.Event) {
+			consumedEvents = append(consumedEvents, ee...)
+		}).Return().Once()
+		out, err := usecase.RunCommand(In{SubjectID: chat.ChiefID, ChatID: chat.ID, Text: "/deploy prod"})
+		suite.Require().NoError(err)
+		suite.Equal([]uuid.UUID{bot.ID}, out.BotIDs)
+		suite.Empty(out.Reply)
+		suite.Require().Len(consumedEvents, 1)
+		suite.Equal(commandd.EventCommandInvoked, consumedEvents[0].Type)
+		suite.Equal([]uuid.UUID{bot.ID}, consumedEvents[0].Recipients)
+		suite.Equal(chat.ChiefID, consumedEvents[0].Data["user_id"])
+	})
+
+	suite.Run("неизвестная команда вернет ошибку", func() {
+		usecase, _ := newUsecase(commands.Registry{})
+		chat := suite.RndChat()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Users.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.RunCommand(In{SubjectID: chat.ChiefID, ChatID: chat.ID, Text: "/unknown"})
+		suite.ErrorIs(err, commandd.ErrUnknownCommand)
+	})
+}
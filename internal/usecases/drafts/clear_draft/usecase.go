@@ -0,0 +1,83 @@
+package clearDraft
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidSessionID = errors.New("некорректное значение SessionID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	SessionID uuid.UUID
+	ChatID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.SessionID); err != nil {
+		return errors.Join(err, ErrInvalidSessionID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат очистки черновика
+type Out struct{}
+
+type ClearDraftUsecase struct {
+	Repo          draftt.Repository
+	EventConsumer events.Consumer
+}
+
+// ClearDraft удаляет черновик пользователя в чате.
+// Очистка отсутствующего черновика не считается ошибкой
+func (c *ClearDraftUsecase) ClearDraft(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти черновик
+	draft, err := draftt.Find(c.Repo, draftt.Filter{
+		UserID: in.SubjectID,
+		ChatID: in.ChatID,
+	})
+	if errors.Is(err, draftt.ErrDraftNotExists) {
+		return Out{}, nil
+	} else if err != nil {
+		return Out{}, err
+	}
+
+	// Удалить черновик из репозитория
+	if err = c.Repo.Delete(draft.UserID, draft.ChatID); err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Очистить черновик
+	draft.Clear(in.SessionID, eventsBuf)
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{}, nil
+}
@@ -0,0 +1,81 @@
+package clearDraft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Drafts_ClearDraft тестирует очистку черновика
+func (suite *testSuite) Test_Drafts_ClearDraft() {
+	newUsecase := func() (*ClearDraftUsecase, *mockEvents.Consumer) {
+		uc := &ClearDraftUsecase{
+			Repo:          suite.RR.Drafts,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.ClearDraft(In{SessionID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.ClearDraft(In{SubjectID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSessionID)
+		_, err = usecase.ClearDraft(In{SubjectID: uuid.New(), SessionID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+	})
+
+	suite.Run("очистка отсутствующего черновика не является ошибкой", func() {
+		usecase, _ := newUsecase()
+		suite.RR.Drafts.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.ClearDraft(In{SubjectID: uuid.New(), SessionID: uuid.New(), ChatID: uuid.New()})
+		suite.NoError(err)
+	})
+
+	suite.Run("ошибка репозитория возвращается", func() {
+		usecase, _ := newUsecase()
+		errRepo := errors.New("repo error")
+		suite.RR.Drafts.EXPECT().List(mock.Anything).Return(nil, errRepo).Once()
+		_, err := usecase.ClearDraft(In{SubjectID: uuid.New(), SessionID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, errRepo)
+	})
+
+	suite.Run("черновик удалится, а событие получат остальные сессии", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		draft := suite.NewDraft(chat, chat.ChiefID)
+		sessionID := uuid.New()
+		suite.RR.Drafts.EXPECT().List(draftt.Filter{UserID: chat.ChiefID, ChatID: chat.ID}).Return([]draftt.Draft{draft}, nil).Once()
+		suite.RR.Drafts.EXPECT().Delete(chat.ChiefID, chat.ID).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		_, err := usecase.ClearDraft(In{SubjectID: chat.ChiefID, SessionID: sessionID, ChatID: chat.ID})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, draftt.EventDraftUpdated)
+		suite.Require().Len(consumedEvents, 1)
+		suite.Equal([]uuid.UUID{sessionID}, consumedEvents[0].ExcludedSessions)
+		suite.Empty(consumedEvents[0].Data["draft"].(draftt.Draft).Text)
+	})
+}
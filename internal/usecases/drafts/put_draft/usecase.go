@@ -0,0 +1,91 @@
+package putDraft
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidSessionID = errors.New("некорректное значение SessionID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	SessionID uuid.UUID
+	ChatID    uuid.UUID
+	Text      string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.SessionID); err != nil {
+		return errors.Join(err, ErrInvalidSessionID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := draftt.ValidateDraftText(in.Text); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Out результат сохранения черновика
+type Out struct {
+	Draft draftt.Draft
+}
+
+type PutDraftUsecase struct {
+	Repo          draftt.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// PutDraft сохраняет черновик сообщения пользователя в чате, заменяя предыдущий.
+// Остальные сессии пользователя получат событие об изменении черновика
+func (c *PutDraftUsecase) PutDraft(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Создать черновик
+	draft, err := draftt.NewDraft(chat, in.SubjectID, in.SessionID, in.Text, eventsBuf)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить черновик в репозиторий
+	if err = c.Repo.Upsert(draft); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Draft: draft,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package putDraft
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Drafts_PutDraft тестирует сохранение черновика
+func (suite *testSuite) Test_Drafts_PutDraft() {
+	newUsecase := func() (*PutDraftUsecase, *mockEvents.Consumer) {
+		uc := &PutDraftUsecase{
+			Repo:          suite.RR.Drafts,
+			ChatsRepo:     suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.PutDraft(In{SessionID: uuid.New(), ChatID: uuid.New(), Text: "text"})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.PutDraft(In{SubjectID: uuid.New(), ChatID: uuid.New(), Text: "text"})
+		suite.ErrorIs(err, ErrInvalidSessionID)
+		_, err = usecase.PutDraft(In{SubjectID: uuid.New(), SessionID: uuid.New(), Text: "text"})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.PutDraft(In{SubjectID: uuid.New(), SessionID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, draftt.ErrDraftTextEmpty)
+	})
+
+	suite.Run("чат должен существовать", func() {
+		usecase, _ := newUsecase()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.PutDraft(In{SubjectID: uuid.New(), SessionID: uuid.New(), ChatID: uuid.New(), Text: "text"})
+		suite.ErrorIs(err, chatt.ErrChatNotExists)
+	})
+
+	suite.Run("вести черновик могут только участники чата", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.PutDraft(In{SubjectID: uuid.New(), SessionID: uuid.New(), ChatID: chat.ID, Text: "text"})
+		suite.ErrorIs(err, draftt.ErrUserIsNotMember)
+	})
+
+	suite.Run("черновик сохранится, а событие получат остальные сессии", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		sessionID := uuid.New()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Drafts.EXPECT().Upsert(mock.Anything).Run(func(d draftt.Draft) {
+			suite.Equal(p.UserID, d.UserID)
+			suite.Equal(chat.ID, d.ChatID)
+			suite.Equal("text", d.Text)
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+
+		out, err := usecase.PutDraft(In{SubjectID: p.UserID, SessionID: sessionID, ChatID: chat.ID, Text: "text"})
+		suite.Require().NoError(err)
+		suite.Equal("text", out.Draft.Text)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, draftt.EventDraftUpdated)
+		suite.Require().Len(consumedEvents, 1)
+		suite.Equal([]uuid.UUID{p.UserID}, consumedEvents[0].Recipients)
+		suite.Equal([]uuid.UUID{sessionID}, consumedEvents[0].ExcludedSessions)
+	})
+}
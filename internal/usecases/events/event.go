@@ -8,8 +8,10 @@ import (
 
 // Event описывает событие
 type Event struct {
-	Type       string         // Тип события
-	CreatedIn  time.Time      // Время создания
-	Recipients []uuid.UUID    // Получатели (id пользователей)
-	Data       map[string]any // Полезная нагрузка
+	Type             string         // Тип события
+	CreatedIn        time.Time      // Время создания
+	Recipients       []uuid.UUID    // Получатели (id пользователей)
+	ExcludedSessions []uuid.UUID    // Сессии получателей, которым событие не отправляется
+	Data             map[string]any // Полезная нагрузка
+	Ephemeral        bool           // Событие актуально только в момент создания: не сохраняется и не доставляется повторно
 }
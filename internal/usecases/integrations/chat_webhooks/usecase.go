@@ -0,0 +1,82 @@
+package chatWebhooks
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат запроса входящих вебхуков
+type Out struct {
+	Webhooks []integrationn.Webhook
+}
+
+type ChatWebhooksUsecase struct {
+	Repo      integrationn.Repository
+	ChatsRepo chatt.Repository
+}
+
+// ChatWebhooks возвращает действующие входящие вебхуки чата.
+// Доступно только участникам с правом изменять настройки чата
+func (c *ChatWebhooksUsecase) ChatWebhooks(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить доступ пользователя к этому действию
+	if !chat.Can(in.SubjectID, chatt.PermissionManageChat) {
+		return Out{}, chatt.ErrPermissionDenied
+	}
+
+	// Получить вебхуки чата
+	webhooks, err := c.Repo.List(integrationn.Filter{
+		ChatID:     chat.ID,
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Хэши токенов не нужны клиенту
+	for i := range webhooks {
+		webhooks[i].TokenHash = ""
+	}
+
+	return Out{
+		Webhooks: webhooks,
+	}, nil
+}
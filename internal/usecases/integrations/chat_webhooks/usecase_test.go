@@ -0,0 +1,65 @@
+package chatWebhooks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Integrations_ChatWebhooks тестирует получение входящих вебхуков чата
+func (suite *testSuite) Test_Integrations_ChatWebhooks() {
+	newUsecase := func() *ChatWebhooksUsecase {
+		return &ChatWebhooksUsecase{
+			Repo:      suite.RR.Integrations,
+			ChatsRepo: suite.RR.Chats,
+		}
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase := newUsecase()
+		_, err := usecase.ChatWebhooks(In{ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.ChatWebhooks(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+	})
+
+	suite.Run("просматривать вебхуки могут только участники с соответствующим правом", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		out, err := usecase.ChatWebhooks(In{SubjectID: p.UserID, ChatID: chat.ID})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+		suite.Zero(out)
+	})
+
+	suite.Run("вернутся действующие вебхуки без хэшей токенов", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		webhook, _ := suite.NewIncomingWebhook(chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Integrations.EXPECT().List(integrationn.Filter{
+			ChatID:     chat.ID,
+			ActiveOnly: true,
+		}).Return([]integrationn.Webhook{webhook}, nil).Once()
+		out, err := usecase.ChatWebhooks(In{SubjectID: chat.ChiefID, ChatID: chat.ID})
+		suite.Require().NoError(err)
+		suite.Require().Len(out.Webhooks, 1)
+		suite.Equal(webhook.ID, out.Webhooks[0].ID)
+		suite.Empty(out.Webhooks[0].TokenHash)
+	})
+}
@@ -0,0 +1,89 @@
+package createWebhook
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidName      = errors.New("некорректное значение Name")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	Name      string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := integrationn.ValidateWebhookName(in.Name); err != nil {
+		return errors.Join(err, ErrInvalidName)
+	}
+
+	return nil
+}
+
+// Out результат создания входящего вебхука
+type Out struct {
+	Webhook integrationn.Webhook
+	Token   string // Токен вебхука, возвращается только один раз
+}
+
+type CreateWebhookUsecase struct {
+	Repo      integrationn.Repository
+	ChatsRepo chatt.Repository
+}
+
+// CreateWebhook создает входящий вебхук, через который внешняя система может отправлять сообщения в чат.
+// Доступно только участникам с правом изменять настройки чата
+func (c *CreateWebhookUsecase) CreateWebhook(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить доступ пользователя к этому действию
+	if !chat.Can(in.SubjectID, chatt.PermissionManageChat) {
+		return Out{}, chatt.ErrPermissionDenied
+	}
+
+	// Создать вебхук
+	webhook, token, err := integrationn.NewWebhook(chat.ID, in.SubjectID, in.Name)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить вебхук в репозиторий
+	if err = c.Repo.Upsert(webhook); err != nil {
+		return Out{}, err
+	}
+
+	// Хэш токена не нужен клиенту
+	webhook.TokenHash = ""
+
+	return Out{
+		Webhook: webhook,
+		Token:   token,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package createWebhook
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Integrations_CreateWebhook тестирует создание входящего вебхука
+func (suite *testSuite) Test_Integrations_CreateWebhook() {
+	newUsecase := func() *CreateWebhookUsecase {
+		return &CreateWebhookUsecase{
+			Repo:      suite.RR.Integrations,
+			ChatsRepo: suite.RR.Chats,
+		}
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase := newUsecase()
+		_, err := usecase.CreateWebhook(In{ChatID: uuid.New(), Name: "CI"})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.CreateWebhook(In{SubjectID: uuid.New(), Name: "CI"})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.CreateWebhook(In{SubjectID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidName)
+	})
+
+	suite.Run("создавать вебхуки могут только участники с соответствующим правом", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		out, err := usecase.CreateWebhook(In{SubjectID: p.UserID, ChatID: chat.ID, Name: "CI"})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+		suite.Zero(out)
+	})
+
+	suite.Run("вебхук сохранится с хэшем возвращенного токена", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		var saved integrationn.Webhook
+		suite.RR.Integrations.EXPECT().Upsert(mock.Anything).Run(func(w integrationn.Webhook) {
+			saved = w
+		}).Return(nil).Once()
+		out, err := usecase.CreateWebhook(In{SubjectID: chat.ChiefID, ChatID: chat.ID, Name: "CI"})
+		suite.Require().NoError(err)
+		suite.Equal(chat.ID, saved.ChatID)
+		suite.Equal("CI", saved.Name)
+		suite.Equal(integrationn.HashWebhookToken(out.Token), saved.TokenHash)
+		suite.Equal(saved.ID, out.Webhook.ID)
+		suite.Empty(out.Webhook.TokenHash)
+	})
+}
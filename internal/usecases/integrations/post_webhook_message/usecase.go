@@ -0,0 +1,97 @@
+package postWebhookMessage
+
+import (
+	"errors"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidToken = errors.New("некорректное значение Token")
+)
+
+// In входящие параметры
+type In struct {
+	Token string
+	Text  string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := integrationn.ValidateWebhookToken(in.Token); err != nil {
+		return errors.Join(err, ErrInvalidToken)
+	}
+
+	return nil
+}
+
+// Out результат отправки сообщения
+type Out struct {
+	Message messagee.Message
+}
+
+type PostWebhookMessageUsecase struct {
+	Repo          integrationn.Repository
+	ChatsRepo     chatt.Repository
+	MessagesRepo  messagee.Repository
+	EventConsumer events.Consumer
+}
+
+// PostWebhookMessage отправляет сообщение в чат входящего вебхука от имени интеграции.
+// Аутентификация выполняется только по токену вебхука
+func (c *PostWebhookMessageUsecase) PostWebhookMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти действующий вебхук по токену
+	webhook, err := integrationn.Find(c.Repo, integrationn.Filter{
+		TokenHash:  integrationn.HashWebhookToken(in.Token),
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: webhook.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Создать сообщение
+	message, err := messagee.NewIntegrationMessage(chat, webhook.ID, webhook.Name, in.Text, eventsBuf)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить сообщение в репозиторий
+	if err = c.MessagesRepo.Upsert(message); err != nil {
+		return Out{}, err
+	}
+
+	// Обновить время последней активности чата
+	err = chat.SetLastActiveAt(message.CreatedAt, eventsBuf)
+	switch {
+	case err == nil:
+		if err = c.ChatsRepo.UpdateLastActiveAt(chat.ID, chat.LastActiveAt); err != nil {
+			return Out{}, err
+		}
+	case !errors.Is(err, chatt.ErrNewActiveLessThanActual):
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Message: message,
+	}, nil
+}
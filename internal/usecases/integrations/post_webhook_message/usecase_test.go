@@ -0,0 +1,91 @@
+package postWebhookMessage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Integrations_PostWebhookMessage тестирует отправку сообщения через входящий вебхук
+func (suite *testSuite) Test_Integrations_PostWebhookMessage() {
+	newUsecase := func() (*PostWebhookMessageUsecase, *mockEvents.Consumer) {
+		mockEventConsumer := mockEvents.NewConsumer(suite.T())
+		return &PostWebhookMessageUsecase{
+			Repo:          suite.RR.Integrations,
+			ChatsRepo:     suite.RR.Chats,
+			MessagesRepo:  suite.RR.Messages,
+			EventConsumer: mockEventConsumer,
+		}, mockEventConsumer
+	}
+
+	suite.Run("токен должен быть валидным", func() {
+		usecase, _ := newUsecase()
+		out, err := usecase.PostWebhookMessage(In{Token: "bot_123", Text: "text"})
+		suite.ErrorIs(err, ErrInvalidToken)
+		suite.Zero(out)
+	})
+
+	suite.Run("по отозванному или неизвестному токену отправить нельзя", func() {
+		usecase, _ := newUsecase()
+		_, token := suite.NewIncomingWebhook(suite.RndChat())
+		suite.RR.Integrations.EXPECT().List(integrationn.Filter{
+			TokenHash:  integrationn.HashWebhookToken(token),
+			ActiveOnly: true,
+		}).Return(nil, nil).Once()
+		out, err := usecase.PostWebhookMessage(In{Token: token, Text: "text"})
+		suite.ErrorIs(err, integrationn.ErrWebhookNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("сообщение создается от имени интеграции и публикуются события", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		webhook, token := suite.NewIncomingWebhook(chat)
+		suite.RR.Integrations.EXPECT().List(mock.Anything).Return([]integrationn.Webhook{webhook}, nil).Once()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		var saved messagee.Message
+		suite.RR.Messages.EXPECT().Upsert(mock.Anything).Run(func(m messagee.Message) {
+			saved = m
+		}).Return(nil).Once()
+		suite.RR.Chats.EXPECT().UpdateLastActiveAt(mock.Anything, mock.Anything).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(ee []events.Event) {
+			consumedEvents = append(consumedEvents, ee...)
+		}).Return().Once()
+
+		out, err := usecase.PostWebhookMessage(In{Token: token, Text: "deploy finished"})
+		suite.Require().NoError(err)
+		suite.Equal(saved, out.Message)
+		suite.Equal(chat.ID, saved.ChatID)
+		suite.Equal(webhook.ID, saved.AuthorID)
+		suite.Equal(webhook.Name, saved.IntegrationName)
+		suite.Equal("deploy finished", saved.Text)
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessageCreated)
+	})
+
+	suite.Run("пустой текст отклоняется", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		webhook, token := suite.NewIncomingWebhook(chat)
+		suite.RR.Integrations.EXPECT().List(mock.Anything).Return([]integrationn.Webhook{webhook}, nil).Once()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.PostWebhookMessage(In{Token: token, Text: " "})
+		suite.ErrorIs(err, messagee.ErrTextEmpty)
+	})
+}
@@ -0,0 +1,88 @@
+package revokeWebhook
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidWebhookID = errors.New("некорректное значение WebhookID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	WebhookID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.WebhookID); err != nil {
+		return errors.Join(err, ErrInvalidWebhookID)
+	}
+
+	return nil
+}
+
+// Out результат отзыва вебхука
+type Out struct{}
+
+type RevokeWebhookUsecase struct {
+	Repo      integrationn.Repository
+	ChatsRepo chatt.Repository
+}
+
+// RevokeWebhook отзывает входящий вебхук, после чего отправлять через него сообщения нельзя.
+// Доступно только участникам с правом изменять настройки чата
+func (c *RevokeWebhookUsecase) RevokeWebhook(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить доступ пользователя к этому действию
+	if !chat.Can(in.SubjectID, chatt.PermissionManageChat) {
+		return Out{}, chatt.ErrPermissionDenied
+	}
+
+	// Найти вебхук
+	webhook, err := integrationn.Find(c.Repo, integrationn.Filter{ID: in.WebhookID})
+	if err != nil {
+		return Out{}, err
+	}
+	if webhook.ChatID != chat.ID {
+		return Out{}, integrationn.ErrWebhookInAnotherChat
+	}
+
+	// Отозвать вебхук
+	if err = webhook.Revoke(); err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить вебхук в репозиторий
+	if err = c.Repo.Upsert(webhook); err != nil {
+		return Out{}, err
+	}
+
+	return Out{}, nil
+}
@@ -0,0 +1,74 @@
+package revokeWebhook
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Integrations_RevokeWebhook тестирует отзыв входящего вебхука
+func (suite *testSuite) Test_Integrations_RevokeWebhook() {
+	newUsecase := func() *RevokeWebhookUsecase {
+		return &RevokeWebhookUsecase{
+			Repo:      suite.RR.Integrations,
+			ChatsRepo: suite.RR.Chats,
+		}
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase := newUsecase()
+		_, err := usecase.RevokeWebhook(In{ChatID: uuid.New(), WebhookID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.RevokeWebhook(In{SubjectID: uuid.New(), WebhookID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.RevokeWebhook(In{SubjectID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidWebhookID)
+	})
+
+	suite.Run("отозвать вебхук могут только участники с соответствующим правом", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.RevokeWebhook(In{SubjectID: p.UserID, ChatID: chat.ID, WebhookID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+	})
+
+	suite.Run("вебхук другого чата отозвать нельзя", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		webhook, _ := suite.NewIncomingWebhook(suite.RndChat())
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Integrations.EXPECT().List(mock.Anything).Return([]integrationn.Webhook{webhook}, nil).Once()
+		_, err := usecase.RevokeWebhook(In{SubjectID: chat.ChiefID, ChatID: chat.ID, WebhookID: webhook.ID})
+		suite.ErrorIs(err, integrationn.ErrWebhookInAnotherChat)
+	})
+
+	suite.Run("отозванный вебхук сохранится без токена", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		webhook, _ := suite.NewIncomingWebhook(chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Integrations.EXPECT().List(integrationn.Filter{ID: webhook.ID}).Return([]integrationn.Webhook{webhook}, nil).Once()
+		suite.RR.Integrations.EXPECT().Upsert(mock.Anything).Run(func(w integrationn.Webhook) {
+			suite.True(w.IsRevoked())
+			suite.Empty(w.TokenHash)
+		}).Return(nil).Once()
+		_, err := usecase.RevokeWebhook(In{SubjectID: chat.ChiefID, ChatID: chat.ID, WebhookID: webhook.ID})
+		suite.NoError(err)
+	})
+}
@@ -0,0 +1,76 @@
+package rotateWebhookToken
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Integrations_RotateWebhookToken тестирует выпуск нового токена входящего вебхука
+func (suite *testSuite) Test_Integrations_RotateWebhookToken() {
+	newUsecase := func() *RotateWebhookTokenUsecase {
+		return &RotateWebhookTokenUsecase{
+			Repo:      suite.RR.Integrations,
+			ChatsRepo: suite.RR.Chats,
+		}
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase := newUsecase()
+		_, err := usecase.RotateWebhookToken(In{ChatID: uuid.New(), WebhookID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.RotateWebhookToken(In{SubjectID: uuid.New(), WebhookID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.RotateWebhookToken(In{SubjectID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidWebhookID)
+	})
+
+	suite.Run("выпустить токен могут только участники с соответствующим правом", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.RotateWebhookToken(In{SubjectID: p.UserID, ChatID: chat.ID, WebhookID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+	})
+
+	suite.Run("вебхук другого чата изменить нельзя", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		webhook, _ := suite.NewIncomingWebhook(suite.RndChat())
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Integrations.EXPECT().List(mock.Anything).Return([]integrationn.Webhook{webhook}, nil).Once()
+		_, err := usecase.RotateWebhookToken(In{SubjectID: chat.ChiefID, ChatID: chat.ID, WebhookID: webhook.ID})
+		suite.ErrorIs(err, integrationn.ErrWebhookInAnotherChat)
+	})
+
+	suite.Run("новый токен сохранится в виде хэша", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		webhook, token := suite.NewIncomingWebhook(chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.Integrations.EXPECT().List(integrationn.Filter{ID: webhook.ID}).Return([]integrationn.Webhook{webhook}, nil).Once()
+		var saved integrationn.Webhook
+		suite.RR.Integrations.EXPECT().Upsert(mock.Anything).Run(func(w integrationn.Webhook) {
+			saved = w
+		}).Return(nil).Once()
+		out, err := usecase.RotateWebhookToken(In{SubjectID: chat.ChiefID, ChatID: chat.ID, WebhookID: webhook.ID})
+		suite.Require().NoError(err)
+		suite.NotEqual(token, out.Token)
+		suite.Equal(integrationn.HashWebhookToken(out.Token), saved.TokenHash)
+	})
+}
@@ -0,0 +1,124 @@
+package approveJoinRequest
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidLinkID    = errors.New("некорректное значение LinkID")
+	ErrInvalidUserID    = errors.New("некорректное значение UserID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	LinkID    uuid.UUID
+	UserID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.LinkID); err != nil {
+		return errors.Join(err, ErrInvalidLinkID)
+	}
+	if err := domain.ValidateID(in.UserID); err != nil {
+		return errors.Join(err, ErrInvalidUserID)
+	}
+
+	return nil
+}
+
+// Out результат одобрения заявки
+type Out struct {
+	Chat chatt.Chat
+}
+
+type ApproveJoinRequestUsecase struct {
+	Repo          invitelinkk.Repository
+	EventConsumer events.Consumer
+}
+
+// ApproveJoinRequest одобряет заявку на вступление по ссылке и добавляет пользователя в чат.
+// Доступно только участникам с правом управлять приглашениями.
+// Ссылка и чат блокируются и сохраняются в одной транзакции
+func (c *ApproveJoinRequestUsecase) ApproveJoinRequest(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	var chat chatt.Chat
+	err := c.Repo.InTransaction(func(txRepo invitelinkk.Repository) error {
+		// Найти ссылку. Ссылка блокируется раньше чата, как и при вступлении по ней,
+		// чтобы параллельные транзакции не ждали друг друга
+		link, err := invitelinkk.Find(txRepo, invitelinkk.Filter{ID: in.LinkID})
+		if err != nil {
+			return err
+		}
+
+		// Найти чат
+		chat, err = chatt.Find(txRepo.ChatsRepo(), chatt.Filter{ID: in.ChatID})
+		if err != nil {
+			return err
+		}
+
+		// Проверить доступ пользователя к этому действию
+		if !chat.Can(in.SubjectID, chatt.PermissionManageInvitations) {
+			return chatt.ErrPermissionDenied
+		}
+		if link.ChatID != chat.ID {
+			return invitelinkk.ErrLinkInAnotherChat
+		}
+
+		// Одобрить заявку
+		if _, err = link.Approve(in.SubjectID, in.UserID); err != nil {
+			return err
+		}
+
+		// Добавить пользователя в чат
+		participant, err := chatt.NewParticipant(in.UserID)
+		if err != nil {
+			return err
+		}
+		if err = chat.AddParticipant(participant, eventsBuf); err != nil {
+			return err
+		}
+
+		// Сохранить ссылку в репозиторий
+		if err = txRepo.Upsert(link); err != nil {
+			return err
+		}
+
+		// Сохранить чат в репозиторий
+		return txRepo.ChatsRepo().Upsert(chat)
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Chat: chat,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package approveJoinRequest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_InviteLinks_ApproveJoinRequest тестирует одобрение заявки на вступление
+func (suite *testSuite) Test_InviteLinks_ApproveJoinRequest() {
+	newUsecase := func() (*ApproveJoinRequestUsecase, *mockEvents.Consumer) {
+		uc := &ApproveJoinRequestUsecase{
+			Repo:          suite.RR.InviteLinks,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		suite.SetupInviteLinksTransaction()
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.ApproveJoinRequest(In{ChatID: uuid.New(), LinkID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.ApproveJoinRequest(In{SubjectID: uuid.New(), LinkID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.ApproveJoinRequest(In{SubjectID: uuid.New(), ChatID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidLinkID)
+		_, err = usecase.ApproveJoinRequest(In{SubjectID: uuid.New(), ChatID: uuid.New(), LinkID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidUserID)
+	})
+
+	suite.Run("одобрить заявку могут только участники с соответствующим правом", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		link, _ := suite.NewInviteLink(chat, true)
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.ApproveJoinRequest(In{SubjectID: p.UserID, ChatID: chat.ID, LinkID: link.ID, UserID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+	})
+
+	suite.Run("заявка должна существовать", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		link, _ := suite.NewInviteLink(chat, true)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().List(invitelinkk.Filter{ID: link.ID}).Return([]invitelinkk.Link{link}, nil).Once()
+		_, err := usecase.ApproveJoinRequest(In{SubjectID: chat.ChiefID, ChatID: chat.ID, LinkID: link.ID, UserID: uuid.New()})
+		suite.ErrorIs(err, invitelinkk.ErrJoinRequestNotExists)
+	})
+
+	suite.Run("после одобрения пользователь становится участником", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		link, _ := suite.NewInviteLink(chat, true)
+		userID := uuid.New()
+		_, err := link.Use(chat, userID, time.Now(), nil)
+		suite.Require().NoError(err)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().Upsert(mock.Anything).Run(func(l invitelinkk.Link) {
+			suite.Require().Len(l.Uses, 1)
+			suite.Equal(invitelinkk.UseStatusJoined, l.Uses[0].Status)
+			suite.Equal(chat.ChiefID, l.Uses[0].ReviewedBy)
+		}).Return(nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.True(c.HasParticipant(userID))
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		out, err := usecase.ApproveJoinRequest(In{SubjectID: chat.ChiefID, ChatID: chat.ID, LinkID: link.ID, UserID: userID})
+		suite.Require().NoError(err)
+		suite.True(out.Chat.HasParticipant(userID))
+		suite.AssertHasEventType(consumedEvents, chatt.EventParticipantAdded)
+	})
+
+	suite.Run("если чат не сохранился, ошибка вернется и события не отправятся", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		link, _ := suite.NewInviteLink(chat, true)
+		userID := uuid.New()
+		_, err := link.Use(chat, userID, time.Now(), nil)
+		suite.Require().NoError(err)
+		errUpsert := errors.New("upsert")
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Return(errUpsert).Once()
+		_, err = usecase.ApproveJoinRequest(In{SubjectID: chat.ChiefID, ChatID: chat.ID, LinkID: link.ID, UserID: userID})
+		suite.ErrorIs(err, errUpsert)
+	})
+}
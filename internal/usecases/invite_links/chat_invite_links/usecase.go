@@ -0,0 +1,82 @@
+package chatInviteLinks
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат запроса пригласительных ссылок
+type Out struct {
+	Links []invitelinkk.Link
+}
+
+type ChatInviteLinksUsecase struct {
+	Repo      invitelinkk.Repository
+	ChatsRepo chatt.Repository
+}
+
+// ChatInviteLinks возвращает пригласительные ссылки чата вместе с историей их использования.
+// Отозванные ссылки тоже возвращаются, чтобы по ним можно было рассмотреть заявки.
+// Доступно только участникам с правом управлять приглашениями
+func (c *ChatInviteLinksUsecase) ChatInviteLinks(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить доступ пользователя к этому действию
+	if !chat.Can(in.SubjectID, chatt.PermissionManageInvitations) {
+		return Out{}, chatt.ErrPermissionDenied
+	}
+
+	// Получить ссылки чата
+	links, err := c.Repo.List(invitelinkk.Filter{
+		ChatID: chat.ID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Хэши токенов не нужны клиенту
+	for i := range links {
+		links[i].TokenHash = ""
+	}
+
+	return Out{
+		Links: links,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package chatInviteLinks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_InviteLinks_ChatInviteLinks тестирует получение пригласительных ссылок чата
+func (suite *testSuite) Test_InviteLinks_ChatInviteLinks() {
+	newUsecase := func() *ChatInviteLinksUsecase {
+		return &ChatInviteLinksUsecase{
+			Repo:      suite.RR.InviteLinks,
+			ChatsRepo: suite.RR.Chats,
+		}
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase := newUsecase()
+		_, err := usecase.ChatInviteLinks(In{ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.ChatInviteLinks(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+	})
+
+	suite.Run("получить ссылки могут только участники с соответствующим правом", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.ChatInviteLinks(In{SubjectID: p.UserID, ChatID: chat.ID})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+	})
+
+	suite.Run("вернутся ссылки чата без хэшей токенов", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		link1, _ := suite.NewInviteLink(chat, false)
+		link2, _ := suite.NewInviteLink(chat, true)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().List(invitelinkk.Filter{ChatID: chat.ID}).
+			Return([]invitelinkk.Link{link1, link2}, nil).Once()
+		out, err := usecase.ChatInviteLinks(In{SubjectID: chat.ChiefID, ChatID: chat.ID})
+		suite.Require().NoError(err)
+		suite.Require().Len(out.Links, 2)
+		for _, l := range out.Links {
+			suite.Empty(l.TokenHash)
+		}
+	})
+}
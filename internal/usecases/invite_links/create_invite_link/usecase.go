@@ -0,0 +1,92 @@
+package createInviteLink
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMaxUses   = errors.New("некорректное значение MaxUses")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID        uuid.UUID
+	ChatID           uuid.UUID
+	ExpiresAt        time.Time // Нулевое значение - ссылка бессрочная
+	MaxUses          int       // 0 - без ограничений
+	RequiresApproval bool
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if in.MaxUses < 0 {
+		return ErrInvalidMaxUses
+	}
+
+	return nil
+}
+
+// Out результат создания пригласительной ссылки
+type Out struct {
+	Link  invitelinkk.Link
+	Token string // Токен ссылки, возвращается только один раз
+}
+
+type CreateInviteLinkUsecase struct {
+	Repo      invitelinkk.Repository
+	ChatsRepo chatt.Repository
+}
+
+// CreateInviteLink создает пригласительную ссылку, по которой любой пользователь может вступить в чат.
+// Доступно только участникам с правом управлять приглашениями
+func (c *CreateInviteLinkUsecase) CreateInviteLink(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить доступ пользователя к этому действию
+	if !chat.Can(in.SubjectID, chatt.PermissionManageInvitations) {
+		return Out{}, chatt.ErrPermissionDenied
+	}
+
+	// Создать ссылку
+	link, token, err := invitelinkk.NewLink(chat.ID, in.SubjectID, in.ExpiresAt, in.MaxUses, in.RequiresApproval)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить ссылку в репозиторий
+	if err = c.Repo.Upsert(link); err != nil {
+		return Out{}, err
+	}
+
+	// Хэш токена не нужен клиенту
+	link.TokenHash = ""
+
+	return Out{
+		Link:  link,
+		Token: token,
+	}, nil
+}
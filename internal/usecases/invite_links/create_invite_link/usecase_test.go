@@ -0,0 +1,76 @@
+package createInviteLink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_InviteLinks_CreateInviteLink тестирует создание пригласительной ссылки
+func (suite *testSuite) Test_InviteLinks_CreateInviteLink() {
+	newUsecase := func() *CreateInviteLinkUsecase {
+		return &CreateInviteLinkUsecase{
+			Repo:      suite.RR.InviteLinks,
+			ChatsRepo: suite.RR.Chats,
+		}
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase := newUsecase()
+		_, err := usecase.CreateInviteLink(In{ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.CreateInviteLink(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.CreateInviteLink(In{SubjectID: uuid.New(), ChatID: uuid.New(), MaxUses: -1})
+		suite.ErrorIs(err, ErrInvalidMaxUses)
+	})
+
+	suite.Run("создать ссылку могут только участники с соответствующим правом", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.CreateInviteLink(In{SubjectID: p.UserID, ChatID: chat.ID})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+	})
+
+	suite.Run("созданная ссылка сохранится, а токен вернется клиенту", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		var saved invitelinkk.Link
+		suite.RR.InviteLinks.EXPECT().Upsert(mock.Anything).Run(func(l invitelinkk.Link) {
+			saved = l
+		}).Return(nil).Once()
+		out, err := usecase.CreateInviteLink(In{
+			SubjectID:        chat.ChiefID,
+			ChatID:           chat.ID,
+			ExpiresAt:        expiresAt,
+			MaxUses:          5,
+			RequiresApproval: true,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(invitelinkk.HashLinkToken(out.Token), saved.TokenHash)
+		suite.Empty(out.Link.TokenHash)
+		suite.Equal(chat.ID, out.Link.ChatID)
+		suite.Equal(expiresAt, out.Link.ExpiresAt)
+		suite.Equal(5, out.Link.MaxUses)
+		suite.True(out.Link.RequiresApproval)
+	})
+}
@@ -0,0 +1,119 @@
+package joinByLink
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidToken     = errors.New("некорректное значение Token")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	Token     string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := invitelinkk.ValidateLinkToken(in.Token); err != nil {
+		return errors.Join(err, ErrInvalidToken)
+	}
+
+	return nil
+}
+
+// Out результат вступления по ссылке
+type Out struct {
+	Use  invitelinkk.Use
+	Chat chatt.Chat // Чат, если пользователь вступил в него сразу
+}
+
+type JoinByLinkUsecase struct {
+	Repo          invitelinkk.Repository
+	EventConsumer events.Consumer
+}
+
+// JoinByLink добавляет пользователя в чат по пригласительной ссылке.
+// Если ссылка требует одобрения, вместо вступления создается заявка для администраторов чата.
+// Ссылка и чат блокируются и сохраняются в одной транзакции, поэтому параллельные
+// вступления не превысят лимит использований и не затрут друг друга
+func (c *JoinByLinkUsecase) JoinByLink(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	var out Out
+	err := c.Repo.InTransaction(func(txRepo invitelinkk.Repository) error {
+		// Найти действующую ссылку по токену
+		link, err := invitelinkk.Find(txRepo, invitelinkk.Filter{
+			TokenHash:  invitelinkk.HashLinkToken(in.Token),
+			ActiveOnly: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Найти чат
+		chat, err := chatt.Find(txRepo.ChatsRepo(), chatt.Filter{ID: link.ChatID})
+		if err != nil {
+			return err
+		}
+
+		// Зарегистрировать использование ссылки
+		use, err := link.Use(chat, in.SubjectID, time.Now(), eventsBuf)
+		if err != nil {
+			return err
+		}
+		out.Use = use
+
+		// Сохранить ссылку в репозиторий
+		if err = txRepo.Upsert(link); err != nil {
+			return err
+		}
+
+		// Добавить пользователя в чат, если одобрение не требуется
+		if use.Status != invitelinkk.UseStatusJoined {
+			return nil
+		}
+		participant, err := chatt.NewParticipant(in.SubjectID)
+		if err != nil {
+			return err
+		}
+		if err = chat.AddParticipant(participant, eventsBuf); err != nil {
+			return err
+		}
+
+		// Сохранить чат в репозиторий
+		if err = txRepo.ChatsRepo().Upsert(chat); err != nil {
+			return err
+		}
+		out.Chat = chat
+
+		return nil
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return out, nil
+}
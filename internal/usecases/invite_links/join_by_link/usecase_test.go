@@ -0,0 +1,142 @@
+package joinByLink
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_InviteLinks_JoinByLink тестирует вступление в чат по пригласительной ссылке
+func (suite *testSuite) Test_InviteLinks_JoinByLink() {
+	newUsecase := func() (*JoinByLinkUsecase, *mockEvents.Consumer) {
+		uc := &JoinByLinkUsecase{
+			Repo:          suite.RR.InviteLinks,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		suite.SetupInviteLinksTransaction()
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, token := suite.NewInviteLink(suite.RndChat(), false)
+		_, err := usecase.JoinByLink(In{Token: token})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.JoinByLink(In{SubjectID: uuid.New(), Token: "inv_123"})
+		suite.ErrorIs(err, ErrInvalidToken)
+	})
+
+	suite.Run("ссылка должна быть действующей", func() {
+		usecase, _ := newUsecase()
+		_, token := suite.NewInviteLink(suite.RndChat(), false)
+		suite.RR.InviteLinks.EXPECT().List(invitelinkk.Filter{
+			TokenHash:  invitelinkk.HashLinkToken(token),
+			ActiveOnly: true,
+		}).Return(nil, nil).Once()
+		_, err := usecase.JoinByLink(In{SubjectID: uuid.New(), Token: token})
+		suite.ErrorIs(err, invitelinkk.ErrLinkNotExists)
+	})
+
+	suite.Run("участник чата не может вступить повторно", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		link, token := suite.NewInviteLink(chat, false)
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.Chats.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.JoinByLink(In{SubjectID: p.UserID, Token: token})
+		suite.ErrorIs(err, chatt.ErrParticipantExists)
+	})
+
+	suite.Run("без одобрения пользователь сразу становится участником", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		link, token := suite.NewInviteLink(chat, false)
+		userID := uuid.New()
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().Upsert(mock.Anything).Run(func(l invitelinkk.Link) {
+			suite.Require().Len(l.Uses, 1)
+			suite.Equal(userID, l.Uses[0].UserID)
+			suite.Equal(invitelinkk.UseStatusJoined, l.Uses[0].Status)
+		}).Return(nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(c chatt.Chat) {
+			suite.True(c.HasParticipant(userID))
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		out, err := usecase.JoinByLink(In{SubjectID: userID, Token: token})
+		suite.Require().NoError(err)
+		suite.Equal(invitelinkk.UseStatusJoined, out.Use.Status)
+		suite.Equal(chat.ID, out.Chat.ID)
+		suite.AssertHasEventType(consumedEvents, chatt.EventParticipantAdded)
+	})
+
+	suite.Run("с одобрением создается заявка, а чат не изменяется", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		link, token := suite.NewInviteLink(chat, true)
+		userID := uuid.New()
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().Upsert(mock.Anything).Run(func(l invitelinkk.Link) {
+			suite.Len(l.PendingUses(), 1)
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		out, err := usecase.JoinByLink(In{SubjectID: userID, Token: token})
+		suite.Require().NoError(err)
+		suite.Equal(invitelinkk.UseStatusPending, out.Use.Status)
+		suite.Zero(out.Chat)
+		suite.AssertHasEventType(consumedEvents, invitelinkk.EventJoinRequested)
+	})
+
+	suite.Run("ссылка и чат сохраняются в одной транзакции", func() {
+		uc := &JoinByLinkUsecase{
+			Repo:          suite.RR.InviteLinks,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		var inTx bool
+		suite.RR.InviteLinks.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(invitelinkk.Repository) error) error {
+			inTx = true
+			defer func() { inTx = false }()
+			return fn(suite.RR.InviteLinks)
+		}).Once()
+		suite.RR.InviteLinks.EXPECT().ChatsRepo().Return(suite.RR.Chats)
+		chat := suite.RndChat()
+		link, token := suite.NewInviteLink(chat, false)
+		errUpsert := errors.New("upsert")
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().Upsert(mock.Anything).Run(func(invitelinkk.Link) {
+			suite.True(inTx)
+		}).Return(nil).Once()
+		suite.RR.Chats.EXPECT().Upsert(mock.Anything).Run(func(chatt.Chat) {
+			suite.True(inTx)
+		}).Return(errUpsert).Once()
+		// При ошибке сохранения чата транзакция откатится вместе с использованием ссылки
+		_, err := uc.JoinByLink(In{SubjectID: uuid.New(), Token: token})
+		suite.ErrorIs(err, errUpsert)
+	})
+}
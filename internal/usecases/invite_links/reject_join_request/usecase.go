@@ -0,0 +1,105 @@
+package rejectJoinRequest
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidLinkID    = errors.New("некорректное значение LinkID")
+	ErrInvalidUserID    = errors.New("некорректное значение UserID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	LinkID    uuid.UUID
+	UserID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.LinkID); err != nil {
+		return errors.Join(err, ErrInvalidLinkID)
+	}
+	if err := domain.ValidateID(in.UserID); err != nil {
+		return errors.Join(err, ErrInvalidUserID)
+	}
+
+	return nil
+}
+
+// Out результат отклонения заявки
+type Out struct{}
+
+type RejectJoinRequestUsecase struct {
+	Repo          invitelinkk.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// RejectJoinRequest отклоняет заявку на вступление по ссылке.
+// Доступно только участникам с правом управлять приглашениями
+func (c *RejectJoinRequestUsecase) RejectJoinRequest(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить доступ пользователя к этому действию
+	if !chat.Can(in.SubjectID, chatt.PermissionManageInvitations) {
+		return Out{}, chatt.ErrPermissionDenied
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Ссылка блокируется до сохранения, чтобы не затереть параллельные использования
+	err = c.Repo.InTransaction(func(txRepo invitelinkk.Repository) error {
+		// Найти ссылку
+		link, err := invitelinkk.Find(txRepo, invitelinkk.Filter{ID: in.LinkID})
+		if err != nil {
+			return err
+		}
+		if link.ChatID != chat.ID {
+			return invitelinkk.ErrLinkInAnotherChat
+		}
+
+		// Отклонить заявку
+		if _, err = link.Reject(in.SubjectID, in.UserID, eventsBuf); err != nil {
+			return err
+		}
+
+		// Сохранить ссылку в репозиторий
+		return txRepo.Upsert(link)
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{}, nil
+}
@@ -0,0 +1,90 @@
+package rejectJoinRequest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_InviteLinks_RejectJoinRequest тестирует отклонение заявки на вступление
+func (suite *testSuite) Test_InviteLinks_RejectJoinRequest() {
+	newUsecase := func() (*RejectJoinRequestUsecase, *mockEvents.Consumer) {
+		uc := &RejectJoinRequestUsecase{
+			Repo:          suite.RR.InviteLinks,
+			ChatsRepo:     suite.RR.Chats,
+			EventConsumer: mockEvents.NewConsumer(suite.T()),
+		}
+		suite.SetupInviteLinksTransaction()
+		return uc, uc.EventConsumer.(*mockEvents.Consumer)
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase, _ := newUsecase()
+		_, err := usecase.RejectJoinRequest(In{ChatID: uuid.New(), LinkID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.RejectJoinRequest(In{SubjectID: uuid.New(), LinkID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.RejectJoinRequest(In{SubjectID: uuid.New(), ChatID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidLinkID)
+		_, err = usecase.RejectJoinRequest(In{SubjectID: uuid.New(), ChatID: uuid.New(), LinkID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidUserID)
+	})
+
+	suite.Run("отклонить заявку могут только участники с соответствующим правом", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.RejectJoinRequest(In{SubjectID: p.UserID, ChatID: chat.ID, LinkID: uuid.New(), UserID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+	})
+
+	suite.Run("ссылка должна относиться к чату", func() {
+		usecase, _ := newUsecase()
+		chat := suite.RndChat()
+		link, _ := suite.NewInviteLink(suite.RndChat(), true)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		_, err := usecase.RejectJoinRequest(In{SubjectID: chat.ChiefID, ChatID: chat.ID, LinkID: link.ID, UserID: uuid.New()})
+		suite.ErrorIs(err, invitelinkk.ErrLinkInAnotherChat)
+	})
+
+	suite.Run("после отклонения пользователь получит событие", func() {
+		usecase, mockEventConsumer := newUsecase()
+		chat := suite.RndChat()
+		link, _ := suite.NewInviteLink(chat, true)
+		userID := uuid.New()
+		_, err := link.Use(chat, userID, time.Now(), nil)
+		suite.Require().NoError(err)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().Upsert(mock.Anything).Run(func(l invitelinkk.Link) {
+			suite.Require().Len(l.Uses, 1)
+			suite.Equal(invitelinkk.UseStatusRejected, l.Uses[0].Status)
+		}).Return(nil).Once()
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		_, err = usecase.RejectJoinRequest(In{SubjectID: chat.ChiefID, ChatID: chat.ID, LinkID: link.ID, UserID: userID})
+		suite.Require().NoError(err)
+		suite.AssertHasEventType(consumedEvents, invitelinkk.EventJoinRequestRejected)
+	})
+}
@@ -0,0 +1,92 @@
+package revokeInviteLink
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidLinkID    = errors.New("некорректное значение LinkID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	LinkID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.LinkID); err != nil {
+		return errors.Join(err, ErrInvalidLinkID)
+	}
+
+	return nil
+}
+
+// Out результат отзыва пригласительной ссылки
+type Out struct{}
+
+type RevokeInviteLinkUsecase struct {
+	Repo      invitelinkk.Repository
+	ChatsRepo chatt.Repository
+}
+
+// RevokeInviteLink отзывает пригласительную ссылку, после чего вступить по ней нельзя.
+// Доступно только участникам с правом управлять приглашениями
+func (c *RevokeInviteLinkUsecase) RevokeInviteLink(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить доступ пользователя к этому действию
+	if !chat.Can(in.SubjectID, chatt.PermissionManageInvitations) {
+		return Out{}, chatt.ErrPermissionDenied
+	}
+
+	// Ссылка блокируется до сохранения, чтобы не затереть параллельные использования
+	err = c.Repo.InTransaction(func(txRepo invitelinkk.Repository) error {
+		// Найти ссылку
+		link, err := invitelinkk.Find(txRepo, invitelinkk.Filter{ID: in.LinkID})
+		if err != nil {
+			return err
+		}
+		if link.ChatID != chat.ID {
+			return invitelinkk.ErrLinkInAnotherChat
+		}
+
+		// Отозвать ссылку
+		if err = link.Revoke(); err != nil {
+			return err
+		}
+
+		// Сохранить ссылку в репозиторий
+		return txRepo.Upsert(link)
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{}, nil
+}
@@ -0,0 +1,75 @@
+package revokeInviteLink
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_InviteLinks_RevokeInviteLink тестирует отзыв пригласительной ссылки
+func (suite *testSuite) Test_InviteLinks_RevokeInviteLink() {
+	newUsecase := func() *RevokeInviteLinkUsecase {
+		suite.SetupInviteLinksTransaction()
+		return &RevokeInviteLinkUsecase{
+			Repo:      suite.RR.InviteLinks,
+			ChatsRepo: suite.RR.Chats,
+		}
+	}
+
+	suite.Run("есть валидация параметров", func() {
+		usecase := newUsecase()
+		_, err := usecase.RevokeInviteLink(In{ChatID: uuid.New(), LinkID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.RevokeInviteLink(In{SubjectID: uuid.New(), LinkID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidChatID)
+		_, err = usecase.RevokeInviteLink(In{SubjectID: uuid.New(), ChatID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidLinkID)
+	})
+
+	suite.Run("отозвать ссылку могут только участники с соответствующим правом", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		_, err := usecase.RevokeInviteLink(In{SubjectID: p.UserID, ChatID: chat.ID, LinkID: uuid.New()})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+	})
+
+	suite.Run("ссылку другого чата отозвать нельзя", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		link, _ := suite.NewInviteLink(suite.RndChat(), false)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().List(mock.Anything).Return([]invitelinkk.Link{link}, nil).Once()
+		_, err := usecase.RevokeInviteLink(In{SubjectID: chat.ChiefID, ChatID: chat.ID, LinkID: link.ID})
+		suite.ErrorIs(err, invitelinkk.ErrLinkInAnotherChat)
+	})
+
+	suite.Run("отозванная ссылка сохранится без токена", func() {
+		usecase := newUsecase()
+		chat := suite.RndChat()
+		link, _ := suite.NewInviteLink(chat, false)
+		suite.RR.Chats.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().List(invitelinkk.Filter{ID: link.ID}).Return([]invitelinkk.Link{link}, nil).Once()
+		suite.RR.InviteLinks.EXPECT().Upsert(mock.Anything).Run(func(l invitelinkk.Link) {
+			suite.True(l.IsRevoked())
+			suite.Empty(l.TokenHash)
+		}).Return(nil).Once()
+		_, err := usecase.RevokeInviteLink(In{SubjectID: chat.ChiefID, ChatID: chat.ID, LinkID: link.ID})
+		suite.NoError(err)
+	})
+}
@@ -0,0 +1,122 @@
+package addReaction
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_AddReaction тестирует добавление реакции
+func (suite *testSuite) Test_Messages_AddReaction() {
+	suite.Run("эмодзи должен быть валидным", func() {
+		usecase, _, _, _ := newUsecase(suite)
+		out, err := usecase.AddReaction(In{
+			SubjectID: uuid.New(),
+			ChatID:    uuid.New(),
+			MessageID: uuid.New(),
+			Emoji:     "like",
+		})
+		suite.ErrorIs(err, ErrInvalidEmoji)
+		suite.Zero(out)
+	})
+
+	suite.Run("ставить реакции могут только участники чата", func() {
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+
+		out, err := usecase.AddReaction(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Emoji:     "👍",
+		})
+		suite.ErrorIs(err, messagee.ErrUserIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("нельзя поставить одну реакцию дважды", func() {
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		reaction, err := messagee.NewReaction(chat.ChiefID, "👍")
+		suite.Require().NoError(err)
+		suite.Require().NoError(message.AddReaction(chat, reaction, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+
+		out, err := usecase.AddReaction(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Emoji:     "👍",
+		})
+		suite.ErrorIs(err, messagee.ErrReactionExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("реакция сохранится и попадет в сводку", func() {
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ID:     message.ID,
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{message}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(updated messagee.Message) {
+			suite.True(updated.HasReaction(p.UserID, "👍"))
+		}).Return(nil).Once()
+
+		out, err := usecase.AddReaction(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Emoji:     "👍",
+		})
+		suite.Require().NoError(err)
+		suite.Equal([]messagee.ReactionSummary{
+			{Emoji: "👍", Count: 1, Reacted: true},
+		}, out.Reactions)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventReactionAdded)
+	})
+}
+
+func newUsecase(suite *testSuite) (*AddReactionUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &AddReactionUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
@@ -0,0 +1,71 @@
+package cancelScheduledMessage
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+)
+
+var (
+	ErrInvalidSubjectID          = errors.New("некорректное значение SubjectID")
+	ErrInvalidScheduledMessageID = errors.New("некорректное значение ScheduledMessageID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID          uuid.UUID
+	ScheduledMessageID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ScheduledMessageID); err != nil {
+		return errors.Join(err, ErrInvalidScheduledMessageID)
+	}
+
+	return nil
+}
+
+// Out результат отмены отложенного сообщения
+type Out struct{}
+
+type CancelScheduledMessageUsecase struct {
+	Repo schedulee.Repository
+}
+
+// CancelScheduledMessage отменяет отправку отложенного сообщения.
+// Отменить отправку может только автор, пока сообщение не отправлено
+func (c *CancelScheduledMessageUsecase) CancelScheduledMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Отмена выполняется в транзакции, чтобы не пересечься с одновременной отправкой
+	err := c.Repo.InTransaction(func(txRepo schedulee.Repository) error {
+		// Найти отложенное сообщение
+		scheduled, err := schedulee.Find(txRepo, schedulee.Filter{ID: in.ScheduledMessageID})
+		if err != nil {
+			return err
+		}
+
+		// Отменить отправку
+		if err = scheduled.Cancel(in.SubjectID); err != nil {
+			return err
+		}
+
+		// Сохранить отложенное сообщение в репозиторий
+		return txRepo.Upsert(scheduled)
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{}, nil
+}
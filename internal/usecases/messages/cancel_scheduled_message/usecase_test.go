@@ -0,0 +1,97 @@
+package cancelScheduledMessage
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+	mockSchedulee "github.com/nice-pea/npchat/internal/domain/schedulee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_CancelScheduledMessage тестирует отмену отложенного сообщения
+func (suite *testSuite) Test_Messages_CancelScheduledMessage() {
+	suite.Run("отложенное сообщение должно существовать", func() {
+		// Создать usecase и моки
+		usecase, mockRepo := newUsecase(suite)
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		// Отменить сообщение
+		out, err := usecase.CancelScheduledMessage(In{
+			SubjectID:          uuid.New(),
+			ScheduledMessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, schedulee.ErrScheduledMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("отменить сообщение может только автор", func() {
+		// Создать usecase и моки
+		usecase, mockRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		scheduled := suite.NewScheduledMessage(chat, chat.ChiefID)
+		mockRepo.EXPECT().List(mock.Anything).Return([]schedulee.ScheduledMessage{scheduled}, nil).Once()
+		// Отменить сообщение от имени другого пользователя
+		out, err := usecase.CancelScheduledMessage(In{
+			SubjectID:          uuid.New(),
+			ScheduledMessageID: scheduled.ID,
+		})
+		suite.ErrorIs(err, schedulee.ErrSubjectIsNotAuthor)
+		suite.Zero(out)
+	})
+
+	suite.Run("отправленное сообщение нельзя отменить", func() {
+		// Создать usecase и моки
+		usecase, mockRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		scheduled := suite.NewScheduledMessage(chat, chat.ChiefID)
+		suite.Require().NoError(scheduled.MarkSent(uuid.New()))
+		mockRepo.EXPECT().List(mock.Anything).Return([]schedulee.ScheduledMessage{scheduled}, nil).Once()
+		// Отменить сообщение
+		out, err := usecase.CancelScheduledMessage(In{
+			SubjectID:          chat.ChiefID,
+			ScheduledMessageID: scheduled.ID,
+		})
+		suite.ErrorIs(err, schedulee.ErrScheduledMessageNotPending)
+		suite.Zero(out)
+	})
+
+	suite.Run("отмененное сообщение будет сохранено", func() {
+		// Создать usecase и моки
+		usecase, mockRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		scheduled := suite.NewScheduledMessage(chat, chat.ChiefID)
+		mockRepo.EXPECT().List(schedulee.Filter{ID: scheduled.ID}).Return([]schedulee.ScheduledMessage{scheduled}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(s schedulee.ScheduledMessage) {
+			suite.Equal(scheduled.ID, s.ID)
+			suite.False(s.CanceledAt.IsZero())
+		}).Return(nil).Once()
+		// Отменить сообщение
+		_, err := usecase.CancelScheduledMessage(In{
+			SubjectID:          chat.ChiefID,
+			ScheduledMessageID: scheduled.ID,
+		})
+		suite.NoError(err)
+	})
+}
+
+func newUsecase(suite *testSuite) (*CancelScheduledMessageUsecase, *mockSchedulee.Repository) {
+	uc := &CancelScheduledMessageUsecase{
+		Repo: suite.RR.Scheduled,
+	}
+	mockRepo := uc.Repo.(*mockSchedulee.Repository)
+	mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(schedulee.Repository) error) error {
+		return fn(mockRepo)
+	}).Maybe()
+	return uc, mockRepo
+}
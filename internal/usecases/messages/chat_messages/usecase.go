@@ -0,0 +1,105 @@
+package chatMessages
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+var (
+	ErrInvalidSubjectID   = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID      = errors.New("некорректное значение ChatID")
+	ErrSubjectIsNotMember = errors.New("пользователь не является участником чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	Keyset    Keyset
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат запроса сообщений чата
+type Out struct {
+	Messages   []messagee.Message
+	Reactions  map[uuid.UUID][]messagee.ReactionSummary // Сводки реакций по ID сообщения
+	Polls      map[uuid.UUID]messagee.PollResults       // Итоги опросов по ID сообщения
+	NextKeyset Keyset
+}
+
+type Keyset struct {
+	CreatedBefore time.Time
+}
+
+type ChatMessagesUsecase struct {
+	Repo      messagee.Repository
+	ChatsRepo chatt.Repository
+}
+
+const defaultPageSize = 50
+
+// ChatMessages возвращает историю сообщений чата, начиная с самых новых.
+// Ответы в тредах не входят в историю чата.
+// Просматривать сообщения могут только участники чата
+func (c *ChatMessagesUsecase) ChatMessages(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Пользователь должен быть участником чата
+	if !chat.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMember
+	}
+
+	// Получить страницу сообщений
+	messages, err := c.Repo.List(messagee.Filter{
+		ChatID:        in.ChatID,
+		RootsOnly:     true,
+		CreatedBefore: in.Keyset.CreatedBefore,
+		Limit:         defaultPageSize,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Messages:   messages,
+		Reactions:  messagee.ReactionSummariesOf(messages, in.SubjectID),
+		Polls:      messagee.PollResultsOf(messages, in.SubjectID),
+		NextKeyset: nextKeyset(messages, defaultPageSize),
+	}, nil
+}
+
+func nextKeyset(messages []messagee.Message, pageSize int) Keyset {
+	if len(messages) < pageSize {
+		return Keyset{}
+	}
+
+	return Keyset{
+		CreatedBefore: messages[len(messages)-1].CreatedAt,
+	}
+}
@@ -0,0 +1,178 @@
+package chatMessages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_ChatMessages тестирует получение истории сообщений чата
+func (suite *testSuite) Test_Messages_ChatMessages() {
+	suite.Run("чат должен существовать", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+
+		out, err := usecase.ChatMessages(In{
+			SubjectID: uuid.New(),
+			ChatID:    uuid.New(),
+		})
+		suite.ErrorIs(err, chatt.ErrChatNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("просматривать сообщения могут только участники чата", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+
+		out, err := usecase.ChatMessages(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("возвращает сообщения чата", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		expectedMessages := suite.rndMessages(chat, 3)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ChatID:    chat.ID,
+			RootsOnly: true,
+			Limit:     defaultPageSize,
+		}).Return(expectedMessages, nil).Once()
+
+		out, err := usecase.ChatMessages(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+		})
+		suite.NoError(err)
+		suite.Equal(expectedMessages, out.Messages)
+		suite.Zero(out.NextKeyset)
+	})
+
+	suite.Run("возвращает сводку реакций с отметкой реакций пользователя", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		messages := suite.rndMessages(chat, 2)
+		for _, userID := range []uuid.UUID{chat.ChiefID, p.UserID} {
+			reaction, err := messagee.NewReaction(userID, "👍")
+			suite.Require().NoError(err)
+			suite.Require().NoError(messages[0].AddReaction(chat, reaction, nil))
+		}
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(messages, nil).Once()
+
+		out, err := usecase.ChatMessages(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+		})
+		suite.NoError(err)
+		suite.Equal(map[uuid.UUID][]messagee.ReactionSummary{
+			messages[0].ID: {{Emoji: "👍", Count: 2, Reacted: true}},
+			messages[1].ID: {},
+		}, out.Reactions)
+	})
+
+	suite.Run("возвращает итоги опросов с отметкой голосов пользователя", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		poll := suite.NewPollMessage(chat, chat.ChiefID, false)
+		suite.Require().NoError(poll.Vote(chat, p.UserID, []int{1}, nil))
+		messages := []messagee.Message{poll, suite.NewMessage(chat, chat.ChiefID)}
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(messages, nil).Once()
+
+		out, err := usecase.ChatMessages(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+		})
+		suite.NoError(err)
+		suite.Require().Len(out.Polls, 1)
+		suite.Equal(1, out.Polls[poll.ID].TotalVoters)
+		suite.True(out.Polls[poll.ID].Options[1].Voted)
+	})
+
+	suite.Run("возвращает keyset если страница заполнена", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		messages := suite.rndMessages(chat, defaultPageSize)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(messages, nil).Once()
+
+		out, err := usecase.ChatMessages(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+		})
+		suite.NoError(err)
+		suite.Equal(messages[len(messages)-1].CreatedAt, out.NextKeyset.CreatedBefore)
+	})
+
+	suite.Run("учитывает keyset при запросе", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		createdBefore := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ChatID:        chat.ID,
+			RootsOnly:     true,
+			CreatedBefore: createdBefore,
+			Limit:         defaultPageSize,
+		}).Return(nil, nil).Once()
+
+		out, err := usecase.ChatMessages(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Keyset:    Keyset{CreatedBefore: createdBefore},
+		})
+		suite.NoError(err)
+		suite.Empty(out.Messages)
+		suite.Empty(out.Reactions)
+		suite.Zero(out.NextKeyset)
+	})
+}
+
+// rndMessages создает сообщения главного администратора в порядке убывания CreatedAt
+func (suite *testSuite) rndMessages(chat chatt.Chat, count int) []messagee.Message {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	messages := make([]messagee.Message, count)
+	for i := range messages {
+		message, err := messagee.NewMessage(chat, chat.ChiefID, "text", nil)
+		suite.Require().NoError(err)
+		message.CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+		messages[i] = message
+	}
+
+	return messages
+}
+
+func newUsecase(suite *testSuite) (*ChatMessagesUsecase, *mockMessagee.Repository, *mockChatt.Repository) {
+	uc := &ChatMessagesUsecase{
+		Repo:      suite.RR.Messages,
+		ChatsRepo: suite.RR.Chats,
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	return uc, mockRepo, mockChatsRepo
+}
@@ -0,0 +1,91 @@
+package chatPins
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+var (
+	ErrInvalidSubjectID   = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID      = errors.New("некорректное значение ChatID")
+	ErrSubjectIsNotMember = errors.New("пользователь не является участником чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат запроса закрепленных сообщений
+type Out struct {
+	Pins     []chatt.Pin        // Закрепления в порядке закрепления
+	Messages []messagee.Message // Закрепленные сообщения
+}
+
+type ChatPinsUsecase struct {
+	Repo      messagee.Repository
+	ChatsRepo chatt.Repository
+}
+
+// ChatPins возвращает список закрепленных в чате сообщений.
+// Просматривать закрепленные сообщения могут только участники чата
+func (c *ChatPinsUsecase) ChatPins(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Пользователь должен быть участником чата
+	if !chat.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMember
+	}
+
+	// Если закрепленных сообщений нет, сразу вернуть пустой список
+	if len(chat.Pins) == 0 {
+		return Out{Pins: chat.Pins}, nil
+	}
+
+	// Собрать ID закрепленных сообщений
+	messageIDs := make([]uuid.UUID, len(chat.Pins))
+	for i, p := range chat.Pins {
+		messageIDs[i] = p.MessageID
+	}
+
+	// Найти закрепленные сообщения
+	messages, err := c.Repo.List(messagee.Filter{
+		IDs:    messageIDs,
+		ChatID: chat.ID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Pins:     chat.Pins,
+		Messages: messages,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package chatPins
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_ChatPins тестирует получение закрепленных сообщений
+func (suite *testSuite) Test_Messages_ChatPins() {
+	suite.Run("просматривать закрепленные сообщения могут только участники чата", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+
+		out, err := usecase.ChatPins(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("без закреплений сообщения не запрашиваются", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+
+		out, err := usecase.ChatPins(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Empty(out.Pins)
+		suite.Empty(out.Messages)
+	})
+
+	suite.Run("любой участник получит закрепленные сообщения", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		m1 := suite.NewMessage(chat, chat.ChiefID)
+		m2 := suite.NewMessage(chat, p.UserID)
+		suite.Require().NoError(chat.PinMessage(m1.ID, chat.ChiefID, chatt.DefaultMaxPins, nil))
+		suite.Require().NoError(chat.PinMessage(m2.ID, chat.ChiefID, chatt.DefaultMaxPins, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			IDs:    []uuid.UUID{m1.ID, m2.ID},
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{m2, m1}, nil).Once()
+
+		out, err := usecase.ChatPins(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(chat.Pins, out.Pins)
+		suite.ElementsMatch([]messagee.Message{m1, m2}, out.Messages)
+	})
+}
+
+func newUsecase(suite *testSuite) (*ChatPinsUsecase, *mockMessagee.Repository, *mockChatt.Repository) {
+	uc := &ChatPinsUsecase{
+		Repo:      suite.RR.Messages,
+		ChatsRepo: suite.RR.Chats,
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	return uc, mockRepo, mockChatsRepo
+}
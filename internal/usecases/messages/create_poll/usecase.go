@@ -0,0 +1,105 @@
+package createPoll
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID      uuid.UUID
+	ChatID         uuid.UUID
+	Question       string
+	Options        []string
+	MultipleChoice bool      // Можно ли выбрать несколько вариантов
+	Anonymous      bool      // Скрывать ли, кто за что проголосовал
+	ClosesAt       time.Time // Время завершения опроса, нулевое если опрос бессрочный
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+
+	return nil
+}
+
+// Out результат создания опроса
+type Out struct {
+	Message messagee.Message
+}
+
+type CreatePollUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// CreatePoll отправляет в чат сообщение с опросом.
+// Создавать опросы могут только участники чата
+func (c *CreatePollUsecase) CreatePoll(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Создать опрос
+	poll, err := messagee.NewPoll(in.Question, in.Options, in.MultipleChoice, in.Anonymous, in.ClosesAt)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Создать сообщение с опросом
+	message, err := messagee.NewPollMessage(chat, in.SubjectID, poll, eventsBuf)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить сообщение в репозиторий
+	if err = c.Repo.Upsert(message); err != nil {
+		return Out{}, err
+	}
+
+	// Обновить время последней активности чата
+	err = chat.SetLastActiveAt(message.CreatedAt, eventsBuf)
+	switch {
+	case err == nil:
+		if err = c.ChatsRepo.UpdateLastActiveAt(chat.ID, chat.LastActiveAt); err != nil {
+			return Out{}, err
+		}
+	case !errors.Is(err, chatt.ErrNewActiveLessThanActual):
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Message: message,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package createPoll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_CreatePoll тестирует создание опроса
+func (suite *testSuite) Test_Messages_CreatePoll() {
+	suite.Run("опрос должен быть валидным", func() {
+		usecase, _, _, _ := newUsecase(suite)
+		out, err := usecase.CreatePoll(In{
+			SubjectID: uuid.New(),
+			ChatID:    uuid.New(),
+			Question:  "question",
+			Options:   []string{"a"},
+		})
+		suite.ErrorIs(err, messagee.ErrInvalidPollOptionsCount)
+		suite.Zero(out)
+	})
+
+	suite.Run("создавать опросы могут только участники чата", func() {
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+
+		out, err := usecase.CreatePoll(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+			Question:  "question",
+			Options:   []string{"a", "b"},
+		})
+		suite.ErrorIs(err, messagee.ErrAuthorIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("опрос сохранится, а время активности чата обновится", func() {
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		closesAt := time.Now().Add(time.Hour)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		var savedMessage messagee.Message
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(message messagee.Message) {
+			savedMessage = message
+		}).Return(nil).Once()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(chat.ID, mock.Anything).Run(func(_ uuid.UUID, lastActiveAt time.Time) {
+			suite.Equal(savedMessage.CreatedAt, lastActiveAt)
+		}).Return(nil).Once()
+
+		out, err := usecase.CreatePoll(In{
+			SubjectID:      p.UserID,
+			ChatID:         chat.ID,
+			Question:       "question",
+			Options:        []string{"a", "b"},
+			MultipleChoice: true,
+			Anonymous:      true,
+			ClosesAt:       closesAt,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(savedMessage, out.Message)
+		suite.Require().True(out.Message.IsPoll())
+		suite.Equal([]string{"a", "b"}, out.Message.Poll.Options)
+		suite.True(out.Message.Poll.MultipleChoice)
+		suite.True(out.Message.Poll.Anonymous)
+		suite.Equal(closesAt.UTC().Truncate(time.Microsecond), out.Message.Poll.ClosesAt)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessageCreated)
+	})
+}
+
+func newUsecase(suite *testSuite) (*CreatePollUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &CreatePollUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
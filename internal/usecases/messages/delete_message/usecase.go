@@ -0,0 +1,93 @@
+package deleteMessage
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID = errors.New("некорректное значение MessageID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	return nil
+}
+
+// Out результат удаления сообщения
+type Out struct {
+	Message messagee.Message
+}
+
+type DeleteMessageUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// DeleteMessage удаляет сообщение, оставляя метку удаления.
+// Доступно для автора сообщения и участников с правом удалять чужие сообщения
+func (c *DeleteMessageUsecase) DeleteMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти сообщение
+	message, err := messagee.Find(c.Repo, messagee.Filter{
+		ID:     in.MessageID,
+		ChatID: in.ChatID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Удалить сообщение
+	if err = message.Delete(chat, in.SubjectID, eventsBuf); err != nil {
+		return Out{}, err
+	}
+	if err = c.Repo.Upsert(message); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Message: message,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package deleteMessage
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_DeleteMessage тестирует удаление сообщения
+func (suite *testSuite) Test_Messages_DeleteMessage() {
+	suite.Run("сообщение должно существовать", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		// Удалить сообщение
+		out, err := usecase.DeleteMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, messagee.ErrMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("участник не может удалить чужое сообщение", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		author := suite.AddRndParticipant(&chat)
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, author.UserID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		// Удалить сообщение от имени другого участника
+		out, err := usecase.DeleteMessage(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.ErrorIs(err, messagee.ErrSubjectCannotDelete)
+		suite.Zero(out)
+	})
+
+	suite.Run("главный администратор может удалить чужое сообщение", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		chat := suite.RndChat()
+		author := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, author.UserID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(deleted messagee.Message) {
+			suite.True(deleted.IsDeleted())
+			suite.Empty(deleted.Text)
+		}).Return(nil).Once()
+		// Удалить сообщение от имени администратора
+		out, err := usecase.DeleteMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+		suite.True(out.Message.IsDeleted())
+	})
+
+	suite.Run("после завершения операции, будут созданы события", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		// Удалить сообщение
+		_, err := usecase.DeleteMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessageDeleted)
+	})
+}
+
+func newUsecase(suite *testSuite) (*DeleteMessageUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &DeleteMessageUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
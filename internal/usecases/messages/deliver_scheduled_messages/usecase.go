@@ -0,0 +1,176 @@
+package deliverScheduledMessages
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+	sendMessage "github.com/nice-pea/npchat/internal/usecases/messages/send_message"
+)
+
+// DefaultLimit количество сообщений, отправляемых за один вызов, если лимит не указан
+const DefaultLimit = 100
+
+var ErrInvalidNow = errors.New("некорректное значение Now")
+
+// temporarySQLStateClasses классы кодов ошибок PostgreSQL, после которых отправку стоит повторить:
+// потеря соединения, конфликт транзакций, нехватка ресурсов и вмешательство оператора
+var temporarySQLStateClasses = []string{"08", "40", "53", "57"}
+
+// In входящие параметры
+type In struct {
+	Now   time.Time // Момент, на который отправляются наступившие сообщения
+	Limit int       // Максимальное количество сообщений за вызов
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if in.Now.IsZero() {
+		return ErrInvalidNow
+	}
+
+	return nil
+}
+
+// Out результат отправки отложенных сообщений
+type Out struct {
+	Sent   int // Количество отправленных сообщений
+	Failed int // Количество сообщений, которые не удалось отправить
+}
+
+// Sender отправляет сообщение в чат
+type Sender interface {
+	SendMessage(sendMessage.In) (sendMessage.Out, error)
+}
+
+type DeliverScheduledMessagesUsecase struct {
+	Repo   schedulee.Repository
+	Sender Sender
+}
+
+// DeliverScheduledMessages отправляет отложенные сообщения, время отправки которых наступило.
+// Сообщения отправляются обычным способом, как если бы их отправил автор.
+// Каждое сообщение блокируется и отправляется в отдельной транзакции, а статус отправки
+// сохраняется сразу после нее, поэтому несколько экземпляров приложения с общей базой данных
+// не отправят одно сообщение дважды, а сбой на одном сообщении не приведет к повторной отправке остальных.
+// Если отправка не удалась из-за сбоя инфраструктуры, сообщение остается ожидать следующего вызова,
+// при любой другой ошибке оно отмечается неотправленным
+func (c *DeliverScheduledMessagesUsecase) DeliverScheduledMessages(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+	if in.Limit <= 0 {
+		in.Limit = DefaultLimit
+	}
+
+	var out Out
+	for out.Sent+out.Failed < in.Limit {
+		result, err := c.deliverNext(in.Now)
+		if err != nil {
+			return out, err
+		}
+
+		switch result {
+		case resultNone:
+			return out, nil
+		case resultSent:
+			out.Sent++
+		case resultFailed:
+			out.Failed++
+		}
+	}
+
+	return out, nil
+}
+
+// deliveryResult результат обработки одного отложенного сообщения
+type deliveryResult int
+
+const (
+	resultNone   deliveryResult = iota // Наступивших сообщений нет
+	resultSent                         // Сообщение отправлено
+	resultFailed                       // Сообщение отмечено неотправленным
+)
+
+// deliverNext блокирует одно наступившее сообщение, отправляет его и сохраняет статус отправки
+func (c *DeliverScheduledMessagesUsecase) deliverNext(now time.Time) (deliveryResult, error) {
+	var result deliveryResult
+	var sendErr error
+	err := c.Repo.InTransaction(func(txRepo schedulee.Repository) error {
+		// Заблокировать наступившее сообщение
+		due, err := txRepo.ClaimDue(now, 1)
+		if err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+		scheduled := due[0]
+
+		// Отправить сообщение
+		sent, err := c.Sender.SendMessage(sendMessage.In{
+			SubjectID: scheduled.AuthorID,
+			ChatID:    scheduled.ChatID,
+			Text:      scheduled.Text,
+			ParentID:  scheduled.ParentID,
+		})
+		switch {
+		case err == nil:
+			err = scheduled.MarkSent(sent.Message.ID)
+			result = resultSent
+		case isTemporary(err):
+			// Временная ошибка: оставить сообщение до следующего вызова
+			sendErr = err
+			return nil
+		default:
+			err = scheduled.MarkFailed(err.Error())
+			result = resultFailed
+		}
+		if err != nil {
+			return err
+		}
+
+		// Сохранить статус отправки
+		return txRepo.Upsert(scheduled)
+	})
+	if err != nil {
+		return resultNone, err
+	}
+	if sendErr != nil {
+		return resultNone, sendErr
+	}
+
+	return result, nil
+}
+
+// isTemporary проверяет, вызвана ли ошибка отправки сбоем инфраструктуры,
+// после которого отправку стоит повторить
+func isTemporary(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, sql.ErrTxDone) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var sqlStateErr interface{ SQLState() string }
+	if errors.As(err, &sqlStateErr) {
+		state := sqlStateErr.SQLState()
+		return len(state) >= 2 && slices.Contains(temporarySQLStateClasses, state[:2])
+	}
+
+	return false
+}
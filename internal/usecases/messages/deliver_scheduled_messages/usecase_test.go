@@ -0,0 +1,216 @@
+package deliverScheduledMessages
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+	mockSchedulee "github.com/nice-pea/npchat/internal/domain/schedulee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	sendMessage "github.com/nice-pea/npchat/internal/usecases/messages/send_message"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_DeliverScheduledMessages тестирует отправку отложенных сообщений
+func (suite *testSuite) Test_Messages_DeliverScheduledMessages() {
+	suite.Run("Now должен быть указан", func() {
+		// Создать usecase и моки
+		usecase, _, _, _ := newUsecase(suite)
+		// Отправить сообщения
+		out, err := usecase.DeliverScheduledMessages(In{})
+		suite.ErrorIs(err, ErrInvalidNow)
+		suite.Zero(out)
+	})
+
+	suite.Run("если наступивших сообщений нет, ничего не отправится", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, _, _ := newUsecase(suite)
+		now := time.Now()
+		mockRepo.EXPECT().ClaimDue(now, 1).Return(nil, nil).Once()
+		// Отправить сообщения
+		out, err := usecase.DeliverScheduledMessages(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Zero(out)
+	})
+
+	suite.Run("сообщение отправится обычным способом и будет отмечено отправленным", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockMessagesRepo := newUsecase(suite)
+		mockEventConsumer := usecase.Sender.(*sendMessage.SendMessageUsecase).EventConsumer.(*mockEvents.Consumer)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		scheduled := suite.NewScheduledMessage(chat, chat.ChiefID)
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return([]schedulee.ScheduledMessage{scheduled}, nil).Once()
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		var sent messagee.Message
+		mockMessagesRepo.EXPECT().Upsert(mock.Anything).Run(func(m messagee.Message) {
+			sent = m
+		}).Return(nil).Once()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(chat.ID, mock.Anything).Run(func(_ uuid.UUID, lastActiveAt time.Time) {
+			suite.Equal(sent.CreatedAt, lastActiveAt)
+		}).Return(nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(s schedulee.ScheduledMessage) {
+			suite.Equal(scheduled.ID, s.ID)
+			suite.Equal(sent.ID, s.MessageID)
+			suite.False(s.SentAt.IsZero())
+		}).Return(nil).Once()
+		// Отправить сообщения
+		out, err := usecase.DeliverScheduledMessages(In{Now: scheduled.SendAt})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Sent: 1}, out)
+		suite.Equal(scheduled.AuthorID, sent.AuthorID)
+		suite.Equal(scheduled.Text, sent.Text)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessageCreated)
+	})
+
+	suite.Run("если автор покинул чат, сообщение будет отмечено неотправленным", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		scheduled := suite.NewScheduledMessage(chat, p.UserID)
+		suite.Require().NoError(chat.RemoveParticipant(p.UserID, nil))
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return([]schedulee.ScheduledMessage{scheduled}, nil).Once()
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(s schedulee.ScheduledMessage) {
+			suite.False(s.FailedAt.IsZero())
+			suite.Equal(messagee.ErrAuthorIsNotMember.Error(), s.FailReason)
+		}).Return(nil).Once()
+		// Отправить сообщения
+		out, err := usecase.DeliverScheduledMessages(In{Now: scheduled.SendAt})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Failed: 1}, out)
+	})
+
+	suite.Run("если чат в архиве, сообщение будет отмечено неотправленным и не задержит следующие", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		archived := suite.RndChat()
+		blocked := suite.NewScheduledMessage(archived, archived.ChiefID)
+		suite.Require().NoError(archived.Archive(archived.ChiefID, nil))
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		next := suite.NewScheduledMessage(chat, p.UserID)
+		suite.Require().NoError(chat.RemoveParticipant(p.UserID, nil))
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return([]schedulee.ScheduledMessage{blocked}, nil).Once()
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return([]schedulee.ScheduledMessage{next}, nil).Once()
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: archived.ID}).Return([]chatt.Chat{archived}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		var saved []schedulee.ScheduledMessage
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(s schedulee.ScheduledMessage) {
+			saved = append(saved, s)
+		}).Return(nil).Twice()
+		// Отправить сообщения
+		out, err := usecase.DeliverScheduledMessages(In{Now: next.SendAt})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Failed: 2}, out)
+		suite.Require().Len(saved, 2)
+		suite.Equal(blocked.ID, saved[0].ID)
+		suite.Equal(chatt.ErrChatIsArchived.Error(), saved[0].FailReason)
+		suite.Equal(next.ID, saved[1].ID)
+	})
+
+	suite.Run("неизвестная ошибка не считается временной", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		scheduled := suite.NewScheduledMessage(chat, chat.ChiefID)
+		errUnknown := errors.New("unknown")
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return([]schedulee.ScheduledMessage{scheduled}, nil).Once()
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return(nil, errUnknown).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(s schedulee.ScheduledMessage) {
+			suite.Equal(errUnknown.Error(), s.FailReason)
+		}).Return(nil).Once()
+		// Отправить сообщения
+		out, err := usecase.DeliverScheduledMessages(In{Now: scheduled.SendAt})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Failed: 1}, out)
+	})
+
+	suite.Run("при сбое инфраструктуры сообщение останется ожидать отправки", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		scheduled := suite.NewScheduledMessage(chat, chat.ChiefID)
+		errTemporary := fmt.Errorf("r.DB().Select: %w", &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return([]schedulee.ScheduledMessage{scheduled}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return(nil, errTemporary).Once()
+		// Отправить сообщения
+		out, err := usecase.DeliverScheduledMessages(In{Now: scheduled.SendAt})
+		suite.ErrorIs(err, syscall.ECONNRESET)
+		suite.Zero(out)
+		mockRepo.AssertNotCalled(suite.T(), "Upsert", mock.Anything)
+	})
+
+	suite.Run("статус отправленного сообщения сохраняется до обработки следующего", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		first := suite.NewScheduledMessage(chat, p.UserID)
+		second := suite.NewScheduledMessage(chat, p.UserID)
+		suite.Require().NoError(chat.RemoveParticipant(p.UserID, nil))
+		errTemporary := sql.ErrConnDone
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return([]schedulee.ScheduledMessage{first}, nil).Once()
+		mockRepo.EXPECT().ClaimDue(mock.Anything, 1).Return([]schedulee.ScheduledMessage{second}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return(nil, errTemporary).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(s schedulee.ScheduledMessage) {
+			suite.Equal(first.ID, s.ID)
+		}).Return(nil).Once()
+		// Отправить сообщения
+		out, err := usecase.DeliverScheduledMessages(In{Now: second.SendAt})
+		suite.ErrorIs(err, errTemporary)
+		suite.Equal(Out{Failed: 1}, out)
+		mockRepo.AssertNumberOfCalls(suite.T(), "InTransaction", 2)
+	})
+}
+
+func newUsecase(suite *testSuite) (*DeliverScheduledMessagesUsecase, *mockSchedulee.Repository, *mockChatt.Repository, *mockMessagee.Repository) {
+	uc := &DeliverScheduledMessagesUsecase{
+		Repo: suite.RR.Scheduled,
+		Sender: &sendMessage.SendMessageUsecase{
+			Repo:            suite.RR.Messages,
+			ChatsRepo:       suite.RR.Chats,
+			AttachmentsRepo: suite.RR.Attachments,
+			UsersRepo:       suite.RR.Users,
+			EventConsumer:   mockEvents.NewConsumer(suite.T()),
+		},
+	}
+	mockRepo := uc.Repo.(*mockSchedulee.Repository)
+	mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(schedulee.Repository) error) error {
+		return fn(mockRepo)
+	}).Maybe()
+	return uc, mockRepo, suite.RR.Chats, suite.RR.Messages
+}
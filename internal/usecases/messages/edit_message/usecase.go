@@ -0,0 +1,107 @@
+package editMessage
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID = errors.New("некорректное значение MessageID")
+	ErrInvalidText      = errors.New("некорректное значение Text")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+	Text      string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+	if err := messagee.ValidateMessageText(in.Text); err != nil {
+		return errors.Join(err, ErrInvalidText)
+	}
+
+	return nil
+}
+
+// Out результат редактирования сообщения
+type Out struct {
+	Message messagee.Message
+}
+
+type EditMessageUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	UsersRepo     userr.Repository
+	EventConsumer events.Consumer
+}
+
+// EditMessage изменяет текст сообщения.
+// Доступно только для автора сообщения.
+// Впервые упомянутые в новом тексте участники получают событие упоминания
+func (c *EditMessageUsecase) EditMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти сообщение
+	message, err := messagee.Find(c.Repo, messagee.Filter{
+		ID:     in.MessageID,
+		ChatID: in.ChatID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти упомянутых участников
+	mentions, err := messagee.ResolveMentions(c.UsersRepo, chat, in.Text)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Изменить текст сообщения
+	if err = message.Edit(chat, in.SubjectID, in.Text, mentions, eventsBuf); err != nil {
+		return Out{}, err
+	}
+	if err = c.Repo.Upsert(message); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Message: message,
+	}, nil
+}
@@ -0,0 +1,182 @@
+package editMessage
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_EditMessage тестирует редактирование сообщения
+func (suite *testSuite) Test_Messages_EditMessage() {
+	suite.Run("сообщение должно существовать", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		// Изменить сообщение
+		out, err := usecase.EditMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+			Text:      "new text",
+		})
+		suite.ErrorIs(err, messagee.ErrMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("редактировать может только автор", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ID:     message.ID,
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{message}, nil).Once()
+		// Изменить сообщение от имени другого участника
+		out, err := usecase.EditMessage(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Text:      "new text",
+		})
+		suite.ErrorIs(err, messagee.ErrSubjectIsNotAuthor)
+		suite.Zero(out)
+	})
+
+	suite.Run("новый текст сохранится, а старый попадет в историю", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		input := In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Text:      "new text",
+		}
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(updated messagee.Message) {
+			suite.Equal(input.Text, updated.Text)
+			suite.Require().Len(updated.Revisions, 1)
+			suite.Equal(message.Text, updated.Revisions[0].Text)
+		}).Return(nil).Once()
+		// Изменить сообщение
+		out, err := usecase.EditMessage(input)
+		suite.Require().NoError(err)
+		suite.Equal(input.Text, out.Message.Text)
+		suite.NotZero(out.Message.EditedAt)
+	})
+
+	suite.Run("после завершения операции, будут созданы события", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		// Изменить сообщение
+		_, err := usecase.EditMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Text:      "new text",
+		})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessageUpdated)
+	})
+
+	suite.Run("упомянутый в новом тексте участник получит событие упоминания", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		suite.RR.Users.EXPECT().List(userr.Filter{Nicks: []string{"alice"}}).
+			Return([]userr.User{{ID: p.UserID, Nick: "alice"}}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(updated messagee.Message) {
+			suite.Equal([]uuid.UUID{p.UserID}, updated.Mentions)
+		}).Return(nil).Once()
+		// Изменить сообщение
+		_, err := usecase.EditMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Text:      "@alice посмотри",
+		})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventMentioned)
+	})
+}
+
+// Test_EditMessageInput_Validate тестирует валидацию входящих параметров
+func Test_EditMessageInput_Validate(t *testing.T) {
+	valid := In{
+		SubjectID: uuid.New(),
+		ChatID:    uuid.New(),
+		MessageID: uuid.New(),
+		Text:      "text",
+	}
+	assert.NoError(t, valid.Validate())
+
+	in := valid
+	in.MessageID = uuid.Nil
+	assert.ErrorIs(t, in.Validate(), ErrInvalidMessageID)
+
+	in = valid
+	in.Text = " "
+	assert.ErrorIs(t, in.Validate(), ErrInvalidText)
+}
+
+func newUsecase(suite *testSuite) (*EditMessageUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &EditMessageUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		UsersRepo:     suite.RR.Users,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
@@ -0,0 +1,163 @@
+package forwardMessage
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// MaxMessages максимальное количество сообщений, пересылаемых за один раз
+const MaxMessages = 100
+
+var (
+	ErrInvalidSubjectID           = errors.New("некорректное значение SubjectID")
+	ErrInvalidSourceChatID        = errors.New("некорректное значение SourceChatID")
+	ErrInvalidTargetChatID        = errors.New("некорректное значение TargetChatID")
+	ErrInvalidMessageIDs          = errors.New("некорректное значение MessageIDs")
+	ErrMessageIDsIsRequired       = errors.New("не указаны сообщения для пересылки")
+	ErrTooManyMessages            = fmt.Errorf("за один раз можно переслать не больше %d сообщений", MaxMessages)
+	ErrDuplicateMessageID         = errors.New("сообщение указано несколько раз")
+	ErrSubjectIsNotMemberOfSource = errors.New("пользователь не является участником исходного чата")
+	ErrSubjectIsNotMemberOfTarget = errors.New("пользователь не является участником целевого чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID    uuid.UUID
+	SourceChatID uuid.UUID
+	TargetChatID uuid.UUID
+	MessageIDs   []uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.SourceChatID); err != nil {
+		return errors.Join(err, ErrInvalidSourceChatID)
+	}
+	if err := domain.ValidateID(in.TargetChatID); err != nil {
+		return errors.Join(err, ErrInvalidTargetChatID)
+	}
+	if len(in.MessageIDs) == 0 {
+		return ErrMessageIDsIsRequired
+	}
+	if len(in.MessageIDs) > MaxMessages {
+		return ErrTooManyMessages
+	}
+	for i, id := range in.MessageIDs {
+		if err := domain.ValidateID(id); err != nil {
+			return errors.Join(err, ErrInvalidMessageIDs)
+		}
+		if slices.Contains(in.MessageIDs[:i], id) {
+			return ErrDuplicateMessageID
+		}
+	}
+
+	return nil
+}
+
+// Out результат пересылки сообщений
+type Out struct {
+	Messages []messagee.Message // Пересланные копии в порядке создания исходных сообщений
+}
+
+type ForwardMessageUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// ForwardMessage копирует сообщения из исходного чата в целевой.
+// Пользователь должен быть участником обоих чатов.
+// События о новых сообщениях получат только участники целевого чата
+func (c *ForwardMessageUsecase) ForwardMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти исходный чат
+	source, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.SourceChatID})
+	if err != nil {
+		return Out{}, err
+	}
+	if !source.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMemberOfSource
+	}
+
+	// Найти целевой чат
+	target, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.TargetChatID})
+	if err != nil {
+		return Out{}, err
+	}
+	if !target.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMemberOfTarget
+	}
+
+	// Найти пересылаемые сообщения
+	originals, err := c.Repo.List(messagee.Filter{
+		IDs:    in.MessageIDs,
+		ChatID: source.ID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+	if len(originals) != len(in.MessageIDs) {
+		return Out{}, messagee.ErrMessageNotExists
+	}
+
+	// Сохранить порядок исходных сообщений
+	slices.SortStableFunc(originals, func(a, b messagee.Message) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Создать копии сообщений в целевом чате
+	forwarded := make([]messagee.Message, len(originals))
+	for i, original := range originals {
+		if forwarded[i], err = messagee.NewForwardedMessage(target, in.SubjectID, original, eventsBuf); err != nil {
+			return Out{}, err
+		}
+	}
+
+	// Сохранить копии в репозиторий
+	if err = c.Repo.InTransaction(func(txRepo messagee.Repository) error {
+		for _, message := range forwarded {
+			if err := txRepo.Upsert(message); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return Out{}, err
+	}
+
+	// Обновить время последней активности целевого чата
+	err = target.SetLastActiveAt(forwarded[len(forwarded)-1].CreatedAt, eventsBuf)
+	switch {
+	case err == nil:
+		if err = c.ChatsRepo.UpdateLastActiveAt(target.ID, target.LastActiveAt); err != nil {
+			return Out{}, err
+		}
+	case !errors.Is(err, chatt.ErrNewActiveLessThanActual):
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Messages: forwarded,
+	}, nil
+}
@@ -0,0 +1,216 @@
+package forwardMessage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_ForwardMessage тестирует пересылку сообщений
+func (suite *testSuite) Test_Messages_ForwardMessage() {
+	suite.Run("пользователь должен быть участником исходного чата", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		source := suite.RndChat()
+		target := suite.RndChat()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: source.ID}).Return([]chatt.Chat{source}, nil).Once()
+		// Переслать сообщение
+		out, err := usecase.ForwardMessage(In{
+			SubjectID:    target.ChiefID,
+			SourceChatID: source.ID,
+			TargetChatID: target.ID,
+			MessageIDs:   []uuid.UUID{uuid.New()},
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMemberOfSource)
+		suite.Zero(out)
+	})
+
+	suite.Run("пользователь должен быть участником целевого чата", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		source := suite.RndChat()
+		target := suite.RndChat()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: source.ID}).Return([]chatt.Chat{source}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: target.ID}).Return([]chatt.Chat{target}, nil).Once()
+		// Переслать сообщение
+		out, err := usecase.ForwardMessage(In{
+			SubjectID:    source.ChiefID,
+			SourceChatID: source.ID,
+			TargetChatID: target.ID,
+			MessageIDs:   []uuid.UUID{uuid.New()},
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMemberOfTarget)
+		suite.Zero(out)
+	})
+
+	suite.Run("все сообщения должны существовать в исходном чате", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		source := suite.RndChat()
+		target := suite.RndChat()
+		suite.AddParticipant(&target, suite.NewParticipant(source.ChiefID))
+		message := suite.NewMessage(source, source.ChiefID)
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: source.ID}).Return([]chatt.Chat{source}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: target.ID}).Return([]chatt.Chat{target}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		// Переслать существующее и несуществующее сообщения
+		out, err := usecase.ForwardMessage(In{
+			SubjectID:    source.ChiefID,
+			SourceChatID: source.ID,
+			TargetChatID: target.ID,
+			MessageIDs:   []uuid.UUID{message.ID, uuid.New()},
+		})
+		suite.ErrorIs(err, messagee.ErrMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("копии сохранятся в целевом чате в порядке исходных сообщений", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		source := suite.RndChat()
+		target := suite.RndChat()
+		suite.AddParticipant(&target, suite.NewParticipant(source.ChiefID))
+		m1 := suite.NewMessage(source, source.ChiefID)
+		m2 := suite.NewMessage(source, source.ChiefID)
+		m2.CreatedAt = m1.CreatedAt.Add(time.Second)
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: source.ID}).Return([]chatt.Chat{source}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: target.ID}).Return([]chatt.Chat{target}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			IDs:    []uuid.UUID{m2.ID, m1.ID},
+			ChatID: source.ID,
+		}).Return([]messagee.Message{m2, m1}, nil).Once()
+		mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(messagee.Repository) error) error {
+			return fn(mockRepo)
+		}).Once()
+		var saved []messagee.Message
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(message messagee.Message) {
+			saved = append(saved, message)
+		}).Return(nil).Twice()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(target.ID, mock.Anything).Return(nil).Once()
+		// Переслать сообщения
+		out, err := usecase.ForwardMessage(In{
+			SubjectID:    source.ChiefID,
+			SourceChatID: source.ID,
+			TargetChatID: target.ID,
+			MessageIDs:   []uuid.UUID{m2.ID, m1.ID},
+		})
+		suite.Require().NoError(err)
+		suite.Equal(saved, out.Messages)
+		suite.Require().Len(out.Messages, 2)
+		suite.Equal(m1.ID, out.Messages[0].ForwardedFrom.MessageID)
+		suite.Equal(m2.ID, out.Messages[1].ForwardedFrom.MessageID)
+		for _, message := range out.Messages {
+			suite.Equal(target.ID, message.ChatID)
+			suite.Equal(source.ChiefID, message.AuthorID)
+			suite.Equal(source.ID, message.ForwardedFrom.ChatID)
+		}
+	})
+
+	suite.Run("события получат только участники целевого чата", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		source := suite.RndChat()
+		suite.AddRndParticipant(&source)
+		target := suite.RndChat()
+		suite.AddParticipant(&target, suite.NewParticipant(source.ChiefID))
+		message := suite.NewMessage(source, source.ChiefID)
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: source.ID}).Return([]chatt.Chat{source}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: target.ID}).Return([]chatt.Chat{target}, nil).Once()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(target.ID, mock.Anything).Return(nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(messagee.Repository) error) error {
+			return fn(mockRepo)
+		}).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		// Переслать сообщение
+		_, err := usecase.ForwardMessage(In{
+			SubjectID:    source.ChiefID,
+			SourceChatID: source.ID,
+			TargetChatID: target.ID,
+			MessageIDs:   []uuid.UUID{message.ID},
+		})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessageCreated)
+		suite.AssertHasEventType(consumedEvents, chatt.EventChatUpdated)
+		for _, e := range consumedEvents {
+			suite.ElementsMatch(target.ParticipantIDs(), e.Recipients)
+		}
+	})
+}
+
+// Test_ForwardMessageInput_Validate тестирует входящие параметры пересылки сообщений
+func Test_ForwardMessageInput_Validate(t *testing.T) {
+	valid := func() In {
+		return In{
+			SubjectID:    uuid.New(),
+			SourceChatID: uuid.New(),
+			TargetChatID: uuid.New(),
+			MessageIDs:   []uuid.UUID{uuid.New()},
+		}
+	}
+	t.Run("корректные параметры", func(t *testing.T) {
+		assert.NoError(t, valid().Validate())
+	})
+	t.Run("не указаны сообщения", func(t *testing.T) {
+		in := valid()
+		in.MessageIDs = nil
+		assert.ErrorIs(t, in.Validate(), ErrMessageIDsIsRequired)
+	})
+	t.Run("слишком много сообщений", func(t *testing.T) {
+		in := valid()
+		in.MessageIDs = make([]uuid.UUID, MaxMessages+1)
+		for i := range in.MessageIDs {
+			in.MessageIDs[i] = uuid.New()
+		}
+		assert.ErrorIs(t, in.Validate(), ErrTooManyMessages)
+	})
+	t.Run("сообщение указано дважды", func(t *testing.T) {
+		in := valid()
+		in.MessageIDs = append(in.MessageIDs, in.MessageIDs[0])
+		assert.ErrorIs(t, in.Validate(), ErrDuplicateMessageID)
+	})
+	t.Run("некорректный ID сообщения", func(t *testing.T) {
+		in := valid()
+		in.MessageIDs = []uuid.UUID{uuid.Nil}
+		assert.ErrorIs(t, in.Validate(), ErrInvalidMessageIDs)
+	})
+}
+
+func newUsecase(suite *testSuite) (*ForwardMessageUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &ForwardMessageUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
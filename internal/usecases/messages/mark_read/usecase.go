@@ -0,0 +1,105 @@
+package markRead
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID = errors.New("некорректное значение MessageID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	return nil
+}
+
+// Out результат отметки прочтения
+type Out struct {
+	Participant chatt.Participant
+}
+
+type MarkReadUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// MarkRead перемещает отметку прочтения участника чата на указанное сообщение.
+// Отметка на более раннее сообщение, чем уже прочитанное, игнорируется
+func (c *MarkReadUsecase) MarkRead(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	var participant chatt.Participant
+	err := c.ChatsRepo.InTransaction(func(txRepo chatt.Repository) error {
+		// Найти чат с блокировкой, чтобы не перезаписать параллельные изменения
+		chat, err := chatt.Find(txRepo, chatt.Filter{ID: in.ChatID})
+		if err != nil {
+			return err
+		}
+
+		// Найти сообщение
+		message, err := messagee.Find(c.Repo, messagee.Filter{
+			ID:     in.MessageID,
+			ChatID: in.ChatID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Переместить отметку прочтения
+		err = chat.MarkRead(in.SubjectID, message.ID, message.CreatedAt, eventsBuf)
+		if err != nil && !errors.Is(err, chatt.ErrReadMarkerMovesBackward) {
+			return err
+		}
+		if err == nil {
+			if err = txRepo.Upsert(chat); err != nil {
+				return err
+			}
+		}
+
+		participant, err = chat.Participant(in.SubjectID)
+		return err
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Participant: participant,
+	}, nil
+}
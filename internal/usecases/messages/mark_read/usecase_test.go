@@ -0,0 +1,152 @@
+package markRead
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_MarkRead тестирует отметку прочтения сообщений
+func (suite *testSuite) Test_Messages_MarkRead() {
+	suite.Run("сообщение должно существовать", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		// Отметить прочтение
+		out, err := usecase.MarkRead(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, messagee.ErrMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("отмечать прочтение могут только участники чата", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		// Отметить прочтение от имени постороннего пользователя
+		out, err := usecase.MarkRead(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.ErrorIs(err, chatt.ErrParticipantNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("отметка прочтения будет сохранена у участника", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ID:     message.ID,
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{message}, nil).Once()
+		mockChatsRepo.EXPECT().Upsert(mock.Anything).Run(func(chat chatt.Chat) {
+			participant, err := chat.Participant(p.UserID)
+			suite.Require().NoError(err)
+			suite.Equal(message.ID, participant.LastReadMessageID)
+		}).Return(nil).Once()
+		// Отметить прочтение
+		out, err := usecase.MarkRead(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(p.UserID, out.Participant.UserID)
+		suite.Equal(message.ID, out.Participant.LastReadMessageID)
+		suite.Equal(message.CreatedAt, out.Participant.LastReadAt)
+	})
+
+	suite.Run("отметка на более раннее сообщение игнорируется", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		// Отметить прочтение более позднего сообщения
+		lastReadMessageID := uuid.New()
+		err := chat.MarkRead(chat.ChiefID, lastReadMessageID, message.CreatedAt.Add(time.Minute), nil)
+		suite.Require().NoError(err)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		// Отметить прочтение
+		out, err := usecase.MarkRead(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(lastReadMessageID, out.Participant.LastReadMessageID)
+	})
+
+	suite.Run("после завершения операции, будут созданы события", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		mockChatsRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		// Отметить прочтение
+		_, err := usecase.MarkRead(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventReadMarkerUpdated)
+	})
+}
+
+func newUsecase(suite *testSuite) (*MarkReadUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &MarkReadUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockChatsRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(chatt.Repository) error) error {
+		return fn(mockChatsRepo)
+	}).Maybe()
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
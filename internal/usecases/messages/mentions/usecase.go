@@ -0,0 +1,98 @@
+package mentions
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	Keyset    Keyset
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+
+	return nil
+}
+
+// Out результат запроса сообщений с упоминаниями
+type Out struct {
+	Messages   []messagee.Message
+	NextKeyset Keyset
+}
+
+type Keyset struct {
+	CreatedBefore time.Time
+}
+
+type MentionsUsecase struct {
+	Repo      messagee.Repository
+	ChatsRepo chatt.Repository
+}
+
+const defaultPageSize = 50
+
+// Mentions возвращает сообщения, в которых упомянут пользователь, начиная с самых новых.
+// Учитываются только чаты, в которых пользователь участвует сейчас
+func (c *MentionsUsecase) Mentions(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чаты пользователя
+	chats, err := c.ChatsRepo.List(chatt.Filter{ParticipantID: in.SubjectID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Если пользователь не участвует в чатах, упоминаний нет
+	if len(chats) == 0 {
+		return Out{}, nil
+	}
+	chatIDs := make([]uuid.UUID, len(chats))
+	for i, chat := range chats {
+		chatIDs[i] = chat.ID
+	}
+
+	// Получить страницу сообщений
+	messages, err := c.Repo.List(messagee.Filter{
+		ChatIDs:         chatIDs,
+		MentionedUserID: in.SubjectID,
+		CreatedBefore:   in.Keyset.CreatedBefore,
+		Limit:           defaultPageSize,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Messages:   messages,
+		NextKeyset: nextKeyset(messages, defaultPageSize),
+	}, nil
+}
+
+func nextKeyset(messages []messagee.Message, pageSize int) Keyset {
+	if len(messages) < pageSize {
+		return Keyset{}
+	}
+
+	return Keyset{
+		CreatedBefore: messages[len(messages)-1].CreatedAt,
+	}
+}
@@ -0,0 +1,121 @@
+package mentions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_Mentions тестирует получение сообщений с упоминаниями пользователя
+func (suite *testSuite) Test_Messages_Mentions() {
+	suite.Run("без чатов вернется пустой результат", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		subjectID := uuid.New()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ParticipantID: subjectID}).Return(nil, nil).Once()
+
+		out, err := usecase.Mentions(In{SubjectID: subjectID})
+		suite.NoError(err)
+		suite.Zero(out)
+	})
+
+	suite.Run("возвращает сообщения с упоминаниями в чатах пользователя", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat1, chat2 := suite.RndChat(), suite.RndChat()
+		p := suite.AddRndParticipant(&chat1)
+		suite.AddParticipant(&chat2, p)
+		expectedMessages := suite.rndMentions(chat1, p.UserID, 3)
+		mockChatsRepo.EXPECT().List(chatt.Filter{ParticipantID: p.UserID}).
+			Return([]chatt.Chat{chat1, chat2}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ChatIDs:         []uuid.UUID{chat1.ID, chat2.ID},
+			MentionedUserID: p.UserID,
+			Limit:           defaultPageSize,
+		}).Return(expectedMessages, nil).Once()
+
+		out, err := usecase.Mentions(In{SubjectID: p.UserID})
+		suite.NoError(err)
+		suite.Equal(expectedMessages, out.Messages)
+		suite.Zero(out.NextKeyset)
+	})
+
+	suite.Run("возвращает keyset если страница заполнена", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		messages := suite.rndMentions(chat, p.UserID, defaultPageSize)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(messages, nil).Once()
+
+		out, err := usecase.Mentions(In{SubjectID: p.UserID})
+		suite.NoError(err)
+		suite.Equal(messages[len(messages)-1].CreatedAt, out.NextKeyset.CreatedBefore)
+	})
+
+	suite.Run("учитывает keyset при запросе", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		createdBefore := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ChatIDs:         []uuid.UUID{chat.ID},
+			MentionedUserID: chat.ChiefID,
+			CreatedBefore:   createdBefore,
+			Limit:           defaultPageSize,
+		}).Return(nil, nil).Once()
+
+		out, err := usecase.Mentions(In{
+			SubjectID: chat.ChiefID,
+			Keyset:    Keyset{CreatedBefore: createdBefore},
+		})
+		suite.NoError(err)
+		suite.Empty(out.Messages)
+		suite.Zero(out.NextKeyset)
+	})
+}
+
+func Test_MentionsInput_Validate(t *testing.T) {
+	assert.NoError(t, In{SubjectID: uuid.New()}.Validate())
+	assert.ErrorIs(t, In{}.Validate(), ErrInvalidSubjectID)
+}
+
+// rndMentions создает сообщения главного администратора с упоминанием пользователя в порядке убывания CreatedAt
+func (suite *testSuite) rndMentions(chat chatt.Chat, userID uuid.UUID, count int) []messagee.Message {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	messages := make([]messagee.Message, count)
+	for i := range messages {
+		message, err := messagee.NewMessageWithAttachments(chat, chat.ChiefID, "text", nil, []uuid.UUID{userID}, nil)
+		suite.Require().NoError(err)
+		message.CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+		messages[i] = message
+	}
+
+	return messages
+}
+
+func newUsecase(suite *testSuite) (*MentionsUsecase, *mockMessagee.Repository, *mockChatt.Repository) {
+	uc := &MentionsUsecase{
+		Repo:      suite.RR.Messages,
+		ChatsRepo: suite.RR.Chats,
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	return uc, mockRepo, mockChatsRepo
+}
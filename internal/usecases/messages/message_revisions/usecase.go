@@ -0,0 +1,83 @@
+package messageRevisions
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+var (
+	ErrInvalidSubjectID   = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID      = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID   = errors.New("некорректное значение MessageID")
+	ErrSubjectIsNotMember = errors.New("пользователь не является участником чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	return nil
+}
+
+// Out результат запроса истории версий сообщения
+type Out struct {
+	Revisions []messagee.Revision
+}
+
+type MessageRevisionsUsecase struct {
+	Repo      messagee.Repository
+	ChatsRepo chatt.Repository
+}
+
+// MessageRevisions возвращает предыдущие версии текста сообщения, начиная с самой старой.
+// Просматривать историю могут только участники чата
+func (c *MessageRevisionsUsecase) MessageRevisions(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Пользователь должен быть участником чата
+	if !chat.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMember
+	}
+
+	// Найти сообщение
+	message, err := messagee.Find(c.Repo, messagee.Filter{
+		ID:     in.MessageID,
+		ChatID: in.ChatID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Revisions: message.Revisions,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package messageRevisions
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_MessageRevisions тестирует получение истории версий сообщения
+func (suite *testSuite) Test_Messages_MessageRevisions() {
+	suite.Run("просматривать историю могут только участники чата", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+
+		out, err := usecase.MessageRevisions(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("сообщение должно существовать", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+
+		out, err := usecase.MessageRevisions(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, messagee.ErrMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("любой участник получит историю версий сообщения", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, chat.ChiefID)
+		suite.Require().NoError(message.Edit(chat, chat.ChiefID, "second", nil, nil))
+		suite.Require().NoError(message.Edit(chat, chat.ChiefID, "third", nil, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ID:     message.ID,
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{message}, nil).Once()
+
+		out, err := usecase.MessageRevisions(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(message.Revisions, out.Revisions)
+		suite.Len(out.Revisions, 2)
+	})
+}
+
+func newUsecase(suite *testSuite) (*MessageRevisionsUsecase, *mockMessagee.Repository, *mockChatt.Repository) {
+	uc := &MessageRevisionsUsecase{
+		Repo:      suite.RR.Messages,
+		ChatsRepo: suite.RR.Chats,
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	return uc, mockRepo, mockChatsRepo
+}
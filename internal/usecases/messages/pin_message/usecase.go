@@ -0,0 +1,120 @@
+package pinMessage
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID = errors.New("некорректное значение MessageID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	return nil
+}
+
+// Out результат закрепления сообщения
+type Out struct {
+	Pin chatt.Pin
+}
+
+type PinMessageUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	MaxPins       int // Максимальное количество закрепленных сообщений в чате
+	EventConsumer events.Consumer
+}
+
+// PinMessage закрепляет сообщение в чате.
+// Закреплять сообщения могут только участники с соответствующим правом
+func (c *PinMessageUsecase) PinMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	var pin chatt.Pin
+	err := c.ChatsRepo.InTransaction(func(txRepo chatt.Repository) error {
+		// Найти чат с блокировкой, чтобы не перезаписать параллельные изменения
+		chat, err := chatt.Find(txRepo, chatt.Filter{ID: in.ChatID})
+		if err != nil {
+			return err
+		}
+
+		// Закреплять сообщения могут только участники с соответствующим правом
+		if !chat.Can(in.SubjectID, chatt.PermissionPin) {
+			return chatt.ErrPermissionDenied
+		}
+
+		// Найти сообщение
+		message, err := messagee.Find(c.Repo, messagee.Filter{
+			ID:     in.MessageID,
+			ChatID: in.ChatID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Удаленное сообщение закрепить нельзя
+		if message.IsDeleted() {
+			return messagee.ErrMessageIsDeleted
+		}
+
+		// Закрепить сообщение
+		if err = chat.PinMessage(message.ID, in.SubjectID, c.maxPins(), eventsBuf); err != nil {
+			return err
+		}
+		pin = chat.Pins[len(chat.Pins)-1]
+
+		// Сохранить чат в репозиторий
+		return txRepo.Upsert(chat)
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Pin: pin,
+	}, nil
+}
+
+// maxPins возвращает лимит закрепленных сообщений, либо значение по умолчанию
+func (c *PinMessageUsecase) maxPins() int {
+	if c.MaxPins <= 0 {
+		return chatt.DefaultMaxPins
+	}
+
+	return c.MaxPins
+}
@@ -0,0 +1,163 @@
+package pinMessage
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_PinMessage тестирует закрепление сообщения
+func (suite *testSuite) Test_Messages_PinMessage() {
+	suite.Run("закреплять сообщения могут только участники с соответствующим правом", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Закрепить сообщение от имени участника
+		out, err := usecase.PinMessage(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+		suite.Zero(out)
+	})
+
+	suite.Run("сообщение должно существовать", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		// Закрепить несуществующее сообщение
+		out, err := usecase.PinMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, messagee.ErrMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("удаленное сообщение закрепить нельзя", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		suite.Require().NoError(message.Delete(chat, chat.ChiefID, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		// Закрепить удаленное сообщение
+		out, err := usecase.PinMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.ErrorIs(err, messagee.ErrMessageIsDeleted)
+		suite.Zero(out)
+	})
+
+	suite.Run("количество закрепленных сообщений ограничено настройкой", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		usecase.MaxPins = 1
+		chat := suite.RndChat()
+		suite.Require().NoError(chat.PinMessage(uuid.New(), chat.ChiefID, usecase.MaxPins, nil))
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		// Закрепить сообщение сверх лимита
+		out, err := usecase.PinMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.ErrorIs(err, chatt.ErrTooManyPins)
+		suite.Zero(out)
+	})
+
+	suite.Run("закрепление сохранится в чате", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ID:     message.ID,
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{message}, nil).Once()
+		var savedChat chatt.Chat
+		mockChatsRepo.EXPECT().Upsert(mock.Anything).Run(func(chat chatt.Chat) {
+			savedChat = chat
+		}).Return(nil).Once()
+		// Закрепить сообщение
+		out, err := usecase.PinMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(message.ID, out.Pin.MessageID)
+		suite.Equal(chat.ChiefID, out.Pin.PinnedBy)
+		suite.Equal([]chatt.Pin{out.Pin}, savedChat.Pins)
+	})
+
+	suite.Run("после завершения операции, будут созданы события", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockChatsRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		// Закрепить сообщение
+		_, err := usecase.PinMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventMessagePinned)
+	})
+}
+
+func newUsecase(suite *testSuite) (*PinMessageUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &PinMessageUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockChatsRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(chatt.Repository) error) error {
+		return fn(mockChatsRepo)
+	}).Maybe()
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
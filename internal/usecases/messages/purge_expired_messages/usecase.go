@@ -0,0 +1,273 @@
+package purgeExpiredMessages
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// DefaultLimit количество сообщений, удаляемых за один вызов, если лимит не указан
+const DefaultLimit = 500
+
+var ErrInvalidNow = errors.New("некорректное значение Now")
+
+// In входящие параметры
+type In struct {
+	Now   time.Time // Момент, на который проверяется срок хранения сообщений
+	Limit int       // Максимальное количество истекших сообщений за вызов, не считая ответов в тредах
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if in.Now.IsZero() {
+		return ErrInvalidNow
+	}
+
+	return nil
+}
+
+// Out результат удаления истекших сообщений
+type Out struct {
+	Purged int // Количество удаленных сообщений вместе с ответами в тредах
+}
+
+type PurgeExpiredMessagesUsecase struct {
+	Repo            messagee.Repository
+	ChatsRepo       chatt.Repository
+	AttachmentsRepo attachmentt.Repository
+	Storage         attachmentt.Storage
+	EventConsumer   events.Consumer
+}
+
+// PurgeExpiredMessages безвозвратно удаляет сообщения, срок хранения которых истек:
+// самоуничтожающиеся сообщения и сообщения старше срока хранения своего чата.
+// Вместе с корневым сообщением удаляются ответы в его треде, а вместе с сообщениями - их вложения.
+// Участники чата получают событие об удалении сообщений
+func (c *PurgeExpiredMessagesUsecase) PurgeExpiredMessages(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+	if in.Limit <= 0 {
+		in.Limit = DefaultLimit
+	}
+
+	// Найти истекшие сообщения
+	expired, err := c.findExpired(in.Now, in.Limit)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сгруппировать сообщения по чатам без повторов
+	var chatIDs []uuid.UUID
+	byChat := make(map[uuid.UUID][]messagee.Message)
+	seen := make(map[uuid.UUID]bool, len(expired))
+	for _, m := range expired {
+		if seen[m.ID] {
+			continue
+		}
+		seen[m.ID] = true
+		if _, ok := byChat[m.ChatID]; !ok {
+			chatIDs = append(chatIDs, m.ChatID)
+		}
+		byChat[m.ChatID] = append(byChat[m.ChatID], m)
+	}
+
+	var out Out
+	for _, chatID := range chatIDs {
+		purged, err := c.purgeInChat(chatID, byChat[chatID])
+		if err != nil {
+			return out, err
+		}
+		out.Purged += purged
+	}
+
+	return out, nil
+}
+
+// findExpired возвращает не больше limit сообщений, срок хранения которых истек к моменту now
+func (c *PurgeExpiredMessagesUsecase) findExpired(now time.Time, limit int) ([]messagee.Message, error) {
+	// Найти сообщения с наступившим временем самоуничтожения
+	expired, err := c.Repo.List(messagee.Filter{
+		ExpiresBefore: now,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Найти чаты с ограниченным сроком хранения
+	chats, err := c.ChatsRepo.List(chatt.Filter{WithRetentionMaxAge: true})
+	if err != nil {
+		return nil, err
+	}
+
+	// Найти сообщения старше срока хранения каждого из чатов
+	for _, chat := range chats {
+		if len(expired) >= limit {
+			break
+		}
+		old, err := c.Repo.List(messagee.Filter{
+			ChatID:        chat.ID,
+			CreatedBefore: chat.RetentionCutoff(now),
+			Limit:         limit - len(expired),
+		})
+		if err != nil {
+			return nil, err
+		}
+		expired = append(expired, old...)
+	}
+
+	return expired, nil
+}
+
+// purgeInChat удаляет истекшие сообщения чата вместе с ответами в их тредах и вложениями
+// и возвращает количество удаленных сообщений
+func (c *PurgeExpiredMessagesUsecase) purgeInChat(chatID uuid.UUID, messages []messagee.Message) (int, error) {
+	// Собрать удаляемые сообщения вместе с ответами в тредах
+	purged := slices.Clone(messages)
+	ids := make([]uuid.UUID, 0, len(messages))
+	for _, m := range messages {
+		ids = append(ids, m.ID)
+	}
+	for _, m := range messages {
+		if m.ReplyCount == 0 {
+			continue
+		}
+		replies, err := c.Repo.List(messagee.Filter{ParentID: m.ID})
+		if err != nil {
+			return 0, err
+		}
+		for _, reply := range replies {
+			if !slices.Contains(ids, reply.ID) {
+				ids = append(ids, reply.ID)
+				purged = append(purged, reply)
+			}
+		}
+	}
+
+	// Собрать вложения удаляемых сообщений и количество удаляемых ответов в уцелевших тредах
+	var attachmentIDs, parentIDs []uuid.UUID
+	removedReplies := make(map[uuid.UUID]int)
+	for _, m := range purged {
+		attachmentIDs = append(attachmentIDs, m.AttachmentIDs...)
+		if !m.IsReply() || slices.Contains(ids, m.ParentID) {
+			continue
+		}
+		if removedReplies[m.ParentID] == 0 {
+			parentIDs = append(parentIDs, m.ParentID)
+		}
+		removedReplies[m.ParentID]++
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Открепить удаляемые сообщения в перечитанном с блокировкой чате
+	var chat chatt.Chat
+	err := c.ChatsRepo.InTransaction(func(txRepo chatt.Repository) error {
+		var err error
+		if chat, err = chatt.Find(txRepo, chatt.Filter{ID: chatID}); err != nil {
+			return err
+		}
+
+		var unpinned bool
+		for _, id := range ids {
+			if !chat.HasPin(id) {
+				continue
+			}
+			if err = chat.UnpinMessage(id, eventsBuf); err != nil {
+				return err
+			}
+			unpinned = true
+		}
+		if !unpinned {
+			return nil
+		}
+
+		// Сохранить чат в репозиторий
+		return txRepo.Upsert(chat)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Удалить сообщения и обновить счетчики ответов в уцелевших тредах
+	err = c.Repo.InTransaction(func(txRepo messagee.Repository) error {
+		if err := txRepo.Delete(ids); err != nil {
+			return err
+		}
+
+		for _, parentID := range parentIDs {
+			// Найти корневое сообщение с блокировкой
+			parent, err := messagee.Find(txRepo, messagee.Filter{ID: parentID})
+			if errors.Is(err, messagee.ErrMessageNotExists) {
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			parent.RemoveReplies(chat, removedReplies[parentID], eventsBuf)
+			if err = txRepo.Upsert(parent); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Удалить вложения, которые больше не используются
+	if err = c.purgeAttachments(attachmentIDs); err != nil {
+		return 0, err
+	}
+
+	// Добавить событие
+	eventsBuf.AddSafety(messagee.NewEventMessagesExpired(chat, ids))
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return len(ids), nil
+}
+
+// purgeAttachments удаляет вложения, на которые больше не ссылаются сообщения, и их содержимое
+func (c *PurgeExpiredMessagesUsecase) purgeAttachments(ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Запомнить вложения, чтобы после удаления записей очистить хранилище
+	attachments, err := c.AttachmentsRepo.List(attachmentt.Filter{IDs: ids})
+	if err != nil {
+		return err
+	}
+
+	// Удалить записи вложений, используемые в других сообщениях останутся
+	if err = c.AttachmentsRepo.Delete(ids); err != nil {
+		return err
+	}
+	remaining, err := c.AttachmentsRepo.List(attachmentt.Filter{IDs: ids})
+	if err != nil {
+		return err
+	}
+
+	// Удалить содержимое удаленных вложений из хранилища.
+	// Записи уже удалены, поэтому ошибки хранилища не отменяют операцию, а оставляют содержимое без ссылок
+	for _, attachment := range attachments {
+		if !slices.ContainsFunc(remaining, func(a attachmentt.Attachment) bool { return a.ID == attachment.ID }) {
+			_ = c.Storage.Delete(attachment.StorageKey)
+		}
+	}
+
+	return nil
+}
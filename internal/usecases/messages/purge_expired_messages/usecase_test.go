@@ -0,0 +1,196 @@
+package purgeExpiredMessages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	mockAttachmentt "github.com/nice-pea/npchat/internal/domain/attachmentt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_PurgeExpiredMessages тестирует удаление истекших сообщений
+func (suite *testSuite) Test_Messages_PurgeExpiredMessages() {
+	suite.Run("Now должен быть указан", func() {
+		// Создать usecase и моки
+		usecase, _, _, _ := newUsecase(suite)
+		// Удалить сообщения
+		out, err := usecase.PurgeExpiredMessages(In{})
+		suite.ErrorIs(err, ErrInvalidNow)
+		suite.Zero(out)
+	})
+
+	suite.Run("если истекших сообщений нет, ничего не удалится", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		now := time.Now()
+		mockRepo.EXPECT().List(messagee.Filter{ExpiresBefore: now, Limit: DefaultLimit}).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{WithRetentionMaxAge: true}).Return(nil, nil).Once()
+		// Удалить сообщения
+		out, err := usecase.PurgeExpiredMessages(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Zero(out)
+	})
+
+	suite.Run("самоуничтожившиеся сообщения будут удалены и будут созданы события", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		suite.Require().NoError(chat.SetRetention(chatt.Retention{SelfDestruct: time.Minute}, nil))
+		message := suite.NewMessage(chat, chat.ChiefID)
+		now := message.ExpiresAt
+		mockRepo.EXPECT().List(messagee.Filter{ExpiresBefore: now, Limit: DefaultLimit}).Return([]messagee.Message{message}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{WithRetentionMaxAge: true}).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().Delete([]uuid.UUID{message.ID}).Return(nil).Once()
+		// Удалить сообщения
+		out, err := usecase.PurgeExpiredMessages(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Equal(1, out.Purged)
+
+		// Проверить список опубликованных событий
+		suite.Require().Len(consumedEvents, 1)
+		suite.Equal(messagee.EventMessagesExpired, consumedEvents[0].Type)
+		suite.ElementsMatch(chat.ParticipantIDs(), consumedEvents[0].Recipients)
+	})
+
+	suite.Run("сообщения старше срока хранения удалятся вместе с ответами и закреплениями", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		suite.Require().NoError(chat.SetRetention(chatt.Retention{MaxAge: 24 * time.Hour}, nil))
+		parent := suite.NewMessage(chat, chat.ChiefID)
+		reply, err := messagee.NewReply(chat, &parent, chat.ChiefID, "reply", nil)
+		suite.Require().NoError(err)
+		suite.Require().NoError(chat.PinMessage(parent.ID, chat.ChiefID, chatt.DefaultMaxPins, nil))
+		now := parent.CreatedAt.Add(48 * time.Hour)
+		mockRepo.EXPECT().List(messagee.Filter{ExpiresBefore: now, Limit: DefaultLimit}).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{WithRetentionMaxAge: true}).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ChatID:        chat.ID,
+			CreatedBefore: now.Add(-24 * time.Hour),
+			Limit:         DefaultLimit,
+		}).Return([]messagee.Message{parent}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{ParentID: parent.ID}).Return([]messagee.Message{reply}, nil).Once()
+		mockRepo.EXPECT().Delete([]uuid.UUID{parent.ID, reply.ID}).Return(nil).Once()
+		mockChatsRepo.EXPECT().Upsert(mock.Anything).Run(func(chat chatt.Chat) {
+			suite.False(chat.HasPin(parent.ID))
+		}).Return(nil).Once()
+		// Удалить сообщения
+		out, err := usecase.PurgeExpiredMessages(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Equal(2, out.Purged)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventMessageUnpinned)
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessagesExpired)
+	})
+
+	suite.Run("у корневого сообщения уменьшится счетчик удаленных ответов", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		parent := suite.NewMessage(chat, chat.ChiefID)
+		suite.Require().NoError(chat.SetRetention(chatt.Retention{SelfDestruct: time.Minute}, nil))
+		reply, err := messagee.NewReply(chat, &parent, chat.ChiefID, "reply", nil)
+		suite.Require().NoError(err)
+		now := reply.ExpiresAt
+		mockRepo.EXPECT().List(messagee.Filter{ExpiresBefore: now, Limit: DefaultLimit}).Return([]messagee.Message{reply}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{WithRetentionMaxAge: true}).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().Delete([]uuid.UUID{reply.ID}).Return(nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{ID: parent.ID}).Return([]messagee.Message{parent}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(m messagee.Message) {
+			suite.Equal(parent.ID, m.ID)
+			suite.Zero(m.ReplyCount)
+		}).Return(nil).Once()
+		// Удалить сообщения
+		out, err := usecase.PurgeExpiredMessages(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Equal(1, out.Purged)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventThreadUpdated)
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessagesExpired)
+	})
+
+	suite.Run("вложения удалятся вместе с содержимым, если не используются в других сообщениях", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		mockAttachmentsRepo := usecase.AttachmentsRepo.(*mockAttachmentt.Repository)
+		mockStorage := usecase.Storage.(*mockAttachmentt.Storage)
+		chat := suite.RndChat()
+		suite.Require().NoError(chat.SetRetention(chatt.Retention{SelfDestruct: time.Minute}, nil))
+		unused := suite.NewAttachment(chat, chat.ChiefID)
+		used := suite.NewAttachment(chat, chat.ChiefID)
+		message, err := messagee.NewMessageWithAttachments(chat, chat.ChiefID, "", []attachmentt.Attachment{unused, used}, nil, nil)
+		suite.Require().NoError(err)
+		now := message.ExpiresAt
+		mockRepo.EXPECT().List(messagee.Filter{ExpiresBefore: now, Limit: DefaultLimit}).Return([]messagee.Message{message}, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{WithRetentionMaxAge: true}).Return(nil, nil).Once()
+		mockChatsRepo.EXPECT().List(chatt.Filter{ID: chat.ID}).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().Delete([]uuid.UUID{message.ID}).Return(nil).Once()
+		attachmentsFilter := attachmentt.Filter{IDs: []uuid.UUID{unused.ID, used.ID}}
+		mockAttachmentsRepo.EXPECT().List(attachmentsFilter).Return([]attachmentt.Attachment{unused, used}, nil).Once()
+		mockAttachmentsRepo.EXPECT().Delete([]uuid.UUID{unused.ID, used.ID}).Return(nil).Once()
+		// Вложение used осталось, так как используется в другом сообщении
+		mockAttachmentsRepo.EXPECT().List(attachmentsFilter).Return([]attachmentt.Attachment{used}, nil).Once()
+		mockStorage.EXPECT().Delete(unused.StorageKey).Return(nil).Once()
+		// Удалить сообщения
+		out, err := usecase.PurgeExpiredMessages(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Equal(1, out.Purged)
+	})
+}
+
+func newUsecase(suite *testSuite) (*PurgeExpiredMessagesUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &PurgeExpiredMessagesUsecase{
+		Repo:            suite.RR.Messages,
+		ChatsRepo:       suite.RR.Chats,
+		AttachmentsRepo: suite.RR.Attachments,
+		Storage:         suite.Adapters.BlobStorage,
+		EventConsumer:   mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(messagee.Repository) error) error {
+		return fn(mockRepo)
+	}).Maybe()
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockChatsRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(chatt.Repository) error) error {
+		return fn(mockChatsRepo)
+	}).Maybe()
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
@@ -0,0 +1,98 @@
+package removeReaction
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID = errors.New("некорректное значение MessageID")
+	ErrInvalidEmoji     = errors.New("некорректное значение Emoji")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+	Emoji     string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+	if err := messagee.ValidateEmoji(in.Emoji); err != nil {
+		return errors.Join(err, ErrInvalidEmoji)
+	}
+
+	return nil
+}
+
+// Out результат удаления реакции
+type Out struct {
+	Reactions []messagee.ReactionSummary
+}
+
+type RemoveReactionUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// RemoveReaction удаляет реакцию пользователя с сообщения.
+// Удалить можно только собственную реакцию
+func (c *RemoveReactionUsecase) RemoveReaction(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти сообщение
+	message, err := messagee.Find(c.Repo, messagee.Filter{
+		ID:     in.MessageID,
+		ChatID: in.ChatID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Удалить реакцию с сообщения
+	if err = message.RemoveReaction(chat, in.SubjectID, in.Emoji, eventsBuf); err != nil {
+		return Out{}, err
+	}
+	if err = c.Repo.Upsert(message); err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Reactions: message.ReactionSummaries(in.SubjectID),
+	}, nil
+}
@@ -0,0 +1,115 @@
+package removeReaction
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_RemoveReaction тестирует удаление реакции
+func (suite *testSuite) Test_Messages_RemoveReaction() {
+	suite.Run("реакция должна существовать", func() {
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		message := suite.NewMessage(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+
+		out, err := usecase.RemoveReaction(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Emoji:     "👍",
+		})
+		suite.ErrorIs(err, messagee.ErrReactionNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("нельзя удалить чужую реакцию", func() {
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, chat.ChiefID)
+		suite.addReaction(chat, &message, p.UserID, "👍")
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+
+		out, err := usecase.RemoveReaction(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Emoji:     "👍",
+		})
+		suite.ErrorIs(err, messagee.ErrReactionNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("реакция удалится и пропадет из сводки", func() {
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewMessage(chat, chat.ChiefID)
+		suite.addReaction(chat, &message, p.UserID, "👍")
+		suite.addReaction(chat, &message, chat.ChiefID, "👍")
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(updated messagee.Message) {
+			suite.False(updated.HasReaction(chat.ChiefID, "👍"))
+		}).Return(nil).Once()
+
+		out, err := usecase.RemoveReaction(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+			Emoji:     "👍",
+		})
+		suite.Require().NoError(err)
+		suite.Equal([]messagee.ReactionSummary{
+			{Emoji: "👍", Count: 1, Reacted: false},
+		}, out.Reactions)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventReactionRemoved)
+	})
+}
+
+// addReaction добавляет реакцию пользователя на сообщение
+func (suite *testSuite) addReaction(chat chatt.Chat, message *messagee.Message, userID uuid.UUID, emoji string) {
+	reaction, err := messagee.NewReaction(userID, emoji)
+	suite.Require().NoError(err)
+	suite.Require().NoError(message.AddReaction(chat, reaction, nil))
+}
+
+func newUsecase(suite *testSuite) (*RemoveReactionUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &RemoveReactionUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
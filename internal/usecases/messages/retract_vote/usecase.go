@@ -0,0 +1,99 @@
+package retractVote
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID = errors.New("некорректное значение MessageID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	return nil
+}
+
+// Out результат отзыва голоса
+type Out struct {
+	Results messagee.PollResults
+}
+
+type RetractVoteUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// RetractVote отзывает все голоса пользователя в опросе.
+// Отозвать голос могут только участники чата до завершения опроса
+func (c *RetractVoteUsecase) RetractVote(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Отозвать голос в транзакции, чтобы параллельные голоса не перезаписали друг друга
+	var message messagee.Message
+	err = c.Repo.InTransaction(func(txRepo messagee.Repository) error {
+		// Найти сообщение с опросом
+		message, err = messagee.Find(txRepo, messagee.Filter{
+			ID:     in.MessageID,
+			ChatID: in.ChatID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Отозвать голос
+		if err = message.RetractVote(chat, in.SubjectID, eventsBuf); err != nil {
+			return err
+		}
+
+		return txRepo.Upsert(message)
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Results: message.Poll.Results(in.SubjectID),
+	}, nil
+}
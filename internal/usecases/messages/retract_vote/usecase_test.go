@@ -0,0 +1,106 @@
+package retractVote
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_RetractVote тестирует отзыв голоса в опросе
+func (suite *testSuite) Test_Messages_RetractVote() {
+	suite.Run("SubjectID должен быть валидным", func() {
+		usecase, _, _, _ := newUsecase(suite)
+		out, err := usecase.RetractVote(In{
+			ChatID:    uuid.New(),
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		suite.Zero(out)
+	})
+
+	suite.Run("нельзя отозвать несуществующий голос", func() {
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		message := suite.NewPollMessage(chat, chat.ChiefID, false)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(messagee.Repository) error) error {
+			return fn(mockRepo)
+		}).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{message}, nil).Once()
+
+		out, err := usecase.RetractVote(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.ErrorIs(err, messagee.ErrVoteNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("голос удалится из итогов", func() {
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		message := suite.NewPollMessage(chat, chat.ChiefID, false)
+		suite.Require().NoError(message.Vote(chat, p.UserID, []int{1}, nil))
+		suite.Require().NoError(message.Vote(chat, chat.ChiefID, []int{1}, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(messagee.Repository) error) error {
+			return fn(mockRepo)
+		}).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ID:     message.ID,
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{message}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(updated messagee.Message) {
+			suite.Len(updated.Poll.Votes, 1)
+		}).Return(nil).Once()
+
+		out, err := usecase.RetractVote(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			MessageID: message.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(1, out.Results.TotalVoters)
+		suite.Equal(1, out.Results.Options[1].Count)
+		suite.False(out.Results.Options[1].Voted)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventPollUpdated)
+	})
+}
+
+func newUsecase(suite *testSuite) (*RetractVoteUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &RetractVoteUsecase{
+		Repo:          suite.RR.Messages,
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
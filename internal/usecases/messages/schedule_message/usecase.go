@@ -0,0 +1,120 @@
+package scheduleMessage
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidText      = errors.New("некорректное значение Text")
+	ErrInvalidParentID  = errors.New("некорректное значение ParentID")
+	ErrInvalidSendAt    = errors.New("некорректное значение SendAt")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	Text      string
+	ParentID  uuid.UUID // ID корневого сообщения, если сообщение будет отправлено в тред
+	SendAt    time.Time // Время отправки сообщения
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := messagee.ValidateMessageText(in.Text); err != nil {
+		return errors.Join(err, ErrInvalidText)
+	}
+	if in.ParentID != uuid.Nil {
+		if err := domain.ValidateID(in.ParentID); err != nil {
+			return errors.Join(err, ErrInvalidParentID)
+		}
+	}
+	if in.SendAt.IsZero() {
+		return ErrInvalidSendAt
+	}
+
+	return nil
+}
+
+// Out результат планирования сообщения
+type Out struct {
+	ScheduledMessage schedulee.ScheduledMessage
+}
+
+type ScheduleMessageUsecase struct {
+	Repo         schedulee.Repository
+	ChatsRepo    chatt.Repository
+	MessagesRepo messagee.Repository
+}
+
+// ScheduleMessage планирует отправку сообщения в чат на указанное время.
+// Планировать сообщения могут только участники чата
+func (c *ScheduleMessageUsecase) ScheduleMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Создать отложенное сообщение
+	scheduled, err := schedulee.NewScheduledMessage(chat, in.SubjectID, in.Text, in.ParentID, in.SendAt)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Проверить, что в тред можно будет ответить
+	if in.ParentID != uuid.Nil {
+		if err = c.checkParent(chat, in.ParentID); err != nil {
+			return Out{}, err
+		}
+	}
+
+	// Сохранить отложенное сообщение в репозиторий
+	if err = c.Repo.Upsert(scheduled); err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		ScheduledMessage: scheduled,
+	}, nil
+}
+
+// checkParent проверяет, что корневое сообщение существует и принимает ответы
+func (c *ScheduleMessageUsecase) checkParent(chat chatt.Chat, parentID uuid.UUID) error {
+	parent, err := messagee.Find(c.MessagesRepo, messagee.Filter{
+		ID:     parentID,
+		ChatID: chat.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if parent.IsReply() {
+		return messagee.ErrCannotReplyToReply
+	}
+	if parent.IsDeleted() {
+		return messagee.ErrMessageIsDeleted
+	}
+
+	return nil
+}
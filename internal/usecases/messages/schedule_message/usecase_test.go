@@ -0,0 +1,131 @@
+package scheduleMessage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+	mockSchedulee "github.com/nice-pea/npchat/internal/domain/schedulee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_ScheduleMessage тестирует планирование сообщения
+func (suite *testSuite) Test_Messages_ScheduleMessage() {
+	suite.Run("время отправки должно быть указано", func() {
+		// Создать usecase и моки
+		usecase, _, _, _ := newUsecase(suite)
+		// Запланировать сообщение
+		out, err := usecase.ScheduleMessage(In{
+			SubjectID: uuid.New(),
+			ChatID:    uuid.New(),
+			Text:      "text",
+		})
+		suite.ErrorIs(err, ErrInvalidSendAt)
+		suite.Zero(out)
+	})
+
+	suite.Run("время отправки не может быть в прошлом", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Запланировать сообщение
+		out, err := usecase.ScheduleMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Text:      "text",
+			SendAt:    time.Now().Add(-time.Minute),
+		})
+		suite.ErrorIs(err, schedulee.ErrSendAtInPast)
+		suite.Zero(out)
+	})
+
+	suite.Run("планировать сообщения могут только участники чата", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Запланировать сообщение
+		out, err := usecase.ScheduleMessage(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+			Text:      "text",
+			SendAt:    time.Now().Add(time.Hour),
+		})
+		suite.ErrorIs(err, schedulee.ErrAuthorIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("ответ можно запланировать только на корневое сообщение", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, mockMessagesRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		parent := suite.NewMessage(chat, chat.ChiefID)
+		parent.ParentID = uuid.New()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockMessagesRepo.EXPECT().List(messagee.Filter{ID: parent.ID, ChatID: chat.ID}).Return([]messagee.Message{parent}, nil).Once()
+		// Запланировать ответ
+		out, err := usecase.ScheduleMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Text:      "text",
+			ParentID:  parent.ID,
+			SendAt:    time.Now().Add(time.Hour),
+		})
+		suite.ErrorIs(err, messagee.ErrCannotReplyToReply)
+		suite.Zero(out)
+	})
+
+	suite.Run("отложенное сообщение будет сохранено", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		sendAt := time.Now().Add(time.Hour)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		var saved schedulee.ScheduledMessage
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(s schedulee.ScheduledMessage) {
+			saved = s
+		}).Return(nil).Once()
+		// Запланировать сообщение
+		out, err := usecase.ScheduleMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Text:      "text",
+			SendAt:    sendAt,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(saved, out.ScheduledMessage)
+		suite.Equal(chat.ID, out.ScheduledMessage.ChatID)
+		suite.Equal(chat.ChiefID, out.ScheduledMessage.AuthorID)
+		suite.True(out.ScheduledMessage.SendAt.Equal(sendAt.Truncate(time.Microsecond)))
+		suite.True(out.ScheduledMessage.IsPending())
+	})
+}
+
+func newUsecase(suite *testSuite) (*ScheduleMessageUsecase, *mockSchedulee.Repository, *mockChatt.Repository, *mockMessagee.Repository) {
+	uc := &ScheduleMessageUsecase{
+		Repo:         suite.RR.Scheduled,
+		ChatsRepo:    suite.RR.Chats,
+		MessagesRepo: suite.RR.Messages,
+	}
+	mockRepo := uc.Repo.(*mockSchedulee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockMessagesRepo := uc.MessagesRepo.(*mockMessagee.Repository)
+	return uc, mockRepo, mockChatsRepo, mockMessagesRepo
+}
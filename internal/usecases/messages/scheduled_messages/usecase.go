@@ -0,0 +1,67 @@
+package scheduledMessages
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID // Необязательный фильтр по чату
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if in.ChatID != uuid.Nil {
+		if err := domain.ValidateID(in.ChatID); err != nil {
+			return errors.Join(err, ErrInvalidChatID)
+		}
+	}
+
+	return nil
+}
+
+// Out результат запроса отложенных сообщений
+type Out struct {
+	ScheduledMessages []schedulee.ScheduledMessage // Ожидающие отправки сообщения в порядке времени отправки
+}
+
+type ScheduledMessagesUsecase struct {
+	Repo schedulee.Repository
+}
+
+// ScheduledMessages возвращает ожидающие отправки сообщения пользователя.
+// Пользователь видит только запланированные им сообщения
+func (c *ScheduledMessagesUsecase) ScheduledMessages(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти отложенные сообщения пользователя
+	scheduled, err := c.Repo.List(schedulee.Filter{
+		AuthorID:    in.SubjectID,
+		ChatID:      in.ChatID,
+		PendingOnly: true,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		ScheduledMessages: scheduled,
+	}, nil
+}
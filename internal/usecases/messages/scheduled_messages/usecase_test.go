@@ -0,0 +1,76 @@
+package scheduledMessages
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
+	mockSchedulee "github.com/nice-pea/npchat/internal/domain/schedulee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_ScheduledMessages тестирует получение отложенных сообщений
+func (suite *testSuite) Test_Messages_ScheduledMessages() {
+	suite.Run("SubjectID должен быть валидным", func() {
+		// Создать usecase и моки
+		usecase, _ := newUsecase(suite)
+		// Получить отложенные сообщения
+		out, err := usecase.ScheduledMessages(In{})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		suite.Zero(out)
+	})
+
+	suite.Run("вернутся ожидающие отправки сообщения пользователя в чате", func() {
+		// Создать usecase и моки
+		usecase, mockRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		expected := []schedulee.ScheduledMessage{
+			suite.NewScheduledMessage(chat, chat.ChiefID),
+			suite.NewScheduledMessage(chat, chat.ChiefID),
+		}
+		mockRepo.EXPECT().List(schedulee.Filter{
+			AuthorID:    chat.ChiefID,
+			ChatID:      chat.ID,
+			PendingOnly: true,
+		}).Return(expected, nil).Once()
+		// Получить отложенные сообщения
+		out, err := usecase.ScheduledMessages(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(expected, out.ScheduledMessages)
+	})
+
+	suite.Run("без ChatID вернутся сообщения пользователя во всех чатах", func() {
+		// Создать usecase и моки
+		usecase, mockRepo := newUsecase(suite)
+		subjectID := uuid.New()
+		mockRepo.EXPECT().List(schedulee.Filter{
+			AuthorID:    subjectID,
+			PendingOnly: true,
+		}).Return(nil, nil).Once()
+		// Получить отложенные сообщения
+		out, err := usecase.ScheduledMessages(In{SubjectID: subjectID})
+		suite.Require().NoError(err)
+		suite.Empty(out.ScheduledMessages)
+	})
+}
+
+func newUsecase(suite *testSuite) (*ScheduledMessagesUsecase, *mockSchedulee.Repository) {
+	uc := &ScheduledMessagesUsecase{
+		Repo: suite.RR.Scheduled,
+	}
+	mockRepo := uc.Repo.(*mockSchedulee.Repository)
+	return uc, mockRepo
+}
@@ -0,0 +1,150 @@
+package searchMessages
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+var (
+	ErrInvalidSubjectID   = errors.New("некорректное значение SubjectID")
+	ErrQueryIsRequired    = errors.New("поисковый запрос обязателен")
+	ErrQueryTooLong       = errors.New("поисковый запрос слишком длинный")
+	ErrInvalidDateRange   = errors.New("начало периода должно быть раньше его окончания")
+	ErrSubjectIsNotMember = errors.New("пользователь не является участником чата")
+)
+
+// QueryMaxLen максимальная длина поискового запроса
+const QueryMaxLen = 256
+
+// In входящие параметры
+type In struct {
+	SubjectID     uuid.UUID
+	Query         string
+	ChatID        uuid.UUID // Искать только в этом чате (необязательно)
+	AuthorID      uuid.UUID // Искать только сообщения этого автора (необязательно)
+	CreatedAfter  time.Time // Начало периода (необязательно)
+	CreatedBefore time.Time // Окончание периода (необязательно)
+	Keyset        Keyset
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if strings.TrimSpace(in.Query) == "" {
+		return ErrQueryIsRequired
+	}
+	if utf8.RuneCountInString(in.Query) > QueryMaxLen {
+		return ErrQueryTooLong
+	}
+	if !in.CreatedAfter.IsZero() && !in.CreatedBefore.IsZero() && !in.CreatedAfter.Before(in.CreatedBefore) {
+		return ErrInvalidDateRange
+	}
+
+	return nil
+}
+
+// Out результат поиска сообщений
+type Out struct {
+	Results    []messagee.SearchResult
+	NextKeyset Keyset
+}
+
+type Keyset struct {
+	Rank      float32
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+type SearchMessagesUsecase struct {
+	Repo      messagee.Repository
+	ChatsRepo chatt.Repository
+}
+
+const defaultPageSize = 20
+
+// SearchMessages выполняет полнотекстовый поиск по сообщениям чатов, в которых участвует пользователь.
+// Результаты упорядочены по убыванию релевантности
+func (c *SearchMessagesUsecase) SearchMessages(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чаты пользователя
+	chats, err := c.ChatsRepo.List(chatt.Filter{ParticipantID: in.SubjectID})
+	if err != nil {
+		return Out{}, err
+	}
+	chatIDs := make([]uuid.UUID, len(chats))
+	for i, chat := range chats {
+		chatIDs[i] = chat.ID
+	}
+
+	// Ограничить поиск одним чатом, если он указан
+	if in.ChatID != uuid.Nil {
+		if !slices.Contains(chatIDs, in.ChatID) {
+			return Out{}, ErrSubjectIsNotMember
+		}
+		chatIDs = []uuid.UUID{in.ChatID}
+	}
+
+	// Если пользователь не участвует в чатах, искать негде
+	if len(chatIDs) == 0 {
+		return Out{}, nil
+	}
+
+	// Выполнить поиск
+	results, err := c.Repo.Search(messagee.SearchFilter{
+		Query:         in.Query,
+		ChatIDs:       chatIDs,
+		AuthorID:      in.AuthorID,
+		CreatedAfter:  in.CreatedAfter,
+		CreatedBefore: in.CreatedBefore,
+		After:         searchCursor(in.Keyset),
+		Limit:         defaultPageSize,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Results:    results,
+		NextKeyset: nextKeyset(results, defaultPageSize),
+	}, nil
+}
+
+func searchCursor(keyset Keyset) *messagee.SearchCursor {
+	if keyset == (Keyset{}) {
+		return nil
+	}
+
+	return &messagee.SearchCursor{
+		Rank:      keyset.Rank,
+		CreatedAt: keyset.CreatedAt,
+		ID:        keyset.ID,
+	}
+}
+
+func nextKeyset(results []messagee.SearchResult, pageSize int) Keyset {
+	if len(results) < pageSize {
+		return Keyset{}
+	}
+
+	cursor := results[len(results)-1].Cursor()
+	return Keyset{
+		Rank:      cursor.Rank,
+		CreatedAt: cursor.CreatedAt,
+		ID:        cursor.ID,
+	}
+}
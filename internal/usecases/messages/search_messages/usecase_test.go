@@ -0,0 +1,186 @@
+package searchMessages
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_SearchMessages тестирует полнотекстовый поиск сообщений
+func (suite *testSuite) Test_Messages_SearchMessages() {
+	suite.Run("поисковый запрос обязателен", func() {
+		usecase, _, _ := newUsecase(suite)
+		out, err := usecase.SearchMessages(In{
+			SubjectID: uuid.New(),
+			Query:     "   ",
+		})
+		suite.ErrorIs(err, ErrQueryIsRequired)
+		suite.Zero(out)
+	})
+
+	suite.Run("поисковый запрос ограничен по длине", func() {
+		usecase, _, _ := newUsecase(suite)
+		out, err := usecase.SearchMessages(In{
+			SubjectID: uuid.New(),
+			Query:     strings.Repeat("я", QueryMaxLen+1),
+		})
+		suite.ErrorIs(err, ErrQueryTooLong)
+		suite.Zero(out)
+	})
+
+	suite.Run("начало периода должно быть раньше окончания", func() {
+		usecase, _, _ := newUsecase(suite)
+		now := time.Now()
+		out, err := usecase.SearchMessages(In{
+			SubjectID:     uuid.New(),
+			Query:         "релиз",
+			CreatedAfter:  now,
+			CreatedBefore: now.Add(-time.Hour),
+		})
+		suite.ErrorIs(err, ErrInvalidDateRange)
+		suite.Zero(out)
+	})
+
+	suite.Run("нельзя искать в чате, в котором пользователь не участвует", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		out, err := usecase.SearchMessages(In{
+			SubjectID: chat.ChiefID,
+			Query:     "релиз",
+			ChatID:    uuid.New(),
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("без чатов вернется пустой результат", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		out, err := usecase.SearchMessages(In{
+			SubjectID: uuid.New(),
+			Query:     "релиз",
+		})
+		suite.NoError(err)
+		suite.Empty(out.Results)
+	})
+
+	suite.Run("поиск выполняется только по чатам пользователя", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		subjectID := uuid.New()
+		chats := make([]chatt.Chat, 3)
+		chatIDs := make([]uuid.UUID, len(chats))
+		for i := range chats {
+			chats[i] = suite.RndChat()
+			chats[i].Participants = append(chats[i].Participants, suite.NewParticipant(subjectID))
+			chatIDs[i] = chats[i].ID
+		}
+		mockChatsRepo.EXPECT().List(chatt.Filter{ParticipantID: subjectID}).Return(chats, nil).Once()
+		message := suite.NewMessage(chats[0], subjectID)
+		expected := []messagee.SearchResult{{Message: message, Rank: 0.5, Snippet: "<mark>релиз</mark>"}}
+		authorID := uuid.New()
+		createdAfter := time.Now().Add(-time.Hour)
+		mockRepo.EXPECT().Search(messagee.SearchFilter{
+			Query:        "релиз",
+			ChatIDs:      chatIDs,
+			AuthorID:     authorID,
+			CreatedAfter: createdAfter,
+			Limit:        defaultPageSize,
+		}).Return(expected, nil).Once()
+
+		out, err := usecase.SearchMessages(In{
+			SubjectID:    subjectID,
+			Query:        "релиз",
+			AuthorID:     authorID,
+			CreatedAfter: createdAfter,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(expected, out.Results)
+		suite.Zero(out.NextKeyset)
+	})
+
+	suite.Run("с фильтром по чату поиск выполняется только в нем", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chats := []chatt.Chat{suite.RndChat(), suite.RndChat()}
+		subjectID := chats[0].ChiefID
+		mockChatsRepo.EXPECT().List(mock.Anything).Return(chats, nil).Once()
+		mockRepo.EXPECT().Search(mock.Anything).Run(func(filter messagee.SearchFilter) {
+			suite.Equal([]uuid.UUID{chats[1].ID}, filter.ChatIDs)
+		}).Return(nil, nil).Once()
+
+		_, err := usecase.SearchMessages(In{
+			SubjectID: subjectID,
+			Query:     "релиз",
+			ChatID:    chats[1].ID,
+		})
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("keyset следующей страницы передается в репозиторий", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Полная страница результатов
+		results := make([]messagee.SearchResult, defaultPageSize)
+		for i := range results {
+			results[i] = messagee.SearchResult{
+				Message: suite.NewMessage(chat, chat.ChiefID),
+				Rank:    float32(defaultPageSize-i) / 100,
+			}
+		}
+		mockRepo.EXPECT().Search(mock.Anything).Return(results, nil).Once()
+
+		out, err := usecase.SearchMessages(In{
+			SubjectID: chat.ChiefID,
+			Query:     "релиз",
+		})
+		suite.Require().NoError(err)
+		last := results[len(results)-1]
+		suite.Equal(Keyset{
+			Rank:      last.Rank,
+			CreatedAt: last.Message.CreatedAt,
+			ID:        last.Message.ID,
+		}, out.NextKeyset)
+
+		// Запросить следующую страницу
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().Search(mock.Anything).Run(func(filter messagee.SearchFilter) {
+			suite.Require().NotNil(filter.After)
+			suite.Equal(last.Cursor(), *filter.After)
+		}).Return(nil, nil).Once()
+		_, err = usecase.SearchMessages(In{
+			SubjectID: chat.ChiefID,
+			Query:     "релиз",
+			Keyset:    out.NextKeyset,
+		})
+		suite.Require().NoError(err)
+	})
+}
+
+func newUsecase(suite *testSuite) (*SearchMessagesUsecase, *mockMessagee.Repository, *mockChatt.Repository) {
+	uc := &SearchMessagesUsecase{
+		Repo:      suite.RR.Messages,
+		ChatsRepo: suite.RR.Chats,
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	return uc, mockRepo, mockChatsRepo
+}
@@ -0,0 +1,241 @@
+package sendMessage
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/commandd"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	runCommand "github.com/nice-pea/npchat/internal/usecases/commands/run_command"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID     = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID        = errors.New("некорректное значение ChatID")
+	ErrInvalidText          = errors.New("некорректное значение Text")
+	ErrInvalidParentID      = errors.New("некорректное значение ParentID")
+	ErrInvalidAttachmentIDs = errors.New("некорректное значение AttachmentIDs")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID     uuid.UUID
+	ChatID        uuid.UUID
+	Text          string
+	ParentID      uuid.UUID   // ID корневого сообщения, если сообщение отправляется в тред
+	AttachmentIDs []uuid.UUID // ID загруженных в чат вложений
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	// Сообщение без вложений должно содержать текст
+	if len(in.AttachmentIDs) == 0 {
+		if err := messagee.ValidateMessageText(in.Text); err != nil {
+			return errors.Join(err, ErrInvalidText)
+		}
+	}
+	for _, id := range in.AttachmentIDs {
+		if err := domain.ValidateID(id); err != nil {
+			return errors.Join(err, ErrInvalidAttachmentIDs)
+		}
+	}
+	if in.ParentID != uuid.Nil {
+		if err := domain.ValidateID(in.ParentID); err != nil {
+			return errors.Join(err, ErrInvalidParentID)
+		}
+	}
+
+	return nil
+}
+
+// Out результат отправки сообщения
+type Out struct {
+	Message messagee.Message
+	Command *runCommand.Out // Результат выполнения, если текст сообщения оказался командой
+}
+
+// CommandRunner выполняет команды, вызванные в тексте сообщения
+type CommandRunner interface {
+	RunCommand(runCommand.In) (runCommand.Out, error)
+}
+
+type SendMessageUsecase struct {
+	Repo            messagee.Repository
+	ChatsRepo       chatt.Repository
+	AttachmentsRepo attachmentt.Repository
+	UsersRepo       userr.Repository
+	Commands        CommandRunner
+	EventConsumer   events.Consumer
+}
+
+// SendMessage отправляет сообщение в чат.
+// Отправлять сообщения могут только участники чата.
+// Упомянутые через @nick участники чата получают отдельное событие.
+// Текст, начинающийся с команды, выполняется как команда и не сохраняется в чате,
+// если сообщение отправляется не в тред и не содержит вложений
+func (c *SendMessageUsecase) SendMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Выполнить команду вместо отправки сообщения
+	if c.isCommand(in) {
+		out, err := c.Commands.RunCommand(runCommand.In{
+			SubjectID: in.SubjectID,
+			ChatID:    in.ChatID,
+			Text:      in.Text,
+		})
+		if err != nil {
+			return Out{}, err
+		}
+		return Out{
+			Command: &out,
+		}, nil
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти вложения
+	attachments, err := c.findAttachments(chat, in.AttachmentIDs)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Найти упомянутых участников
+	mentions, err := messagee.ResolveMentions(c.UsersRepo, chat, in.Text)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Создать сообщение
+	var message messagee.Message
+	if in.ParentID == uuid.Nil {
+		message, err = c.sendToChat(chat, in, attachments, mentions, eventsBuf)
+	} else {
+		message, err = c.sendToThread(chat, in, attachments, mentions, eventsBuf)
+	}
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Обновить время последней активности чата
+	err = chat.SetLastActiveAt(message.CreatedAt, eventsBuf)
+	switch {
+	case err == nil:
+		if err = c.ChatsRepo.UpdateLastActiveAt(chat.ID, chat.LastActiveAt); err != nil {
+			return Out{}, err
+		}
+	case !errors.Is(err, chatt.ErrNewActiveLessThanActual):
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Message: message,
+	}, nil
+}
+
+// isCommand проверяет, нужно ли выполнить сообщение как команду
+func (c *SendMessageUsecase) isCommand(in In) bool {
+	if c.Commands == nil || in.ParentID != uuid.Nil || len(in.AttachmentIDs) > 0 {
+		return false
+	}
+	_, ok := commandd.Parse(in.Text)
+	return ok
+}
+
+// sendToChat создает и сохраняет сообщение в общей ленте чата
+func (c *SendMessageUsecase) sendToChat(chat chatt.Chat, in In, attachments []attachmentt.Attachment, mentions []uuid.UUID, eventsBuf *events.Buffer) (messagee.Message, error) {
+	message, err := messagee.NewMessageWithAttachments(chat, in.SubjectID, in.Text, attachments, mentions, eventsBuf)
+	if err != nil {
+		return messagee.Message{}, err
+	}
+
+	// Сохранить сообщение в репозиторий
+	if err = c.Repo.Upsert(message); err != nil {
+		return messagee.Message{}, err
+	}
+
+	return message, nil
+}
+
+// sendToThread создает и сохраняет ответ в треде вместе с обновленным корневым сообщением
+func (c *SendMessageUsecase) sendToThread(chat chatt.Chat, in In, attachments []attachmentt.Attachment, mentions []uuid.UUID, eventsBuf *events.Buffer) (messagee.Message, error) {
+	var reply messagee.Message
+	err := c.Repo.InTransaction(func(txRepo messagee.Repository) error {
+		// Найти корневое сообщение
+		parent, err := messagee.Find(txRepo, messagee.Filter{
+			ID:     in.ParentID,
+			ChatID: chat.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Создать ответ
+		if reply, err = messagee.NewReplyWithAttachments(chat, &parent, in.SubjectID, in.Text, attachments, mentions, eventsBuf); err != nil {
+			return err
+		}
+
+		// Сохранить ответ и корневое сообщение
+		if err = txRepo.Upsert(reply); err != nil {
+			return err
+		}
+		return txRepo.Upsert(parent)
+	})
+
+	return reply, err
+}
+
+// findAttachments возвращает вложения чата в порядке переданных ID
+func (c *SendMessageUsecase) findAttachments(chat chatt.Chat, ids []uuid.UUID) ([]attachmentt.Attachment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	found, err := c.AttachmentsRepo.List(attachmentt.Filter{
+		IDs:    ids,
+		ChatID: chat.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Упорядочить вложения так же, как переданные ID
+	byID := make(map[uuid.UUID]attachmentt.Attachment, len(found))
+	for _, a := range found {
+		byID[a.ID] = a
+	}
+	attachments := make([]attachmentt.Attachment, len(ids))
+	for i, id := range ids {
+		a, ok := byID[id]
+		if !ok {
+			return nil, attachmentt.ErrAttachmentNotExists
+		}
+		attachments[i] = a
+	}
+
+	return attachments, nil
+}
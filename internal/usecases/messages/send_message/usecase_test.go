@@ -0,0 +1,392 @@
+package sendMessage
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	mockAttachmentt "github.com/nice-pea/npchat/internal/domain/attachmentt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/usecases/commands"
+	runCommand "github.com/nice-pea/npchat/internal/usecases/commands/run_command"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_SendMessage тестирует отправку сообщения
+func (suite *testSuite) Test_Messages_SendMessage() {
+	suite.Run("чат должен существовать", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		// Отправить сообщение
+		out, err := usecase.SendMessage(In{
+			SubjectID: uuid.New(),
+			ChatID:    uuid.New(),
+			Text:      "text",
+		})
+		suite.ErrorIs(err, chatt.ErrChatNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("отправлять сообщения могут только участники чата", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		// Создать чат
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Отправить сообщение от имени случайного пользователя
+		out, err := usecase.SendMessage(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+			Text:      "text",
+		})
+		suite.ErrorIs(err, messagee.ErrAuthorIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("сообщение сохранится, а время активности чата обновится", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		// Создать чат с участником
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		input := In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			Text:      "text",
+		}
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		var savedMessage messagee.Message
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(message messagee.Message) {
+			savedMessage = message
+		}).Return(nil).Once()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(input.ChatID, mock.Anything).Run(func(_ uuid.UUID, lastActiveAt time.Time) {
+			suite.Equal(savedMessage.CreatedAt, lastActiveAt)
+		}).Return(nil).Once()
+		// Отправить сообщение
+		out, err := usecase.SendMessage(input)
+		suite.Require().NoError(err)
+		// Результат совпадает с входящими значениями
+		suite.Equal(savedMessage, out.Message)
+		suite.Equal(input.ChatID, out.Message.ChatID)
+		suite.Equal(input.SubjectID, out.Message.AuthorID)
+		suite.Equal(input.Text, out.Message.Text)
+	})
+
+	suite.Run("чат не сохраняется, если его время активности больше времени сообщения", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		// Создать чат с активностью в будущем
+		chat := suite.RndChat()
+		chat.LastActiveAt = time.Now().Add(time.Hour)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		// Отправить сообщение
+		out, err := usecase.SendMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Text:      "text",
+		})
+		suite.Require().NoError(err)
+		suite.NotZero(out.Message)
+	})
+
+	suite.Run("корневое сообщение треда должно существовать", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(messagee.Repository) error) error {
+			return fn(mockRepo)
+		}).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		// Отправить ответ в тред
+		out, err := usecase.SendMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Text:      "text",
+			ParentID:  uuid.New(),
+		})
+		suite.ErrorIs(err, messagee.ErrMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("команда выполняется вместо сохранения сообщения", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var call commands.Call
+		usecase.Commands = &runCommand.RunCommandUsecase{
+			Registry: commands.Registry{
+				"ping": commands.HandlerFunc(func(c commands.Call) (string, error) {
+					call = c
+					return "pong", nil
+				}),
+			},
+			ChatsRepo:     usecase.ChatsRepo,
+			UsersRepo:     usecase.UsersRepo,
+			EventConsumer: usecase.EventConsumer,
+		}
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		// Отправить команду
+		out, err := usecase.SendMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Text:      "/ping now",
+		})
+		suite.Require().NoError(err)
+		suite.Zero(out.Message)
+		suite.Require().NotNil(out.Command)
+		suite.Equal("pong", out.Command.Reply)
+		suite.Equal("now", call.Args)
+		suite.Equal(chat.ID, call.Chat.ID)
+	})
+
+	suite.Run("ответ в треде сохранится вместе с обновленным корневым сообщением", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		parent := suite.NewMessage(chat, chat.ChiefID)
+		input := In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Text:      "text",
+			ParentID:  parent.ID,
+		}
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(messagee.Repository) error) error {
+			return fn(mockRepo)
+		}).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ID:     parent.ID,
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{parent}, nil).Once()
+		var savedMessages []messagee.Message
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(message messagee.Message) {
+			savedMessages = append(savedMessages, message)
+		}).Return(nil).Twice()
+		// Отправить ответ в тред
+		out, err := usecase.SendMessage(input)
+		suite.Require().NoError(err)
+		suite.Equal(parent.ID, out.Message.ParentID)
+
+		// Сохранены ответ и корневое сообщение
+		suite.Require().Len(savedMessages, 2)
+		suite.Equal(out.Message, savedMessages[0])
+		suite.Equal(parent.ID, savedMessages[1].ID)
+		suite.Equal(1, savedMessages[1].ReplyCount)
+		suite.Equal(out.Message.CreatedAt, savedMessages[1].LastReplyAt)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessageCreated)
+		suite.AssertHasEventType(consumedEvents, messagee.EventThreadUpdated)
+	})
+
+	suite.Run("сообщение может содержать только вложения", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		mockAttachmentsRepo := usecase.AttachmentsRepo.(*mockAttachmentt.Repository)
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Return().Once()
+		// Создать чат с вложениями
+		chat := suite.RndChat()
+		a1 := suite.NewAttachment(chat, chat.ChiefID)
+		a2 := suite.NewAttachment(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(mock.Anything, mock.Anything).Return(nil).Once()
+		mockAttachmentsRepo.EXPECT().List(attachmentt.Filter{
+			IDs:    []uuid.UUID{a2.ID, a1.ID},
+			ChatID: chat.ID,
+		}).Return([]attachmentt.Attachment{a1, a2}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		// Отправить сообщение без текста
+		out, err := usecase.SendMessage(In{
+			SubjectID:     chat.ChiefID,
+			ChatID:        chat.ID,
+			AttachmentIDs: []uuid.UUID{a2.ID, a1.ID},
+		})
+		suite.Require().NoError(err)
+		// Порядок вложений совпадает с переданным
+		suite.Equal([]uuid.UUID{a2.ID, a1.ID}, out.Message.AttachmentIDs)
+		suite.Empty(out.Message.Text)
+	})
+
+	suite.Run("вложения должны существовать в чате", func() {
+		// Создать usecase и моки
+		usecase, _, mockChatsRepo, _ := newUsecase(suite)
+		mockAttachmentsRepo := usecase.AttachmentsRepo.(*mockAttachmentt.Repository)
+		chat := suite.RndChat()
+		a := suite.NewAttachment(chat, chat.ChiefID)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockAttachmentsRepo.EXPECT().List(mock.Anything).Return([]attachmentt.Attachment{a}, nil).Once()
+		// Отправить сообщение с несуществующим вложением
+		out, err := usecase.SendMessage(In{
+			SubjectID:     chat.ChiefID,
+			ChatID:        chat.ID,
+			Text:          "text",
+			AttachmentIDs: []uuid.UUID{a.ID, uuid.New()},
+		})
+		suite.ErrorIs(err, attachmentt.ErrAttachmentNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("после завершения операции, будут созданы события", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		// Настройка мока
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		// Создать чат с участником
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		// Отправить сообщение
+		_, err := usecase.SendMessage(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			Text:      "text",
+		})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, messagee.EventMessageCreated)
+		suite.AssertHasEventType(consumedEvents, chatt.EventChatUpdated)
+		// Событие о новом сообщении получат все участники чата
+		for _, e := range consumedEvents {
+			if e.Type == messagee.EventMessageCreated {
+				suite.ElementsMatch(chat.ParticipantIDs(), e.Recipients)
+			}
+		}
+	})
+
+	suite.Run("упомянутые участники сохранятся в сообщении и получат событие упоминания", func() {
+		// Создать usecase и моки
+		usecase, mockRepo, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		// Создать чат с участником и пользователя вне чата
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		member := userr.User{ID: p.UserID, Nick: "alice"}
+		stranger := userr.User{ID: uuid.New(), Nick: "bob"}
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockChatsRepo.EXPECT().UpdateLastActiveAt(mock.Anything, mock.Anything).Return(nil).Once()
+		suite.RR.Users.EXPECT().List(userr.Filter{Nicks: []string{"Alice", "bob"}}).
+			Return([]userr.User{member, stranger}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Once()
+		// Отправить сообщение с упоминаниями
+		out, err := usecase.SendMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			Text:      "@Alice и @bob, привет",
+		})
+		suite.Require().NoError(err)
+		// Упомянут только участник чата
+		suite.Equal([]uuid.UUID{member.ID}, out.Message.Mentions)
+		// Событие упоминания получит только упомянутый участник
+		suite.AssertHasEventType(consumedEvents, messagee.EventMentioned)
+		for _, e := range consumedEvents {
+			if e.Type == messagee.EventMentioned {
+				suite.Equal([]uuid.UUID{member.ID}, e.Recipients)
+			}
+		}
+	})
+}
+
+// Test_SendMessageInput_Validate тестирует входящие параметры отправки сообщения
+func Test_SendMessageInput_Validate(t *testing.T) {
+	t.Run("Text", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			text    string
+			wantErr bool
+		}{
+			{
+				name:    "пустая строка",
+				text:    "",
+				wantErr: true,
+			},
+			{
+				name:    "содержит только пробелы",
+				text:    " \n\t ",
+				wantErr: true,
+			},
+			{
+				name:    "превышает лимит символов",
+				text:    strings.Repeat("a", messagee.MessageTextMaxLen+1),
+				wantErr: true,
+			},
+			{
+				name:    "максимальная длина",
+				text:    strings.Repeat("я", messagee.MessageTextMaxLen),
+				wantErr: false,
+			},
+			{
+				name:    "обычный текст",
+				text:    "Привет!\nКак дела?",
+				wantErr: false,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				input := In{
+					SubjectID: uuid.New(),
+					ChatID:    uuid.New(),
+					Text:      tt.text,
+				}
+				if err := input.Validate(); tt.wantErr {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+		}
+	})
+}
+
+func newUsecase(suite *testSuite) (*SendMessageUsecase, *mockMessagee.Repository, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &SendMessageUsecase{
+		Repo:            suite.RR.Messages,
+		ChatsRepo:       suite.RR.Chats,
+		AttachmentsRepo: suite.RR.Attachments,
+		UsersRepo:       suite.RR.Users,
+		EventConsumer:   mockEvents.NewConsumer(suite.T()),
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockRepo, mockChatsRepo, mockEventsConsumer
+}
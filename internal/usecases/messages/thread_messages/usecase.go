@@ -0,0 +1,118 @@
+package threadMessages
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+)
+
+var (
+	ErrInvalidSubjectID   = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID      = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID   = errors.New("некорректное значение MessageID")
+	ErrSubjectIsNotMember = errors.New("пользователь не является участником чата")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID // ID корневого сообщения треда
+	Keyset    Keyset
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	return nil
+}
+
+// Out результат запроса сообщений треда
+type Out struct {
+	Root       messagee.Message
+	Messages   []messagee.Message
+	Reactions  map[uuid.UUID][]messagee.ReactionSummary // Сводки реакций по ID сообщения, включая корневое
+	NextKeyset Keyset
+}
+
+type Keyset struct {
+	CreatedBefore time.Time
+}
+
+type ThreadMessagesUsecase struct {
+	Repo      messagee.Repository
+	ChatsRepo chatt.Repository
+}
+
+const defaultPageSize = 50
+
+// ThreadMessages возвращает корневое сообщение треда и ответы в нем, начиная с самых новых.
+// Просматривать тред могут только участники чата
+func (c *ThreadMessagesUsecase) ThreadMessages(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Пользователь должен быть участником чата
+	if !chat.HasParticipant(in.SubjectID) {
+		return Out{}, ErrSubjectIsNotMember
+	}
+
+	// Найти корневое сообщение
+	root, err := messagee.Find(c.Repo, messagee.Filter{
+		ID:     in.MessageID,
+		ChatID: in.ChatID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Получить страницу ответов
+	messages, err := c.Repo.List(messagee.Filter{
+		ChatID:        in.ChatID,
+		ParentID:      root.ID,
+		CreatedBefore: in.Keyset.CreatedBefore,
+		Limit:         defaultPageSize,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Root:       root,
+		Messages:   messages,
+		Reactions:  messagee.ReactionSummariesOf(append([]messagee.Message{root}, messages...), in.SubjectID),
+		NextKeyset: nextKeyset(messages, defaultPageSize),
+	}, nil
+}
+
+func nextKeyset(messages []messagee.Message, pageSize int) Keyset {
+	if len(messages) < pageSize {
+		return Keyset{}
+	}
+
+	return Keyset{
+		CreatedBefore: messages[len(messages)-1].CreatedAt,
+	}
+}
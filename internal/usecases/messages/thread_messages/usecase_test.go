@@ -0,0 +1,125 @@
+package threadMessages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_ThreadMessages тестирует получение сообщений треда
+func (suite *testSuite) Test_Messages_ThreadMessages() {
+	suite.Run("просматривать тред могут только участники чата", func() {
+		usecase, _, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+
+		out, err := usecase.ThreadMessages(In{
+			SubjectID: uuid.New(),
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, ErrSubjectIsNotMember)
+		suite.Zero(out)
+	})
+
+	suite.Run("корневое сообщение должно существовать", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+
+		out, err := usecase.ThreadMessages(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, messagee.ErrMessageNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("возвращает корневое сообщение и ответы в треде", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		root := suite.NewMessage(chat, chat.ChiefID)
+		replies := suite.newReplies(chat, &root, 3)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ID:     root.ID,
+			ChatID: chat.ID,
+		}).Return([]messagee.Message{root}, nil).Once()
+		mockRepo.EXPECT().List(messagee.Filter{
+			ChatID:   chat.ID,
+			ParentID: root.ID,
+			Limit:    defaultPageSize,
+		}).Return(replies, nil).Once()
+
+		out, err := usecase.ThreadMessages(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: root.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(root, out.Root)
+		suite.Equal(replies, out.Messages)
+		suite.Zero(out.NextKeyset)
+	})
+
+	suite.Run("возвращает keyset если страница заполнена", func() {
+		usecase, mockRepo, mockChatsRepo := newUsecase(suite)
+		chat := suite.RndChat()
+		root := suite.NewMessage(chat, chat.ChiefID)
+		replies := suite.newReplies(chat, &root, defaultPageSize)
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return([]messagee.Message{root}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything).Return(replies, nil).Once()
+
+		out, err := usecase.ThreadMessages(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: root.ID,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(replies[len(replies)-1].CreatedAt, out.NextKeyset.CreatedBefore)
+	})
+}
+
+// newReplies создает ответы в треде в порядке убывания CreatedAt
+func (suite *testSuite) newReplies(chat chatt.Chat, root *messagee.Message, count int) []messagee.Message {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	replies := make([]messagee.Message, count)
+	for i := range replies {
+		reply, err := messagee.NewReply(chat, root, chat.ChiefID, "text", nil)
+		suite.Require().NoError(err)
+		reply.CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+		replies[i] = reply
+	}
+
+	return replies
+}
+
+func newUsecase(suite *testSuite) (*ThreadMessagesUsecase, *mockMessagee.Repository, *mockChatt.Repository) {
+	uc := &ThreadMessagesUsecase{
+		Repo:      suite.RR.Messages,
+		ChatsRepo: suite.RR.Chats,
+	}
+	mockRepo := uc.Repo.(*mockMessagee.Repository)
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	return uc, mockRepo, mockChatsRepo
+}
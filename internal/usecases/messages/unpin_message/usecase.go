@@ -0,0 +1,88 @@
+package unpinMessage
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID = errors.New("некорректное значение MessageID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	return nil
+}
+
+// Out результат открепления сообщения
+type Out struct{}
+
+type UnpinMessageUsecase struct {
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// UnpinMessage открепляет сообщение в чате.
+// Откреплять сообщения могут только участники с соответствующим правом
+func (c *UnpinMessageUsecase) UnpinMessage(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	err := c.ChatsRepo.InTransaction(func(txRepo chatt.Repository) error {
+		// Найти чат с блокировкой, чтобы не перезаписать параллельные изменения
+		chat, err := chatt.Find(txRepo, chatt.Filter{ID: in.ChatID})
+		if err != nil {
+			return err
+		}
+
+		// Откреплять сообщения могут только участники с соответствующим правом
+		if !chat.Can(in.SubjectID, chatt.PermissionPin) {
+			return chatt.ErrPermissionDenied
+		}
+
+		// Открепить сообщение
+		if err = chat.UnpinMessage(in.MessageID, eventsBuf); err != nil {
+			return err
+		}
+
+		// Сохранить чат в репозиторий
+		return txRepo.Upsert(chat)
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{}, nil
+}
@@ -0,0 +1,98 @@
+package unpinMessage
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	mockEvents "github.com/nice-pea/npchat/internal/usecases/events/mocks"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Messages_UnpinMessage тестирует открепление сообщения
+func (suite *testSuite) Test_Messages_UnpinMessage() {
+	suite.Run("откреплять сообщения могут только участники с соответствующим правом", func() {
+		// Создать usecase и моки
+		usecase, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		p := suite.AddRndParticipant(&chat)
+		messageID := uuid.New()
+		suite.Require().NoError(chat.PinMessage(messageID, chat.ChiefID, chatt.DefaultMaxPins, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Открепить сообщение от имени участника
+		out, err := usecase.UnpinMessage(In{
+			SubjectID: p.UserID,
+			ChatID:    chat.ID,
+			MessageID: messageID,
+		})
+		suite.ErrorIs(err, chatt.ErrPermissionDenied)
+		suite.Zero(out)
+	})
+
+	suite.Run("открепить можно только закрепленное сообщение", func() {
+		// Создать usecase и моки
+		usecase, mockChatsRepo, _ := newUsecase(suite)
+		chat := suite.RndChat()
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		// Открепить незакрепленное сообщение
+		out, err := usecase.UnpinMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: uuid.New(),
+		})
+		suite.ErrorIs(err, chatt.ErrPinNotExists)
+		suite.Zero(out)
+	})
+
+	suite.Run("закрепление удалится из чата и будут созданы события", func() {
+		// Создать usecase и моки
+		usecase, mockChatsRepo, mockEventConsumer := newUsecase(suite)
+		var consumedEvents []events.Event
+		mockEventConsumer.EXPECT().Consume(mock.Anything).Run(func(events []events.Event) {
+			consumedEvents = append(consumedEvents, events...)
+		}).Return().Once()
+		chat := suite.RndChat()
+		messageID := uuid.New()
+		suite.Require().NoError(chat.PinMessage(messageID, chat.ChiefID, chatt.DefaultMaxPins, nil))
+		mockChatsRepo.EXPECT().List(mock.Anything).Return([]chatt.Chat{chat}, nil).Once()
+		mockChatsRepo.EXPECT().Upsert(mock.Anything).Run(func(chat chatt.Chat) {
+			suite.False(chat.HasPin(messageID))
+		}).Return(nil).Once()
+		// Открепить сообщение
+		_, err := usecase.UnpinMessage(In{
+			SubjectID: chat.ChiefID,
+			ChatID:    chat.ID,
+			MessageID: messageID,
+		})
+		suite.Require().NoError(err)
+
+		// Проверить список опубликованных событий
+		suite.AssertHasEventType(consumedEvents, chatt.EventMessageUnpinned)
+	})
+}
+
+func newUsecase(suite *testSuite) (*UnpinMessageUsecase, *mockChatt.Repository, *mockEvents.Consumer) {
+	uc := &UnpinMessageUsecase{
+		ChatsRepo:     suite.RR.Chats,
+		EventConsumer: mockEvents.NewConsumer(suite.T()),
+	}
+	mockChatsRepo := uc.ChatsRepo.(*mockChatt.Repository)
+	mockChatsRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(chatt.Repository) error) error {
+		return fn(mockChatsRepo)
+	}).Maybe()
+	mockEventsConsumer := uc.EventConsumer.(*mockEvents.Consumer)
+	return uc, mockChatsRepo, mockEventsConsumer
+}
@@ -0,0 +1,100 @@
+package votePoll
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/chatt"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidChatID    = errors.New("некорректное значение ChatID")
+	ErrInvalidMessageID = errors.New("некорректное значение MessageID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	ChatID    uuid.UUID
+	MessageID uuid.UUID
+	Options   []int // Индексы выбранных вариантов ответа
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return errors.Join(err, ErrInvalidSubjectID)
+	}
+	if err := domain.ValidateID(in.ChatID); err != nil {
+		return errors.Join(err, ErrInvalidChatID)
+	}
+	if err := domain.ValidateID(in.MessageID); err != nil {
+		return errors.Join(err, ErrInvalidMessageID)
+	}
+
+	return nil
+}
+
+// Out результат голосования
+type Out struct {
+	Results messagee.PollResults
+}
+
+type VotePollUsecase struct {
+	Repo          messagee.Repository
+	ChatsRepo     chatt.Repository
+	EventConsumer events.Consumer
+}
+
+// VotePoll заменяет голоса пользователя в опросе выбранными вариантами.
+// Голосовать могут только участники чата до завершения опроса
+func (c *VotePollUsecase) VotePoll(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти чат
+	chat, err := chatt.Find(c.ChatsRepo, chatt.Filter{ID: in.ChatID})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Инициализировать буфер событий
+	eventsBuf := new(events.Buffer)
+
+	// Проголосовать в транзакции, чтобы параллельные голоса не перезаписали друг друга
+	var message messagee.Message
+	err = c.Repo.InTransaction(func(txRepo messagee.Repository) error {
+		// Найти сообщение с опросом
+		message, err = messagee.Find(txRepo, messagee.Filter{
+			ID:     in.MessageID,
+			ChatID: in.ChatID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Проголосовать
+		if err = message.Vote(chat, in.SubjectID, in.Options, eventsBuf); err != nil {
+			return err
+		}
+
+		return txRepo.Upsert(message)
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Отправить собранные события
+	c.EventConsumer.Consume(eventsBuf.Events())
+
+	return Out{
+		Results: message.Poll.Results(in.SubjectID),
+	}, nil
+}
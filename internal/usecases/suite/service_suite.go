@@ -1,6 +1,8 @@
 package serviceSuite
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"math/rand"
 	"slices"
@@ -12,11 +14,24 @@ import (
 	testifySuite "github.com/stretchr/testify/suite"
 
 	"github.com/nice-pea/npchat/internal/common"
+	"github.com/nice-pea/npchat/internal/domain/attachmentt"
+	mockAttachmentt "github.com/nice-pea/npchat/internal/domain/attachmentt/mocks"
 	"github.com/nice-pea/npchat/internal/domain/chatt"
 	mockChatt "github.com/nice-pea/npchat/internal/domain/chatt/mocks"
+	mockDraftt "github.com/nice-pea/npchat/internal/domain/draftt/mocks"
+	mockIntegrationn "github.com/nice-pea/npchat/internal/domain/integrationn/mocks"
+	mockInvitelinkk "github.com/nice-pea/npchat/internal/domain/invitelinkk/mocks"
+	mockMessagee "github.com/nice-pea/npchat/internal/domain/messagee/mocks"
+	mockSchedulee "github.com/nice-pea/npchat/internal/domain/schedulee/mocks"
 	mockSessionn "github.com/nice-pea/npchat/internal/domain/sessionn/mocks"
 	mockUserr "github.com/nice-pea/npchat/internal/domain/userr/mocks"
+	mockWebhookk "github.com/nice-pea/npchat/internal/domain/webhookk/mocks"
 
+	"github.com/nice-pea/npchat/internal/domain/draftt"
+	"github.com/nice-pea/npchat/internal/domain/integrationn"
+	"github.com/nice-pea/npchat/internal/domain/invitelinkk"
+	"github.com/nice-pea/npchat/internal/domain/messagee"
+	"github.com/nice-pea/npchat/internal/domain/schedulee"
 	"github.com/nice-pea/npchat/internal/domain/sessionn"
 	"github.com/nice-pea/npchat/internal/domain/userr"
 	"github.com/nice-pea/npchat/internal/usecases/events"
@@ -26,12 +41,21 @@ import (
 type Suite struct {
 	testifySuite.Suite
 	RR struct {
-		Chats    *mockChatt.Repository
-		Sessions *mockSessionn.Repository
-		Users    *mockUserr.Repository
+		Attachments  *mockAttachmentt.Repository
+		Chats        *mockChatt.Repository
+		Drafts       *mockDraftt.Repository
+		Integrations *mockIntegrationn.Repository
+		InviteLinks  *mockInvitelinkk.Repository
+		Messages     *mockMessagee.Repository
+		Scheduled    *mockSchedulee.Repository
+		Sessions     *mockSessionn.Repository
+		Users        *mockUserr.Repository
+		Webhooks     *mockWebhookk.Repository
 	}
 	Adapters struct {
-		Oauth *mockOauth.Provider
+		Oauth         *mockOauth.Provider
+		BlobStorage   *mockAttachmentt.Storage
+		WebhookSender *mockWebhookk.Sender
 	}
 	MockOauthTokens map[string]userr.OpenAuthToken
 	MockOauthUsers  map[userr.OpenAuthToken]userr.OpenAuthUser
@@ -54,13 +78,31 @@ func (suite *Suite) SetupAcceptInvitationMocks(invitationID uuid.UUID, chat chat
 	}).Once()
 }
 
+// SetupInviteLinksTransaction настраивает мок транзакции репозитория пригласительных ссылок.
+// Внутри транзакции репозиторием чатов служит мок RR.Chats
+func (suite *Suite) SetupInviteLinksTransaction() {
+	suite.RR.InviteLinks.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(invitelinkk.Repository) error) error {
+		return fn(suite.RR.InviteLinks)
+	}).Maybe()
+	suite.RR.InviteLinks.EXPECT().ChatsRepo().Return(suite.RR.Chats).Maybe()
+}
+
 // TearDownSubTest выполняется после каждого подтеста, связанного с suite
 func (suite *Suite) TearDownSubTest() {
 	// пересоздаем моки репозиториев
+	suite.RR.Attachments = mockAttachmentt.NewRepository(suite.T())
 	suite.RR.Chats = mockChatt.NewRepository(suite.T())
+	suite.RR.Drafts = mockDraftt.NewRepository(suite.T())
+	suite.RR.Integrations = mockIntegrationn.NewRepository(suite.T())
+	suite.RR.InviteLinks = mockInvitelinkk.NewRepository(suite.T())
+	suite.RR.Messages = mockMessagee.NewRepository(suite.T())
+	suite.RR.Scheduled = mockSchedulee.NewRepository(suite.T())
 	suite.RR.Users = mockUserr.NewRepository(suite.T())
 	suite.RR.Sessions = mockSessionn.NewRepository(suite.T())
+	suite.RR.Webhooks = mockWebhookk.NewRepository(suite.T())
 	suite.Adapters.Oauth = mockOauth.NewProvider(suite.T())
+	suite.Adapters.BlobStorage = mockAttachmentt.NewStorage(suite.T())
+	suite.Adapters.WebhookSender = mockWebhookk.NewSender(suite.T())
 	// Инициализация адаптеров
 	suite.initAdapters()
 }
@@ -140,7 +182,7 @@ func (suite *Suite) AddParticipant(chat *chatt.Chat, p chatt.Participant) {
 
 // NewInvitation создает новое приглашение
 func (suite *Suite) NewInvitation(subjectID, recipientID uuid.UUID) chatt.Invitation {
-	i, err := chatt.NewInvitation(subjectID, recipientID)
+	i, err := chatt.NewInvitation(subjectID, recipientID, "", time.Time{})
 	suite.Require().NoError(err)
 	return i
 }
@@ -150,6 +192,44 @@ func (suite *Suite) AddInvitation(chat *chatt.Chat, i chatt.Invitation) {
 	suite.Require().NoError(chat.AddInvitation(i, nil))
 }
 
+// NewMessage создает новое сообщение в чате
+func (suite *Suite) NewMessage(chat chatt.Chat, authorID uuid.UUID) messagee.Message {
+	m, err := messagee.NewMessage(chat, authorID, gofakeit.Sentence(5), nil)
+	suite.Require().NoError(err)
+	return m
+}
+
+// NewPollMessage создает новое сообщение с опросом из трех вариантов в чате
+func (suite *Suite) NewPollMessage(chat chatt.Chat, authorID uuid.UUID, multipleChoice bool) messagee.Message {
+	poll, err := messagee.NewPoll(gofakeit.Sentence(5), []string{"a", "b", "c"}, multipleChoice, false, time.Time{})
+	suite.Require().NoError(err)
+	m, err := messagee.NewPollMessage(chat, authorID, poll, nil)
+	suite.Require().NoError(err)
+	return m
+}
+
+// NewDraft создает черновик пользователя в чате
+func (suite *Suite) NewDraft(chat chatt.Chat, userID uuid.UUID) draftt.Draft {
+	d, err := draftt.NewDraft(chat, userID, uuid.New(), gofakeit.Sentence(5), nil)
+	suite.Require().NoError(err)
+	return d
+}
+
+// NewScheduledMessage создает новое отложенное сообщение в чате
+func (suite *Suite) NewScheduledMessage(chat chatt.Chat, authorID uuid.UUID) schedulee.ScheduledMessage {
+	s, err := schedulee.NewScheduledMessage(chat, authorID, gofakeit.Sentence(5), uuid.Nil, time.Now().Add(time.Hour))
+	suite.Require().NoError(err)
+	return s
+}
+
+// NewAttachment создает новое вложение в чате
+func (suite *Suite) NewAttachment(chat chatt.Chat, uploaderID uuid.UUID) attachmentt.Attachment {
+	sum := sha256.Sum256([]byte(gofakeit.Sentence(5)))
+	a, err := attachmentt.NewAttachment(chat, uploaderID, gofakeit.Word()+".txt", "text/plain", 128, hex.EncodeToString(sum[:]), uuid.NewString())
+	suite.Require().NoError(err)
+	return a
+}
+
 // RandomString генерирует случайную строку
 func RandomString2(n int) string {
 	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
@@ -213,6 +293,27 @@ func (suite *Suite) NewRndUserWithBasicAuth() userr.User {
 	return user
 }
 
+// NewBot создает бота с вебхуком, принадлежащего owner
+func (suite *Suite) NewBot(owner userr.User) userr.User {
+	bot, _, err := userr.NewBot(owner, gofakeit.Name(), "", "https://"+gofakeit.DomainName()+"/hook")
+	suite.Require().NoError(err)
+	return bot
+}
+
+// NewIncomingWebhook создает входящий вебхук главного администратора чата
+func (suite *Suite) NewIncomingWebhook(chat chatt.Chat) (integrationn.Webhook, string) {
+	webhook, token, err := integrationn.NewWebhook(chat.ID, chat.ChiefID, gofakeit.AppName())
+	suite.Require().NoError(err)
+	return webhook, token
+}
+
+// NewInviteLink создает пригласительную ссылку главного администратора чата
+func (suite *Suite) NewInviteLink(chat chatt.Chat, requiresApproval bool) (invitelinkk.Link, string) {
+	link, token, err := invitelinkk.NewLink(chat.ID, chat.ChiefID, time.Time{}, 0, requiresApproval)
+	suite.Require().NoError(err)
+	return link, token
+}
+
 // HasElementOfType возвращает true, если в срезе есть элемент заданного типа
 func HasElementOfType2[T any](e []any) bool {
 	for _, e := range e {
@@ -0,0 +1,46 @@
+package authenticateBot
+
+import (
+	"github.com/nice-pea/npchat/internal/domain/userr"
+)
+
+// In входящие параметры
+type In struct {
+	Token string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	return userr.ValidateBotToken(in.Token)
+}
+
+// Out результат аутентификации бота
+type Out struct {
+	Bot userr.User
+}
+
+type AuthenticateBotUsecase struct {
+	Repo userr.Repository
+}
+
+// AuthenticateBot находит бота по его токену.
+// Бот аутентифицируется токеном вместо сессии, поэтому токен действует до выпуска нового
+func (c *AuthenticateBotUsecase) AuthenticateBot(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти бота по хэшу токена
+	bot, err := userr.Find(c.Repo, userr.Filter{
+		Kind:         userr.KindBot,
+		BotTokenHash: userr.HashBotToken(in.Token),
+	})
+	if err != nil {
+		return Out{}, userr.ErrInvalidBotToken
+	}
+
+	return Out{
+		Bot: bot,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package authenticateBot
+
+import (
+	"testing"
+
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Bots_AuthenticateBot тестирует аутентификацию бота по токену
+func (suite *testSuite) Test_Bots_AuthenticateBot() {
+	usecase := &AuthenticateBotUsecase{
+		Repo: suite.RR.Users,
+	}
+	mockRepoUsers := suite.RR.Users
+
+	suite.Run("токен некорректного формата отклоняется без обращения к репозиторию", func() {
+		_, err := usecase.AuthenticateBot(In{Token: "session-token"})
+		suite.ErrorIs(err, userr.ErrInvalidBotToken)
+	})
+
+	suite.Run("неизвестный токен отклоняется", func() {
+		_, token, err := userr.NewBot(suite.NewRndUserWithBasicAuth(), "bot", "", "")
+		suite.Require().NoError(err)
+		mockRepoUsers.EXPECT().List(userr.Filter{
+			Kind:         userr.KindBot,
+			BotTokenHash: userr.HashBotToken(token),
+		}).Return(nil, nil).Once()
+		_, err = usecase.AuthenticateBot(In{Token: token})
+		suite.ErrorIs(err, userr.ErrInvalidBotToken)
+	})
+
+	suite.Run("по токену находится бот", func() {
+		bot, token, err := userr.NewBot(suite.NewRndUserWithBasicAuth(), "bot", "", "")
+		suite.Require().NoError(err)
+		mockRepoUsers.EXPECT().List(userr.Filter{
+			Kind:         userr.KindBot,
+			BotTokenHash: userr.HashBotToken(token),
+		}).Return([]userr.User{bot}, nil).Once()
+		out, err := usecase.AuthenticateBot(In{Token: token})
+		suite.Require().NoError(err)
+		suite.Equal(bot, out.Bot)
+	})
+}
@@ -0,0 +1,80 @@
+package createBot
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrNameIsRequired   = errors.New("name это обязательный параметр")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID  uuid.UUID
+	Name       string
+	Nick       string
+	WebhookURL string
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return ErrInvalidSubjectID
+	}
+	if in.Name == "" {
+		return ErrNameIsRequired
+	}
+
+	return nil
+}
+
+// Out результат создания бота
+type Out struct {
+	Bot   userr.User
+	Token string // Токен бота, возвращается только один раз
+}
+
+type CreateBotUsecase struct {
+	Repo userr.Repository
+}
+
+// CreateBot создает бота, владельцем которого становится пользователь SubjectID.
+func (c *CreateBotUsecase) CreateBot(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти владельца
+	owner, err := userr.Find(c.Repo, userr.Filter{
+		ID: in.SubjectID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Создать бота
+	bot, token, err := userr.NewBot(owner, in.Name, in.Nick, in.WebhookURL)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить бота в репозиторий
+	if err = c.Repo.Upsert(bot); err != nil {
+		return Out{}, err
+	}
+
+	// Хэш токена не нужен клиенту
+	bot.Bot.TokenHash = ""
+
+	return Out{
+		Bot:   bot,
+		Token: token,
+	}, nil
+}
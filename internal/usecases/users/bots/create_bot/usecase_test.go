@@ -0,0 +1,77 @@
+package createBot
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Bots_CreateBot тестирует создание бота
+func (suite *testSuite) Test_Bots_CreateBot() {
+	usecase := &CreateBotUsecase{
+		Repo: suite.RR.Users,
+	}
+	mockRepoUsers := suite.RR.Users
+
+	suite.Run("есть валидация параметров", func() {
+		_, err := usecase.CreateBot(In{Name: "bot"})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.CreateBot(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrNameIsRequired)
+	})
+
+	suite.Run("владелец должен существовать", func() {
+		mockRepoUsers.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		_, err := usecase.CreateBot(In{SubjectID: uuid.New(), Name: "bot"})
+		suite.ErrorIs(err, userr.ErrUserNotExists)
+	})
+
+	suite.Run("бот не может создать бота", func() {
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		mockRepoUsers.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Once()
+		_, err := usecase.CreateBot(In{SubjectID: bot.ID, Name: "bot"})
+		suite.ErrorIs(err, userr.ErrBotOwnerIsBot)
+	})
+
+	suite.Run("некорректный адрес вебхука", func() {
+		owner := suite.NewRndUserWithBasicAuth()
+		mockRepoUsers.EXPECT().List(mock.Anything).Return([]userr.User{owner}, nil).Once()
+		_, err := usecase.CreateBot(In{SubjectID: owner.ID, Name: "bot", WebhookURL: "ftp://example.com"})
+		suite.ErrorIs(err, userr.ErrInvalidWebhookURL)
+	})
+
+	suite.Run("бот сохранится, а токен вернется один раз", func() {
+		owner := suite.NewRndUserWithBasicAuth()
+		mockRepoUsers.EXPECT().List(userr.Filter{ID: owner.ID}).Return([]userr.User{owner}, nil).Once()
+		var saved userr.User
+		mockRepoUsers.EXPECT().Upsert(mock.Anything).Run(func(u userr.User) {
+			saved = u
+		}).Return(nil).Once()
+		out, err := usecase.CreateBot(In{
+			SubjectID:  owner.ID,
+			Name:       "bot",
+			Nick:       "bot",
+			WebhookURL: "https://example.com/hook",
+		})
+		suite.Require().NoError(err)
+		suite.True(saved.IsBot())
+		suite.Equal(owner.ID, saved.Bot.OwnerID)
+		suite.Equal(userr.HashBotToken(out.Token), saved.Bot.TokenHash)
+		suite.Equal(saved.ID, out.Bot.ID)
+		suite.Empty(out.Bot.Bot.TokenHash)
+		suite.Equal(saved.Bot.WebhookSecret, out.Bot.Bot.WebhookSecret)
+	})
+}
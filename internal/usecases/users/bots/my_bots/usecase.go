@@ -0,0 +1,63 @@
+package myBots
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return ErrInvalidSubjectID
+	}
+
+	return nil
+}
+
+// Out результат запроса ботов
+type Out struct {
+	Bots []userr.User
+}
+
+type MyBotsUsecase struct {
+	Repo userr.Repository
+}
+
+// MyBots возвращает ботов, владельцем которых является пользователь SubjectID.
+func (c *MyBotsUsecase) MyBots(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Получить ботов пользователя
+	bots, err := c.Repo.List(userr.Filter{
+		Kind:       userr.KindBot,
+		BotOwnerID: in.SubjectID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Хэши токенов не нужны клиенту
+	for i := range bots {
+		bots[i].Bot.TokenHash = ""
+	}
+
+	return Out{
+		Bots: bots,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package myBots
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Bots_MyBots тестирует получение ботов пользователя
+func (suite *testSuite) Test_Bots_MyBots() {
+	usecase := &MyBotsUsecase{
+		Repo: suite.RR.Users,
+	}
+	mockRepoUsers := suite.RR.Users
+
+	suite.Run("SubjectID должен быть валидным", func() {
+		_, err := usecase.MyBots(In{})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+	})
+
+	suite.Run("вернутся боты владельца без хэшей токенов", func() {
+		owner := suite.NewRndUserWithBasicAuth()
+		bots := []userr.User{suite.NewBot(owner), suite.NewBot(owner)}
+		mockRepoUsers.EXPECT().List(userr.Filter{
+			Kind:       userr.KindBot,
+			BotOwnerID: owner.ID,
+		}).Return(bots, nil).Once()
+		out, err := usecase.MyBots(In{SubjectID: owner.ID})
+		suite.Require().NoError(err)
+		suite.Require().Len(out.Bots, 2)
+		for _, bot := range out.Bots {
+			suite.Empty(bot.Bot.TokenHash)
+			suite.NotEmpty(bot.Bot.WebhookSecret)
+		}
+	})
+
+	suite.Run("у пользователя может не быть ботов", func() {
+		subjectID := uuid.New()
+		mockRepoUsers.EXPECT().List(userr.Filter{
+			Kind:       userr.KindBot,
+			BotOwnerID: subjectID,
+		}).Return(nil, nil).Once()
+		out, err := usecase.MyBots(In{SubjectID: subjectID})
+		suite.Require().NoError(err)
+		suite.Empty(out.Bots)
+	})
+}
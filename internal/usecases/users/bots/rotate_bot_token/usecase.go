@@ -0,0 +1,73 @@
+package rotateBotToken
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidBotID     = errors.New("некорректное значение BotID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	BotID     uuid.UUID
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return ErrInvalidSubjectID
+	}
+	if err := domain.ValidateID(in.BotID); err != nil {
+		return ErrInvalidBotID
+	}
+
+	return nil
+}
+
+// Out результат выпуска токена
+type Out struct {
+	Token string // Новый токен бота, возвращается только один раз
+}
+
+type RotateBotTokenUsecase struct {
+	Repo userr.Repository
+}
+
+// RotateBotToken выпускает боту новый токен, старый токен перестает действовать.
+func (c *RotateBotTokenUsecase) RotateBotToken(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти бота
+	bot, err := userr.Find(c.Repo, userr.Filter{
+		ID: in.BotID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Выпустить новый токен
+	token, err := bot.RotateBotToken(in.SubjectID)
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить бота в репозиторий
+	if err = c.Repo.Upsert(bot); err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Token: token,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package rotateBotToken
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Bots_RotateBotToken тестирует выпуск нового токена бота
+func (suite *testSuite) Test_Bots_RotateBotToken() {
+	usecase := &RotateBotTokenUsecase{
+		Repo: suite.RR.Users,
+	}
+	mockRepoUsers := suite.RR.Users
+
+	suite.Run("есть валидация параметров", func() {
+		_, err := usecase.RotateBotToken(In{BotID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.RotateBotToken(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidBotID)
+	})
+
+	suite.Run("выпустить токен может только владелец", func() {
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		mockRepoUsers.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Once()
+		_, err := usecase.RotateBotToken(In{SubjectID: uuid.New(), BotID: bot.ID})
+		suite.ErrorIs(err, userr.ErrSubjectIsNotBotOwner)
+	})
+
+	suite.Run("новый токен сохранится в виде хэша", func() {
+		owner := suite.NewRndUserWithBasicAuth()
+		bot := suite.NewBot(owner)
+		mockRepoUsers.EXPECT().List(userr.Filter{ID: bot.ID}).Return([]userr.User{bot}, nil).Once()
+		var saved userr.User
+		mockRepoUsers.EXPECT().Upsert(mock.Anything).Run(func(u userr.User) {
+			saved = u
+		}).Return(nil).Once()
+		out, err := usecase.RotateBotToken(In{SubjectID: owner.ID, BotID: bot.ID})
+		suite.Require().NoError(err)
+		suite.Equal(userr.HashBotToken(out.Token), saved.Bot.TokenHash)
+		suite.NotEqual(bot.Bot.TokenHash, saved.Bot.TokenHash)
+	})
+}
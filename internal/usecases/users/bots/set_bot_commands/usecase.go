@@ -0,0 +1,74 @@
+package setBotCommands
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain"
+	"github.com/nice-pea/npchat/internal/domain/userr"
+)
+
+var (
+	ErrInvalidSubjectID = errors.New("некорректное значение SubjectID")
+	ErrInvalidBotID     = errors.New("некорректное значение BotID")
+)
+
+// In входящие параметры
+type In struct {
+	SubjectID uuid.UUID
+	BotID     uuid.UUID
+	Commands  []userr.BotCommand
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if err := domain.ValidateID(in.SubjectID); err != nil {
+		return ErrInvalidSubjectID
+	}
+	if err := domain.ValidateID(in.BotID); err != nil {
+		return ErrInvalidBotID
+	}
+
+	return nil
+}
+
+// Out результат изменения команд бота
+type Out struct {
+	Commands []userr.BotCommand
+}
+
+type SetBotCommandsUsecase struct {
+	Repo userr.Repository
+}
+
+// SetBotCommands заменяет список команд, которые обрабатывает бот.
+// Изменить команды может сам бот или его владелец
+func (c *SetBotCommandsUsecase) SetBotCommands(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+
+	// Найти бота
+	bot, err := userr.Find(c.Repo, userr.Filter{
+		ID: in.BotID,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	// Заменить команды
+	if err = bot.SetBotCommands(in.SubjectID, in.Commands); err != nil {
+		return Out{}, err
+	}
+
+	// Сохранить бота в репозиторий
+	if err = c.Repo.Upsert(bot); err != nil {
+		return Out{}, err
+	}
+
+	return Out{
+		Commands: bot.Bot.Commands,
+	}, nil
+}
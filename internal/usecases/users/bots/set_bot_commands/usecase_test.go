@@ -0,0 +1,54 @@
+package setBotCommands
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Bots_SetBotCommands тестирует изменение команд бота
+func (suite *testSuite) Test_Bots_SetBotCommands() {
+	usecase := &SetBotCommandsUsecase{
+		Repo: suite.RR.Users,
+	}
+	mockRepoUsers := suite.RR.Users
+
+	suite.Run("есть валидация параметров", func() {
+		_, err := usecase.SetBotCommands(In{BotID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidSubjectID)
+		_, err = usecase.SetBotCommands(In{SubjectID: uuid.New()})
+		suite.ErrorIs(err, ErrInvalidBotID)
+	})
+
+	suite.Run("посторонний не может изменить команды", func() {
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		mockRepoUsers.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Once()
+		_, err := usecase.SetBotCommands(In{SubjectID: uuid.New(), BotID: bot.ID})
+		suite.ErrorIs(err, userr.ErrSubjectIsNotBotOwner)
+	})
+
+	suite.Run("бот может зарегистрировать свои команды", func() {
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		commands := []userr.BotCommand{{Name: "deploy", Description: "Выкатить релиз"}}
+		mockRepoUsers.EXPECT().List(userr.Filter{ID: bot.ID}).Return([]userr.User{bot}, nil).Once()
+		mockRepoUsers.EXPECT().Upsert(mock.Anything).Run(func(u userr.User) {
+			suite.Equal(commands, u.Bot.Commands)
+		}).Return(nil).Once()
+		out, err := usecase.SetBotCommands(In{SubjectID: bot.ID, BotID: bot.ID, Commands: commands})
+		suite.Require().NoError(err)
+		suite.Equal(commands, out.Commands)
+	})
+}
@@ -62,6 +62,13 @@ func (c *UserProfileUsecase) UserProfile(in In) (Out, error) {
 		user.BasicAuth = userr.BasicAuth{}
 	}
 
+	// Секреты бота доступны только его владельцу, хэш токена не отдается никому
+	user.Bot.TokenHash = ""
+	if user.IsBot() && user.Bot.OwnerID != in.SubjectID {
+		user.Bot.WebhookURL = ""
+		user.Bot.WebhookSecret = ""
+	}
+
 	return Out{
 		User: user,
 	}, nil
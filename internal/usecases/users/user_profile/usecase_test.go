@@ -80,4 +80,30 @@ func (suite *testSuite) Test_UserProfile() {
 		suite.Empty(out.User.OpenAuthUsers)
 		suite.Zero(out.User.BasicAuth)
 	})
+
+	suite.Run("секреты бота видны только его владельцу", func() {
+		owner := suite.NewRndUserWithBasicAuth()
+		bot, _, err := userr.NewBot(owner, "bot", "bot", "https://example.com/hook")
+		suite.Require().NoError(err)
+		// Владелец видит вебхук, но не хэш токена
+		mockRepoUsers.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Once()
+		out, err := usecase.UserProfile(In{
+			SubjectID: owner.ID,
+			UserID:    bot.ID,
+		})
+		suite.NoError(err)
+		suite.Equal(bot.Bot.WebhookSecret, out.User.Bot.WebhookSecret)
+		suite.Empty(out.User.Bot.TokenHash)
+		// Остальные не видят секретов
+		mockRepoUsers.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Once()
+		out, err = usecase.UserProfile(In{
+			SubjectID: uuid.New(),
+			UserID:    bot.ID,
+		})
+		suite.NoError(err)
+		suite.Equal(owner.ID, out.User.Bot.OwnerID)
+		suite.Empty(out.User.Bot.WebhookURL)
+		suite.Empty(out.User.Bot.WebhookSecret)
+		suite.Empty(out.User.Bot.TokenHash)
+	})
 }
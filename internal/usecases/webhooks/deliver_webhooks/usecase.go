@@ -0,0 +1,162 @@
+package deliverWebhooks
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
+)
+
+// DefaultLimit количество доставок, выполняемых за один вызов, если лимит не указан
+const DefaultLimit = 100
+
+// SendConcurrency количество одновременно выполняемых попыток доставки
+const SendConcurrency = 10
+
+var ErrInvalidNow = errors.New("некорректное значение Now")
+
+// In входящие параметры
+type In struct {
+	Now   time.Time // Момент, на который выполняются наступившие доставки
+	Limit int       // Максимальное количество доставок за вызов
+}
+
+// Validate валидирует значение отдельно каждого параметры
+func (in In) Validate() error {
+	if in.Now.IsZero() {
+		return ErrInvalidNow
+	}
+
+	return nil
+}
+
+// Out результат доставки вебхуков
+type Out struct {
+	Delivered int // Количество успешных доставок
+	Failed    int // Количество доставок, попытки которых исчерпаны
+}
+
+type DeliverWebhooksUsecase struct {
+	Repo      webhookk.Repository
+	UsersRepo userr.Repository
+	Sender    webhookk.Sender
+}
+
+// DeliverWebhooks отправляет ботам события, время доставки которых наступило.
+// Каждая попытка подписывается секретом бота. Неудачные попытки повторяются
+// с растущей задержкой. Наступившие доставки сначала откладываются на время попытки
+// в короткой транзакции, поэтому несколько экземпляров приложения с общей базой данных
+// не отправят одну доставку дважды. Запросы к ботам выполняются вне транзакции
+// и параллельно, чтобы медленный получатель не задерживал остальные доставки
+func (c *DeliverWebhooksUsecase) DeliverWebhooks(in In) (Out, error) {
+	// Валидировать параметры
+	if err := in.Validate(); err != nil {
+		return Out{}, err
+	}
+	if in.Limit <= 0 {
+		in.Limit = DefaultLimit
+	}
+
+	// Взять наступившие доставки
+	due, err := c.claimDue(in.Now, in.Limit)
+	if err != nil || len(due) == 0 {
+		return Out{}, err
+	}
+
+	// Найти ботов-получателей
+	botIDs := make([]uuid.UUID, 0, len(due))
+	for _, delivery := range due {
+		botIDs = append(botIDs, delivery.BotID)
+	}
+	bots, err := c.UsersRepo.List(userr.Filter{
+		IDs:  botIDs,
+		Kind: userr.KindBot,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+	botsMap := make(map[uuid.UUID]userr.User, len(bots))
+	for _, bot := range bots {
+		botsMap[bot.ID] = bot
+	}
+
+	// Выполнить попытки доставки
+	errs := make([]error, len(due))
+	sem := make(chan struct{}, SendConcurrency)
+	var wg sync.WaitGroup
+	for i := range due {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			errs[i] = c.attempt(&due[i], botsMap)
+		}()
+	}
+	wg.Wait()
+
+	var out Out
+	for i, delivery := range due {
+		if errs[i] != nil {
+			return out, errs[i]
+		}
+		if !delivery.DeliveredAt.IsZero() {
+			out.Delivered++
+		} else if !delivery.FailedAt.IsZero() {
+			out.Failed++
+		}
+
+		// Сохранить статус доставки
+		if err = c.Repo.Upsert(delivery); err != nil {
+			return out, err
+		}
+	}
+
+	return out, nil
+}
+
+// claimDue блокирует наступившие доставки и откладывает их на время попытки
+func (c *DeliverWebhooksUsecase) claimDue(now time.Time, limit int) ([]webhookk.Delivery, error) {
+	var due []webhookk.Delivery
+	err := c.Repo.InTransaction(func(txRepo webhookk.Repository) error {
+		var err error
+		if due, err = txRepo.ClaimDue(now, limit); err != nil {
+			return err
+		}
+		for i := range due {
+			if err = due[i].Claim(now); err != nil {
+				return err
+			}
+			if err = txRepo.Upsert(due[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return due, nil
+}
+
+// attempt выполняет попытку доставки и отмечает ее результат
+func (c *DeliverWebhooksUsecase) attempt(delivery *webhookk.Delivery, botsMap map[uuid.UUID]userr.User) error {
+	bot, ok := botsMap[delivery.BotID]
+	if !ok || !bot.HasWebhook() {
+		// Бот больше не принимает вебхуки, повторять попытки бессмысленно
+		return delivery.MarkAbandoned("вебхук бота не настроен")
+	}
+
+	// Отправить подписанную доставку
+	headers := delivery.Headers(bot.Bot.WebhookSecret, time.Now())
+	if err := c.Sender.Send(bot.Bot.WebhookURL, headers, delivery.Payload); err != nil {
+		return delivery.MarkFailed(err.Error())
+	}
+
+	return delivery.MarkDelivered()
+}
@@ -0,0 +1,199 @@
+package deliverWebhooks
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
+	mockWebhookk "github.com/nice-pea/npchat/internal/domain/webhookk/mocks"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Webhooks_DeliverWebhooks тестирует доставку вебхуков ботам
+func (suite *testSuite) Test_Webhooks_DeliverWebhooks() {
+	suite.Run("Now должен быть указан", func() {
+		usecase, _ := newUsecase(suite)
+		out, err := usecase.DeliverWebhooks(In{})
+		suite.ErrorIs(err, ErrInvalidNow)
+		suite.Zero(out)
+	})
+
+	suite.Run("если наступивших доставок нет, ничего не отправится", func() {
+		usecase, mockRepo := newUsecase(suite)
+		now := time.Now()
+		mockRepo.EXPECT().ClaimDue(now, DefaultLimit).Return(nil, nil).Once()
+		out, err := usecase.DeliverWebhooks(In{Now: now})
+		suite.Require().NoError(err)
+		suite.Zero(out)
+	})
+
+	suite.Run("доставка отправится с подписью и будет отмечена успешной", func() {
+		usecase, mockRepo := newUsecase(suite)
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		delivery := suite.newDelivery(bot.ID)
+		mockRepo.EXPECT().ClaimDue(mock.Anything, mock.Anything).Return([]webhookk.Delivery{delivery}, nil).Once()
+		suite.expectClaim(mockRepo, delivery)
+		suite.RR.Users.EXPECT().List(userr.Filter{IDs: []uuid.UUID{bot.ID}, Kind: userr.KindBot}).Return([]userr.User{bot}, nil).Once()
+		suite.Adapters.WebhookSender.EXPECT().Send(bot.Bot.WebhookURL, mock.Anything, delivery.Payload).
+			Run(func(_ string, headers map[string]string, body []byte) {
+				signature := webhookk.Sign(bot.Bot.WebhookSecret, headers[webhookk.HeaderTimestamp], body)
+				suite.Equal(signature, headers[webhookk.HeaderSignature])
+				suite.Equal(delivery.ID.String(), headers[webhookk.HeaderDelivery])
+			}).Return(nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(d webhookk.Delivery) {
+			suite.False(d.DeliveredAt.IsZero())
+		}).Return(nil).Once()
+		out, err := usecase.DeliverWebhooks(In{Now: time.Now()})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Delivered: 1}, out)
+	})
+
+	suite.Run("при ошибке получателя попытка будет повторена позже", func() {
+		usecase, mockRepo := newUsecase(suite)
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		delivery := suite.newDelivery(bot.ID)
+		mockRepo.EXPECT().ClaimDue(mock.Anything, mock.Anything).Return([]webhookk.Delivery{delivery}, nil).Once()
+		suite.expectClaim(mockRepo, delivery)
+		suite.RR.Users.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Once()
+		suite.Adapters.WebhookSender.EXPECT().Send(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("status 500")).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(d webhookk.Delivery) {
+			suite.True(d.IsPending())
+			suite.Equal(1, d.Attempts)
+			suite.Equal("status 500", d.LastError)
+			suite.True(d.NextAttemptAt.After(time.Now()))
+		}).Return(nil).Once()
+		out, err := usecase.DeliverWebhooks(In{Now: time.Now()})
+		suite.Require().NoError(err)
+		suite.Zero(out)
+	})
+
+	suite.Run("после последней попытки доставка будет отмечена неудачной", func() {
+		usecase, mockRepo := newUsecase(suite)
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		delivery := suite.newDelivery(bot.ID)
+		delivery.Attempts = webhookk.MaxAttempts - 1
+		mockRepo.EXPECT().ClaimDue(mock.Anything, mock.Anything).Return([]webhookk.Delivery{delivery}, nil).Once()
+		suite.expectClaim(mockRepo, delivery)
+		suite.RR.Users.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Once()
+		suite.Adapters.WebhookSender.EXPECT().Send(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("timeout")).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(d webhookk.Delivery) {
+			suite.False(d.FailedAt.IsZero())
+		}).Return(nil).Once()
+		out, err := usecase.DeliverWebhooks(In{Now: time.Now()})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Failed: 1}, out)
+	})
+
+	suite.Run("запросы к ботам выполняются вне транзакции", func() {
+		uc := &DeliverWebhooksUsecase{
+			Repo:      suite.RR.Webhooks,
+			UsersRepo: suite.RR.Users,
+			Sender:    suite.Adapters.WebhookSender,
+		}
+		mockRepo := suite.RR.Webhooks
+		var inTx bool
+		mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(webhookk.Repository) error) error {
+			inTx = true
+			defer func() { inTx = false }()
+			return fn(mockRepo)
+		}).Once()
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		delivery := suite.newDelivery(bot.ID)
+		mockRepo.EXPECT().ClaimDue(mock.Anything, mock.Anything).Return([]webhookk.Delivery{delivery}, nil).Once()
+		suite.expectClaim(mockRepo, delivery)
+		suite.RR.Users.EXPECT().List(mock.Anything).Return([]userr.User{bot}, nil).Once()
+		suite.Adapters.WebhookSender.EXPECT().Send(mock.Anything, mock.Anything, mock.Anything).Run(func(string, map[string]string, []byte) {
+			suite.False(inTx)
+		}).Return(nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(webhookk.Delivery) {
+			suite.False(inTx)
+		}).Return(nil).Once()
+		out, err := uc.DeliverWebhooks(In{Now: time.Now()})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Delivered: 1}, out)
+	})
+
+	suite.Run("медленный получатель не задерживает остальные доставки", func() {
+		usecase, mockRepo := newUsecase(suite)
+		slowBot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		slow := suite.newDelivery(slowBot.ID)
+		delivery := suite.newDelivery(bot.ID)
+		mockRepo.EXPECT().ClaimDue(mock.Anything, mock.Anything).Return([]webhookk.Delivery{slow, delivery}, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Return(nil).Times(4)
+		suite.RR.Users.EXPECT().List(mock.Anything).Return([]userr.User{slowBot, bot}, nil).Once()
+		released := make(chan struct{})
+		suite.Adapters.WebhookSender.EXPECT().Send(slowBot.Bot.WebhookURL, mock.Anything, mock.Anything).Run(func(string, map[string]string, []byte) {
+			<-released
+		}).Return(nil).Once()
+		suite.Adapters.WebhookSender.EXPECT().Send(bot.Bot.WebhookURL, mock.Anything, mock.Anything).Run(func(string, map[string]string, []byte) {
+			// Медленный получатель отвечает только после быстрого
+			close(released)
+		}).Return(nil).Once()
+		out, err := usecase.DeliverWebhooks(In{Now: time.Now()})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Delivered: 2}, out)
+	})
+
+	suite.Run("доставка удаленному боту не отправляется", func() {
+		usecase, mockRepo := newUsecase(suite)
+		delivery := suite.newDelivery(uuid.New())
+		mockRepo.EXPECT().ClaimDue(mock.Anything, mock.Anything).Return([]webhookk.Delivery{delivery}, nil).Once()
+		suite.expectClaim(mockRepo, delivery)
+		suite.RR.Users.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+		mockRepo.EXPECT().Upsert(mock.Anything).Run(func(d webhookk.Delivery) {
+			suite.False(d.FailedAt.IsZero())
+		}).Return(nil).Once()
+		out, err := usecase.DeliverWebhooks(In{Now: time.Now()})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Failed: 1}, out)
+	})
+}
+
+// expectClaim ожидает сохранения доставки, отложенной на время попытки
+func (suite *testSuite) expectClaim(mockRepo *mockWebhookk.Repository, delivery webhookk.Delivery) {
+	mockRepo.EXPECT().Upsert(mock.Anything).Run(func(d webhookk.Delivery) {
+		suite.Equal(delivery.ID, d.ID)
+		suite.Equal(delivery.Attempts, d.Attempts)
+		suite.True(d.NextAttemptAt.After(time.Now().Add(webhookk.ClaimTimeout - time.Minute)))
+	}).Return(nil).Once()
+}
+
+// newDelivery создает доставку события боту
+func (suite *testSuite) newDelivery(botID uuid.UUID) webhookk.Delivery {
+	delivery, err := webhookk.NewDelivery(botID, events.Event{
+		Type:      "message_created",
+		CreatedIn: time.Now(),
+		Data:      map[string]any{"text": "hello"},
+	})
+	suite.Require().NoError(err)
+	return delivery
+}
+
+func newUsecase(suite *testSuite) (*DeliverWebhooksUsecase, *mockWebhookk.Repository) {
+	uc := &DeliverWebhooksUsecase{
+		Repo:      suite.RR.Webhooks,
+		UsersRepo: suite.RR.Users,
+		Sender:    suite.Adapters.WebhookSender,
+	}
+	mockRepo := suite.RR.Webhooks
+	mockRepo.EXPECT().InTransaction(mock.Anything).RunAndReturn(func(fn func(webhookk.Repository) error) error {
+		return fn(mockRepo)
+	}).Maybe()
+	return uc, mockRepo
+}
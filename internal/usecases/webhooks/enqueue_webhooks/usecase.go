@@ -0,0 +1,84 @@
+package enqueueWebhooks
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+)
+
+// In входящие параметры
+type In struct {
+	Events []events.Event
+}
+
+// Out результат постановки доставок в очередь
+type Out struct {
+	Enqueued int // Количество созданных доставок
+}
+
+type EnqueueWebhooksUsecase struct {
+	Repo      webhookk.Repository
+	UsersRepo userr.Repository
+}
+
+// EnqueueWebhooks ставит в очередь доставку событий ботам, которые являются их получателями.
+// Доставки создаются только для ботов с настроенным вебхуком, а отправляются позже фоновым процессом.
+// Эфемерные события в очередь не ставятся, так как к моменту доставки теряют актуальность
+func (c *EnqueueWebhooksUsecase) EnqueueWebhooks(in In) (Out, error) {
+	// Отбросить эфемерные события
+	ee := slices.DeleteFunc(slices.Clone(in.Events), func(event events.Event) bool {
+		return event.Ephemeral
+	})
+
+	// Собрать получателей всех событий
+	var recipients []uuid.UUID
+	for _, event := range ee {
+		for _, id := range event.Recipients {
+			if !slices.Contains(recipients, id) {
+				recipients = append(recipients, id)
+			}
+		}
+	}
+	if len(recipients) == 0 {
+		return Out{}, nil
+	}
+
+	// Найти ботов среди получателей
+	bots, err := c.UsersRepo.List(userr.Filter{
+		IDs:  recipients,
+		Kind: userr.KindBot,
+	})
+	if err != nil {
+		return Out{}, err
+	}
+
+	var out Out
+	for _, bot := range bots {
+		if !bot.HasWebhook() {
+			continue
+		}
+		for _, event := range ee {
+			if !slices.Contains(event.Recipients, bot.ID) {
+				continue
+			}
+
+			// Создать доставку
+			delivery, err := webhookk.NewDelivery(bot.ID, event)
+			if err != nil {
+				return out, err
+			}
+
+			// Сохранить доставку в репозиторий
+			if err = c.Repo.Upsert(delivery); err != nil {
+				return out, err
+			}
+			out.Enqueued++
+		}
+	}
+
+	return out, nil
+}
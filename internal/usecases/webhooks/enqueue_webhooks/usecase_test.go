@@ -0,0 +1,74 @@
+package enqueueWebhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	testifySuite "github.com/stretchr/testify/suite"
+
+	"github.com/nice-pea/npchat/internal/domain/userr"
+	"github.com/nice-pea/npchat/internal/domain/webhookk"
+	"github.com/nice-pea/npchat/internal/usecases/events"
+	serviceSuite "github.com/nice-pea/npchat/internal/usecases/suite"
+)
+
+type testSuite struct {
+	serviceSuite.Suite
+}
+
+func Test_TestSuite(t *testing.T) {
+	testifySuite.Run(t, new(testSuite))
+}
+
+// Test_Webhooks_EnqueueWebhooks тестирует постановку доставок вебхуков в очередь
+func (suite *testSuite) Test_Webhooks_EnqueueWebhooks() {
+	suite.Run("события без получателей не требуют запросов", func() {
+		usecase := newUsecase(suite)
+		out, err := usecase.EnqueueWebhooks(In{Events: []events.Event{{Type: "t"}}})
+		suite.Require().NoError(err)
+		suite.Zero(out)
+	})
+
+	suite.Run("эфемерные события не ставятся в очередь", func() {
+		usecase := newUsecase(suite)
+		bot := suite.NewBot(suite.NewRndUserWithBasicAuth())
+		out, err := usecase.EnqueueWebhooks(In{Events: []events.Event{
+			{Type: "typing", CreatedIn: time.Now(), Recipients: []uuid.UUID{bot.ID}, Ephemeral: true},
+		}})
+		suite.Require().NoError(err)
+		suite.Zero(out)
+	})
+
+	suite.Run("доставки создаются только ботам с вебхуком среди получателей", func() {
+		usecase := newUsecase(suite)
+		owner := suite.NewRndUserWithBasicAuth()
+		bot := suite.NewBot(owner)
+		silentBot, _, err := userr.NewBot(owner, "silent", "", "")
+		suite.Require().NoError(err)
+		ee := []events.Event{
+			{Type: "message_created", CreatedIn: time.Now(), Recipients: []uuid.UUID{owner.ID, bot.ID, silentBot.ID}},
+			{Type: "chat_updated", CreatedIn: time.Now(), Recipients: []uuid.UUID{owner.ID}},
+		}
+		suite.RR.Users.EXPECT().List(mock.Anything).RunAndReturn(func(filter userr.Filter) ([]userr.User, error) {
+			suite.Equal(userr.KindBot, filter.Kind)
+			suite.ElementsMatch([]uuid.UUID{owner.ID, bot.ID, silentBot.ID}, filter.IDs)
+			return []userr.User{bot, silentBot}, nil
+		}).Once()
+		suite.RR.Webhooks.EXPECT().Upsert(mock.Anything).Run(func(d webhookk.Delivery) {
+			suite.Equal(bot.ID, d.BotID)
+			suite.Equal("message_created", d.EventType)
+		}).Return(nil).Once()
+		out, err := usecase.EnqueueWebhooks(In{Events: ee})
+		suite.Require().NoError(err)
+		suite.Equal(Out{Enqueued: 1}, out)
+	})
+}
+
+func newUsecase(suite *testSuite) *EnqueueWebhooksUsecase {
+	return &EnqueueWebhooksUsecase{
+		Repo:      suite.RR.Webhooks,
+		UsersRepo: suite.RR.Users,
+	}
+}